@@ -0,0 +1,350 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/rs/zerolog/log"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// discoverer walks the organization and collects the import blocks of the objects found.
+type discoverer struct {
+	client  *client.CloudAvenue
+	org     *client.Org
+	vdcName string
+	// owners are the names of the VDC and of its VDC groups when the discovery is restricted to a VDC.
+	owners map[string]bool
+	blocks []importBlock
+}
+
+// run discovers the objects of the organization.
+// The catalogs and the S3 buckets belong to the organization and are skipped
+// when the discovery is restricted to a VDC.
+func (d *discoverer) run() (err error) {
+	d.org, err = d.client.GetOrg()
+	if err != nil {
+		return err
+	}
+
+	if d.vdcName != "" {
+		if d.owners, err = d.vdcOwners(); err != nil {
+			return fmt.Errorf("error discovering the VDC groups of %s: %w", d.vdcName, err)
+		}
+	}
+
+	steps := []struct {
+		name string
+		f    func() error
+		org  bool
+	}{
+		{name: "VDCs, vApps and VMs", f: d.discoverVDCs},
+		{name: "edge gateways", f: d.discoverEdgeGateways},
+		{name: "networks", f: d.discoverNetworks},
+		{name: "catalogs", f: d.discoverCatalogs, org: true},
+		{name: "S3 buckets", f: d.discoverBuckets, org: true},
+	}
+
+	for _, step := range steps {
+		if step.org && d.vdcName != "" {
+			continue
+		}
+
+		log.Info().Msgf("Discovering %s", step.name)
+		if err := step.f(); err != nil {
+			return fmt.Errorf("error discovering %s: %w", step.name, err)
+		}
+	}
+
+	return nil
+}
+
+// add appends the import block of an object.
+// The label is used to build the name of the resource.
+func (d *discoverer) add(resourceType, label, id string, attributes ...attribute) {
+	d.blocks = append(d.blocks, importBlock{
+		Type:       resourceType,
+		Label:      label,
+		ID:         id,
+		Attributes: attributes,
+	})
+}
+
+// vdcOwners returns the names of the VDC and of the VDC groups it belongs to.
+// The edge gateways and the networks of a VDC group are shared by its VDCs.
+func (d *discoverer) vdcOwners() (map[string]bool, error) {
+	adminOrg, err := d.client.GetAdminOrg()
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := adminOrg.GetAllVdcGroups(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := map[string]bool{d.vdcName: true}
+	for _, group := range groups {
+		for _, vdc := range group.VdcGroup.ParticipatingOrgVdcs {
+			if vdc.VdcRef.Name == d.vdcName {
+				owners[group.VdcGroup.Name] = true
+			}
+		}
+	}
+
+	return owners, nil
+}
+
+// inScope returns true if the object owned by ownerName (a VDC or a VDC group) is in the scope of the discovery.
+func (d *discoverer) inScope(ownerName string) bool {
+	return d.vdcName == "" || d.owners[ownerName]
+}
+
+// discoverVDCs discovers the VDCs, their vApps and the VMs of the vApps.
+func (d *discoverer) discoverVDCs() error {
+	vdcs, err := d.org.QueryOrgVdcList()
+	if err != nil {
+		return err
+	}
+
+	for _, v := range vdcs {
+		if d.vdcName != "" && d.vdcName != v.Name {
+			continue
+		}
+
+		// ID format is VDCName
+		d.add("cloudavenue_vdc", v.Name, v.Name,
+			attribute{Name: "name", Value: cty.StringVal(v.Name)},
+		)
+
+		vdc, err := d.org.GetVDCByName(v.Name, false)
+		if err != nil {
+			return err
+		}
+
+		if err := d.discoverVApps(vdc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// discoverVApps discovers the vApps of the VDC and their VMs.
+func (d *discoverer) discoverVApps(vdc *govcd.Vdc) error {
+	for _, ref := range vdc.GetVappList() {
+		// ID format is VDCName.VAppIDOrName
+		d.add("cloudavenue_vapp", ref.Name, vdc.Vdc.Name+"."+ref.Name,
+			attribute{Name: "name", Value: cty.StringVal(ref.Name)},
+			attribute{Name: "vdc", Value: cty.StringVal(vdc.Vdc.Name)},
+		)
+
+		vapp, err := vdc.GetVAppByName(ref.Name, false)
+		if err != nil {
+			return err
+		}
+
+		if vapp.VApp.Children == nil {
+			continue
+		}
+
+		for _, vm := range vapp.VApp.Children.VM {
+			// ID format is vdc.vapp_name.vm_id
+			d.add("cloudavenue_vm", vm.Name, vdc.Vdc.Name+"."+ref.Name+"."+vm.ID,
+				attribute{Name: "name", Value: cty.StringVal(vm.Name)},
+				attribute{Name: "vdc", Value: cty.StringVal(vdc.Vdc.Name)},
+				attribute{Name: "vapp_name", Value: cty.StringVal(ref.Name)},
+			)
+		}
+	}
+
+	return nil
+}
+
+// discoverEdgeGateways discovers the edge gateways and their NAT rules, firewall,
+// IPsec VPN tunnels, static routes and IP sets.
+func (d *discoverer) discoverEdgeGateways() error {
+	edgegws, err := d.org.GetAllNsxtEdgeGateways(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, egw := range edgegws {
+		if egw.EdgeGateway.OwnerRef == nil || !d.inScope(egw.EdgeGateway.OwnerRef.Name) {
+			continue
+		}
+
+		ownerType := "vdc"
+		if uuid.IsVDCGroup(egw.EdgeGateway.OwnerRef.ID) {
+			ownerType = "vdc-group"
+		}
+
+		attributes := []attribute{
+			{Name: "owner_name", Value: cty.StringVal(egw.EdgeGateway.OwnerRef.Name)},
+			{Name: "owner_type", Value: cty.StringVal(ownerType)},
+		}
+		if len(egw.EdgeGateway.EdgeGatewayUplinks) > 0 {
+			attributes = append(attributes, attribute{Name: "tier0_vrf_name", Value: cty.StringVal(egw.EdgeGateway.EdgeGatewayUplinks[0].UplinkName)})
+		}
+
+		// ID format is EdgeGatewayName
+		d.add("cloudavenue_edgegateway", egw.EdgeGateway.Name, egw.EdgeGateway.Name, attributes...)
+
+		if err := d.discoverEdgeGatewayServices(egw); err != nil {
+			return fmt.Errorf("edge gateway %s: %w", egw.EdgeGateway.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// discoverEdgeGatewayServices discovers the services configured on the edge gateway.
+func (d *discoverer) discoverEdgeGatewayServices(egw *govcd.NsxtEdgeGateway) error {
+	edgegwID := egw.EdgeGateway.ID
+	edgegwAttribute := attribute{Name: "edge_gateway_id", Value: cty.StringVal(edgegwID)}
+
+	natRules, err := egw.GetAllNatRules(nil)
+	if err != nil {
+		return err
+	}
+	for _, rule := range natRules {
+		// ID format is EdgeGatewayIDOrName.NATRuleIDOrName
+		d.add("cloudavenue_edgegateway_nat_rule", rule.NsxtNatRule.Name, edgegwID+"."+rule.NsxtNatRule.ID,
+			attribute{Name: "name", Value: cty.StringVal(rule.NsxtNatRule.Name)},
+			edgegwAttribute,
+		)
+	}
+
+	firewall, err := egw.GetNsxtFirewall()
+	if err != nil {
+		return err
+	}
+	if firewall.NsxtFirewallRuleContainer != nil && len(firewall.NsxtFirewallRuleContainer.UserDefinedRules) > 0 {
+		// ID format is EdgeGatewayIDOrName
+		d.add("cloudavenue_edgegateway_firewall", egw.EdgeGateway.Name, edgegwID, edgegwAttribute)
+	}
+
+	tunnels, err := egw.GetAllIpSecVpnTunnels(nil)
+	if err != nil {
+		return err
+	}
+	for _, tunnel := range tunnels {
+		// ID format is EdgeGatewayIDOrName.VPNIPSecIDOrName
+		d.add("cloudavenue_edgegateway_vpn_ipsec", tunnel.NsxtIpSecVpn.Name, edgegwID+"."+tunnel.NsxtIpSecVpn.ID,
+			attribute{Name: "name", Value: cty.StringVal(tunnel.NsxtIpSecVpn.Name)},
+			edgegwAttribute,
+		)
+	}
+
+	staticRoutes, err := egw.GetAllStaticRoutes(nil)
+	if err != nil {
+		return err
+	}
+	for _, route := range staticRoutes {
+		// ID format is EdgeGatewayIDOrName.StaticRouteNameOrID
+		d.add("cloudavenue_edgegateway_static_route", route.NsxtEdgeGatewayStaticRoute.Name, edgegwID+"."+route.NsxtEdgeGatewayStaticRoute.ID,
+			attribute{Name: "name", Value: cty.StringVal(route.NsxtEdgeGatewayStaticRoute.Name)},
+			edgegwAttribute,
+		)
+	}
+
+	ipSets, err := egw.GetAllNsxtFirewallGroups(nil, govcdtypes.FirewallGroupTypeIpSet)
+	if err != nil {
+		return err
+	}
+	for _, ipSet := range ipSets {
+		// ID format is EdgeGatewayIDOrName.IPSetName
+		d.add("cloudavenue_edgegateway_ip_set", ipSet.NsxtFirewallGroup.Name, edgegwID+"."+ipSet.NsxtFirewallGroup.Name,
+			attribute{Name: "name", Value: cty.StringVal(ipSet.NsxtFirewallGroup.Name)},
+			edgegwAttribute,
+		)
+	}
+
+	return nil
+}
+
+// discoverNetworks discovers the routed and isolated networks.
+func (d *discoverer) discoverNetworks() error {
+	networks, err := d.org.GetAllOpenApiOrgVdcNetworks(nil)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range networks {
+		network := n.OpenApiOrgVdcNetwork
+		if network.OwnerRef == nil || !d.inScope(network.OwnerRef.Name) {
+			continue
+		}
+
+		attributes := []attribute{{Name: "name", Value: cty.StringVal(network.Name)}}
+		if len(network.Subnets.Values) > 0 {
+			attributes = append(attributes,
+				attribute{Name: "gateway", Value: cty.StringVal(network.Subnets.Values[0].Gateway)},
+				attribute{Name: "prefix_length", Value: cty.NumberIntVal(int64(network.Subnets.Values[0].PrefixLength))},
+			)
+		}
+
+		switch {
+		case n.IsRouted() && network.Connection != nil:
+			// ID format is EdgeGatewayIDOrName.NetworkName
+			d.add("cloudavenue_network_routed", network.Name, network.Connection.RouterRef.ID+"."+network.Name,
+				append(attributes, attribute{Name: "edge_gateway_id", Value: cty.StringVal(network.Connection.RouterRef.ID)})...,
+			)
+		case n.IsIsolated():
+			// ID format is VDCOrVDCGroupName.NetworkName
+			d.add("cloudavenue_network_isolated", network.Name, network.OwnerRef.Name+"."+network.Name,
+				append(attributes, attribute{Name: "vdc", Value: cty.StringVal(network.OwnerRef.Name)})...,
+			)
+		default:
+			log.Warn().Msgf("Network %s of type %s is not supported, skipping", network.Name, n.GetType())
+		}
+	}
+
+	return nil
+}
+
+// discoverCatalogs discovers the catalogs of the organization.
+func (d *discoverer) discoverCatalogs() error {
+	catalogs, err := d.org.QueryCatalogList()
+	if err != nil {
+		return err
+	}
+
+	for _, catalog := range catalogs {
+		// ID format is CatalogName
+		d.add("cloudavenue_catalog", catalog.Name, catalog.Name,
+			attribute{Name: "name", Value: cty.StringVal(catalog.Name)},
+		)
+	}
+
+	return nil
+}
+
+// discoverBuckets discovers the S3 buckets of the organization.
+func (d *discoverer) discoverBuckets() error {
+	s3Client, err := d.client.S3()
+	if err != nil {
+		return err
+	}
+
+	buckets, err := s3Client.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range buckets.Buckets {
+		name := aws.StringValue(bucket.Name)
+		// ID format is BucketName
+		d.add("cloudavenue_s3_bucket", name, name,
+			attribute{Name: "name", Value: cty.StringVal(name)},
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/fakeapi"
+)
+
+func TestDiscovererRun(t *testing.T) {
+	t.Parallel()
+
+	server := fakeapi.New(t)
+	server.AddVDC(fakeapi.VDC{Name: "vdc01", VdcGroup: "group01"})
+	server.AddVDC(fakeapi.VDC{Name: "vdc02"})
+	server.AddEdgeGateway(fakeapi.EdgeGateway{Name: "edge01", OwnerName: "vdc01", Tier0VrfName: "t0"})
+	server.AddEdgeGateway(fakeapi.EdgeGateway{Name: "edge02", OwnerType: "vdc-group", OwnerName: "group01", Tier0VrfName: "t0"})
+	edge03 := server.AddEdgeGateway(fakeapi.EdgeGateway{Name: "edge03", OwnerName: "vdc02", Tier0VrfName: "t0"})
+
+	// The edge gateways have no services.
+	emptyPage := func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]any{"resultTotal": 0, "pageCount": 1, "page": 1, "pageSize": 0, "values": []any{}})
+	}
+	server.Handle(http.MethodGet, "/cloudapi/1.0.0/edgeGateways/{edgeId}/nat/rules", emptyPage)
+	server.Handle(http.MethodGet, "/cloudapi/1.0.0/edgeGateways/{edgeId}/ipsec/tunnels", emptyPage)
+	server.Handle(http.MethodGet, "/cloudapi/1.0.0/edgeGateways/{edgeId}/routing/staticRoutes", emptyPage)
+	server.Handle(http.MethodGet, "/cloudapi/1.0.0/firewallGroups/summaries", emptyPage)
+	server.Handle(http.MethodGet, "/cloudapi/1.0.0/edgeGateways/{edgeId}/firewall/rules", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, govcdtypes.NsxtFirewallRuleContainer{})
+	})
+
+	networks := []govcdtypes.OpenApiOrgVdcNetwork{
+		{
+			Name:        "net01",
+			OwnerRef:    &govcdtypes.OpenApiReference{Name: "group01"},
+			NetworkType: govcdtypes.OrgVdcNetworkTypeIsolated,
+		},
+		{
+			Name:        "net02",
+			OwnerRef:    &govcdtypes.OpenApiReference{Name: "vdc02"},
+			NetworkType: govcdtypes.OrgVdcNetworkTypeRouted,
+			Connection:  &govcdtypes.Connection{RouterRef: govcdtypes.OpenApiReference{ID: edge03.ID, Name: edge03.Name}},
+		},
+		{
+			Name:        "net03",
+			OwnerRef:    &govcdtypes.OpenApiReference{Name: "vdc01"},
+			NetworkType: govcdtypes.OrgVdcNetworkTypeIsolated,
+		},
+	}
+	server.Handle(http.MethodGet, "/cloudapi/1.0.0/orgVdcNetworks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]any{"resultTotal": len(networks), "pageCount": 1, "page": 1, "pageSize": len(networks), "values": networks})
+	})
+
+	ca, err := (&client.CloudAvenue{
+		URL:        server.URL,
+		Org:        server.Org(),
+		Token:      fakeapi.DefaultAPIToken,
+		VCDVersion: fakeapi.APIVersion,
+	}).New()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The catalogs and the S3 buckets are skipped when the discovery is restricted to a VDC.
+	tests := []struct {
+		vdcName  string
+		expected []string
+	}{
+		{
+			// * The edge gateways and the networks of the VDC group of the VDC are discovered
+			vdcName: "vdc01",
+			expected: []string{
+				"cloudavenue_vdc vdc01",
+				"cloudavenue_edgegateway edge01",
+				"cloudavenue_edgegateway edge02",
+				"cloudavenue_network_isolated group01.net01",
+				"cloudavenue_network_isolated vdc01.net03",
+			},
+		},
+		{
+			vdcName: "vdc02",
+			expected: []string{
+				"cloudavenue_vdc vdc02",
+				"cloudavenue_edgegateway edge03",
+				"cloudavenue_network_routed " + edge03.ID + ".net02",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.vdcName, func(t *testing.T) {
+			d := &discoverer{client: ca, vdcName: tt.vdcName}
+			if err := d.run(); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			blocks := []string{}
+			for _, block := range d.blocks {
+				blocks = append(blocks, block.Type+" "+block.ID)
+			}
+			if !slices.Equal(blocks, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, blocks)
+			}
+		})
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
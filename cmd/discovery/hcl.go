@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const header = `# Generated by the Cloud Avenue discovery command.
+# The resource skeletons only contain the attributes identifying the objects:
+# complete them with the other required attributes before running terraform plan.
+`
+
+// invalidNameChars matches the characters not allowed in a resource name.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// importBlock is an object to import with its resource skeleton.
+type importBlock struct {
+	// Type is the resource type (cloudavenue_vm).
+	Type string
+	// Label is used to build the name of the resource.
+	Label string
+	// ID is the import ID in the format supported by the resource.
+	ID string
+	// Attributes are the attributes of the resource skeleton.
+	Attributes []attribute
+}
+
+// attribute is an attribute of a resource skeleton.
+type attribute struct {
+	Name  string
+	Value cty.Value
+}
+
+// render returns the import blocks and the resource skeletons in HCL.
+func render(blocks []importBlock) []byte {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	names := map[string]bool{}
+
+	for _, b := range blocks {
+		name := resourceName(b.Type, b.Label, names)
+
+		body.AppendNewline()
+		importBody := body.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: b.Type},
+			hcl.TraverseAttr{Name: name},
+		})
+		importBody.SetAttributeValue("id", cty.StringVal(b.ID))
+
+		body.AppendNewline()
+		resourceBody := body.AppendNewBlock("resource", []string{b.Type, name}).Body()
+		for _, a := range b.Attributes {
+			resourceBody.SetAttributeValue(a.Name, a.Value)
+		}
+	}
+
+	return append([]byte(header), f.Bytes()...)
+}
+
+// resourceName returns a valid resource name built from the label,
+// unique for the resource type.
+func resourceName(resourceType, label string, names map[string]bool) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	switch {
+	case name == "":
+		name = "main"
+	case name[0] >= '0' && name[0] <= '9':
+		name = "_" + name
+	}
+
+	unique := name
+	for i := 2; names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[resourceType+"."+unique] = true
+
+	return unique
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	names := map[string]bool{}
+	tests := []struct {
+		resourceType string
+		label        string
+		expected     string
+	}{
+		{resourceType: "cloudavenue_vm", label: "My VM-01", expected: "my_vm_01"},
+		{resourceType: "cloudavenue_vm", label: "my.vm.01", expected: "my_vm_01_2"},
+		{resourceType: "cloudavenue_vapp", label: "my-vm-01", expected: "my_vm_01"},
+		{resourceType: "cloudavenue_vm", label: "01-vm", expected: "_01_vm"},
+		{resourceType: "cloudavenue_vm", label: "--", expected: "main"},
+	}
+
+	for _, tt := range tests {
+		if got := resourceName(tt.resourceType, tt.label, names); got != tt.expected {
+			t.Fatalf("expected %s, got %s", tt.expected, got)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	got := string(render([]importBlock{
+		{
+			Type:  "cloudavenue_vm",
+			Label: "vm01",
+			ID:    "vdc01.vapp01.urn:vcloud:vm:12345678-1234-1234-1234-123456789012",
+			Attributes: []attribute{
+				{Name: "name", Value: cty.StringVal("vm01")},
+				{Name: "vdc", Value: cty.StringVal("vdc01")},
+				{Name: "vapp_name", Value: cty.StringVal("vapp01")},
+			},
+		},
+		{
+			Type:  "cloudavenue_network_routed",
+			Label: "net01",
+			ID:    "edge01.net01",
+			Attributes: []attribute{
+				{Name: "name", Value: cty.StringVal("net01")},
+				{Name: "prefix_length", Value: cty.NumberIntVal(24)},
+			},
+		},
+	}))
+
+	expected := header + `
+import {
+  to = cloudavenue_vm.vm01
+  id = "vdc01.vapp01.urn:vcloud:vm:12345678-1234-1234-1234-123456789012"
+}
+
+resource "cloudavenue_vm" "vm01" {
+  name      = "vm01"
+  vdc       = "vdc01"
+  vapp_name = "vapp01"
+}
+
+import {
+  to = cloudavenue_network_routed.net01
+  id = "edge01.net01"
+}
+
+resource "cloudavenue_network_routed" "net01" {
+  name          = "net01"
+  prefix_length = 24
+}
+`
+	if got != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, strings.TrimSpace(got))
+	}
+}
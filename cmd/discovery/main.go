@@ -0,0 +1,73 @@
+// Command discovery walks an existing Cloud Avenue organization and generates
+// the Terraform import blocks (Terraform 1.5 and later) and the resource
+// skeletons of the objects it finds.
+//
+// The credentials are read from the same environment variables as the provider:
+// CLOUDAVENUE_URL, CLOUDAVENUE_ORG, CLOUDAVENUE_USER and CLOUDAVENUE_PASSWORD
+// or CLOUDAVENUE_TOKEN.
+//
+//	go run . -output imports.tf [-vdc my-vdc]
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+)
+
+const defaultURL = "https://console1.cloudavenue.orange-business.com"
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	output := flag.String("output", "imports.tf", "The file where the import blocks and the resource skeletons are written.")
+	vdcName := flag.String("vdc", "", "Only discover the objects of this VDC (all the VDCs of the organization by default).")
+	flag.Parse()
+
+	url := os.Getenv("CLOUDAVENUE_URL")
+	if url == "" {
+		url = defaultURL
+	}
+
+	c := client.CloudAvenue{
+		URL:        url,
+		Org:        os.Getenv("CLOUDAVENUE_ORG"),
+		User:       os.Getenv("CLOUDAVENUE_USER"),
+		Password:   os.Getenv("CLOUDAVENUE_PASSWORD"),
+		Token:      os.Getenv("CLOUDAVENUE_TOKEN"),
+		VCDVersion: provider.VCDVersion,
+	}
+	if c.Org == "" {
+		log.Fatal().Msg("CLOUDAVENUE_ORG is not set")
+	}
+	if c.Token == "" && (c.User == "" || c.Password == "") {
+		log.Fatal().Msg("set CLOUDAVENUE_TOKEN or both CLOUDAVENUE_USER and CLOUDAVENUE_PASSWORD")
+	}
+
+	cA, err := c.New()
+	if err != nil {
+		log.Fatal().Err(err).Msg("unable to create the Cloud Avenue client")
+	}
+
+	d := &discoverer{client: cA, vdcName: *vdcName}
+	if err := d.run(); err != nil {
+		log.Fatal().Err(err).Msg("unable to discover the organization")
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		log.Fatal().Err(err).Msgf("unable to create %s", *output)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(render(d.blocks)); err != nil {
+		log.Fatal().Err(err).Msgf("unable to write %s", *output) //nolint:gocritic
+	}
+
+	log.Info().Msgf("%d import blocks written to %s", len(d.blocks), *output)
+}
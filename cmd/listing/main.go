@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/migration"
 	caProvider "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
 
 	_ "github.com/vmware/terraform-provider-vcd/v3/vcd"
@@ -27,38 +28,7 @@ var (
 	blue   = color.New(color.FgBlue)
 	yellow = color.New(color.FgYellow)
 
-	vcdEquivalentCA = map[string]string{
-		"vcd_catalog_access_control ":          "cloudavenue_catalog_acl",
-		"vcd_independent_disk":                 "cloudavenue_vm_disk",
-		"vcd_inserted_media":                   "cloudavenue_vm_inserted_media",
-		"vcd_network_isolated_v2":              "cloudavenue_network_isolated",
-		"vcd_network_routed_v2":                "cloudavenue_network_routed",
-		"vcd_nsxt_alb_pool":                    "cloudavenue_alb_pool",
-		"vcd_nsxt_app_port_profile":            "cloudavenue_edgegateway_app_port_profile",
-		"vcd_nsxt_edgegateway":                 "cloudavenue_edgegateway",
-		"vcd_nsxt_firewall":                    "cloudavenue_edgegateway_firewall",
-		"vcd_nsxt_ip_set":                      "cloudavenue_edgegateway_ip_set",
-		"vcd_nsxt_ipsec_vpn_tunnel":            "cloudavenue_edgegateway_ipsec_vpn_tunnel",
-		"vcd_nsxt_nat_rule":                    "cloudavenue_edgegateway_nat_rule",
-		"vcd_nsxt_network_dhcp_binding ":       "cloudavenue_network_dhcp_binding",
-		"vcd_nsxt_security_group":              "cloudavenue_edgegateway_security_group",
-		"vcd_vapp_network":                     "cloudavenue_vapp_isolated_network",
-		"vcd_vapp_vm":                          "cloudavenue_vm",
-		"vcd_vapp_access_control":              "cloudavenue_vapp_acl",
-		"vcd_vm_internal_disk":                 "cloudavenue_vm_disk",
-		"vcd_org_group ":                       "cloudavenue_iam_group",
-		"vcd_org_user":                         "cloudavenue_iam_user",
-		"vcd_org_vdc":                          "cloudavenue_vdc",
-		"vcd_org_vdc_access_control":           "cloudavenue_vdc_acl",
-		"vcd_role":                             "cloudavenue_iam_role",
-		"vcd_security_tag":                     "cloudavenue_vm_security_tag",
-		"vcd_right":                            "cloudavenue_iam_right",
-		"vcd_nsxt_network_dhcp":                "cloudavenue_network_dhcp",
-		"vcd_nsxt_network_dhcp_binding":        "cloudavenue_network_dhcp_binding",
-		"vcd_vm":                               "cloudavenue_vm",
-		"vcd_nsxt_edgegateway_dhcp_forwarding": "cloudavenue_edgegateway_dhcp_forwarding",
-		"vcd_nsxt_edgegateway_static_route":    "cloudavenue_edgegateway_static_route",
-	}
+	vcdEquivalentCA = equivalentCA()
 
 	vcdNotApplicableCA = []string{
 		"vcd_catalog_item",
@@ -134,6 +104,18 @@ var (
 	}
 )
 
+// equivalentCA returns the Cloud Avenue equivalents of the vcd resources and data sources.
+// The resources are shared with the migration tool.
+func equivalentCA() map[string]string {
+	equivalents := migration.VCDEquivalents()
+
+	// Data sources only
+	equivalents["vcd_org_group"] = "cloudavenue_iam_group"
+	equivalents["vcd_right"] = "cloudavenue_iam_right"
+
+	return equivalents
+}
+
 //nolint:all
 func main() {
 
@@ -0,0 +1,98 @@
+// Command migrate rewrites the Terraform configuration of the vmware/vcd provider
+// with the equivalent resources of the Cloud Avenue provider.
+//
+// The migrated files are written in the output directory with a migration.tf file
+// containing the moved blocks (Terraform 1.8 and later) of the migrated resources or,
+// with the -state flag, the import and removed blocks (Terraform 1.7 and later)
+// built from the state of the vcd resources.
+// The attributes and blocks without equivalent are commented out and logged.
+//
+//	go run . -dir ../../my-config -out migrated [-state terraform.tfstate]
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/migration"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+)
+
+const migrationFile = "migration.tf"
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	dir := flag.String("dir", ".", "The directory of the configuration written for the vcd provider.")
+	out := flag.String("out", "migrated", "The directory where the migrated configuration is written.")
+	statePath := flag.String("state", "", "The state of the vcd resources (terraform state pull) used to build import blocks instead of moved blocks.")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*dir, "*.tf"))
+	if err != nil {
+		log.Fatal().Err(err).Msgf("unable to list the configuration files of %s", *dir)
+	}
+	if len(files) == 0 {
+		log.Fatal().Msgf("no configuration file found in %s", *dir)
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal().Err(err).Msgf("unable to create %s", *out)
+	}
+
+	m := migration.Migrator{
+		Schemas: migration.SchemasFromProvider(context.Background(), provider.New("migrate")(), "cloudavenue"),
+	}
+
+	var (
+		moves []migration.Move
+		diags []migration.Diagnostic
+	)
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("unable to read %s", file)
+		}
+
+		result, err := m.File(filepath.Base(file), src)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("unable to migrate %s", file)
+		}
+		moves = append(moves, result.Moves...)
+		diags = append(diags, result.Diagnostics...)
+
+		if err := os.WriteFile(filepath.Join(*out, filepath.Base(file)), result.Src, 0o644); err != nil { //nolint:gosec
+			log.Fatal().Err(err).Msgf("unable to write the migration of %s", file)
+		}
+	}
+
+	blocks := migration.MovedBlocks(moves)
+	if *statePath != "" {
+		state, err := os.Open(*statePath)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("unable to open %s", *statePath)
+		}
+		defer state.Close()
+
+		imports, stateDiags, err := migration.Imports(state, moves)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("unable to read %s", *statePath) //nolint:gocritic
+		}
+		diags = append(diags, stateDiags...)
+		blocks = migration.ImportBlocks(imports)
+	}
+
+	if err := os.WriteFile(filepath.Join(*out, migrationFile), blocks, 0o644); err != nil { //nolint:gosec
+		log.Fatal().Err(err).Msgf("unable to write %s", migrationFile)
+	}
+
+	for _, d := range diags {
+		log.Warn().Msg(d.String())
+	}
+	log.Info().Msgf("%d resources migrated to %s, %d elements to migrate manually", len(moves), *out, len(diags))
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// allowlistEntry is a violation to ignore. The address is the type of the resource,
+// or data.<type> for a data source. An entry without path ignores the violations
+// of the rule by all the attributes of the resource or the data source.
+type allowlistEntry struct {
+	Rule    string
+	Address string
+	Path    string
+
+	used bool
+}
+
+type allowlist []*allowlistEntry
+
+// parseAllowlist parses the content of an allowlist file.
+func parseAllowlist(content string) (allowlist, error) {
+	var entries allowlist
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("line %d: expected <rule> <address> [<attribute path>], got %q", i+1, line)
+		}
+		if _, ok := findRule(fields[0]); !ok {
+			return nil, fmt.Errorf("line %d: unknown rule %q", i+1, fields[0])
+		}
+
+		e := &allowlistEntry{Rule: fields[0], Address: fields[1]}
+		if len(fields) == 3 {
+			e.Path = fields[2]
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// filter returns the violations not matching an entry of the allowlist.
+func (l allowlist) filter(violations []violation) []violation {
+	var kept []violation
+	for _, v := range violations {
+		allowed := false
+		for _, e := range l {
+			if e.matches(v) {
+				e.used = true
+				allowed = true
+			}
+		}
+		if !allowed {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// unused returns the entries of the checked rules which matched no violation.
+func (l allowlist) unused(checked []rule) []*allowlistEntry {
+	var entries []*allowlistEntry
+	for _, e := range l {
+		if e.used {
+			continue
+		}
+		for _, r := range checked {
+			if r.Name == e.Rule {
+				entries = append(entries, e)
+				break
+			}
+		}
+	}
+	return entries
+}
+
+// matches returns true if the entry allows the violation.
+func (e *allowlistEntry) matches(v violation) bool {
+	return e.Rule == v.Rule && e.Address == v.address() && (e.Path == "" || e.Path == v.Path)
+}
+
+// String returns the entry as written in the allowlist file.
+func (e *allowlistEntry) String() string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s", e.Rule, e.Address, e.Path))
+}
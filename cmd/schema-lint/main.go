@@ -0,0 +1,89 @@
+// Command schema-lint loads the schemas of every resource and data source of
+// the provider and reports the attributes that break the rules of the
+// provider (missing descriptions, ForceNew not documented, id/name pairs
+// without ExactlyOneOf, long-running resources without timeouts...).
+//
+// The known violations can be ignored with an allowlist file, one violation
+// per line: the rule, the resource type or data.<type> for a data source and
+// optionally the path of the attribute. Lines starting with # are comments.
+//
+//	# The name is computed from the ID.
+//	exactly-one-of cloudavenue_vm vapp_name
+//
+// The command exits with a non-zero status if a violation is not allowlisted.
+//
+//	go run . [-allowlist allowlist.txt] [-rules markdown-description,timeouts]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+)
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	allowlistFile := flag.String("allowlist", "", "The file listing the violations to ignore.")
+	ruleNames := flag.String("rules", "", "The comma-separated list of the rules to check (all the rules by default).")
+	flag.Parse()
+
+	selected, err := selectRules(*ruleNames)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid rules")
+	}
+
+	allowed := allowlist{}
+	if *allowlistFile != "" {
+		content, err := os.ReadFile(*allowlistFile)
+		if err != nil {
+			log.Fatal().Err(err).Msg("unable to read the allowlist")
+		}
+		if allowed, err = parseAllowlist(string(content)); err != nil {
+			log.Fatal().Err(err).Str("file", *allowlistFile).Msg("invalid allowlist")
+		}
+	}
+
+	schemas, err := loadSchemas(provider.New("lint")())
+	if err != nil {
+		log.Fatal().Err(err).Msg("unable to load the schemas")
+	}
+
+	violations := allowed.filter(lint(schemas, selected))
+	for _, v := range violations {
+		fmt.Println(v)
+	}
+	for _, e := range allowed.unused(selected) {
+		log.Warn().Str("entry", e.String()).Msg("the allowlist entry does not match any violation and can be removed")
+	}
+
+	if len(violations) > 0 {
+		log.Error().Int("violations", len(violations)).Int("schemas", len(schemas)).Msg("the schemas do not follow the rules")
+		os.Exit(1)
+	}
+	log.Info().Int("schemas", len(schemas)).Msg("the schemas follow the rules")
+}
+
+// selectRules returns the rules of the comma-separated list of names, all the rules if the list is empty.
+func selectRules(names string) ([]rule, error) {
+	if names == "" {
+		return rules, nil
+	}
+
+	var selected []rule
+	for _, name := range strings.Split(names, ",") {
+		r, ok := findRule(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown rule %q", name)
+		}
+		selected = append(selected, r)
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
+)
+
+// forceNewTag is the tag added by superschema to the description of the attributes forcing a new resource.
+const forceNewTag = "(ForceNew)"
+
+// longRunningResources are the resources waiting for vCD tasks lasting several minutes.
+var longRunningResources = map[string]bool{
+	"cloudavenue_catalog":     true,
+	"cloudavenue_edgegateway": true,
+	"cloudavenue_publicip":    true,
+	"cloudavenue_s3_bucket":   true,
+	"cloudavenue_vapp":        true,
+	"cloudavenue_vdc":         true,
+	"cloudavenue_vdc_group":   true,
+	"cloudavenue_vm":          true,
+}
+
+// rule is a rule the schemas of the provider must follow.
+type rule struct {
+	Name        string
+	Description string
+	check       func(s *schema) []violation
+}
+
+// violation is an attribute breaking a rule.
+type violation struct {
+	Rule     string
+	Kind     string
+	TypeName string
+	// Path is the path of the attribute, empty if the violation is about the schema itself.
+	Path    string
+	Message string
+	Fix     string
+}
+
+var rules = []rule{
+	{
+		Name:        "markdown-description",
+		Description: "The schemas and the attributes have a MarkdownDescription.",
+		check:       checkMarkdownDescription,
+	},
+	{
+		Name:        "force-new-description",
+		Description: "The description of the attributes forcing a new resource says it.",
+		check:       checkForceNewDescription,
+	},
+	{
+		Name:        "exactly-one-of",
+		Description: "The optional <name>_id and <name>_name attributes have an ExactlyOneOf validator.",
+		check:       checkExactlyOneOf,
+	},
+	{
+		Name:        "timeouts",
+		Description: "The long-running resources have a timeouts attribute.",
+		check:       checkTimeouts,
+	},
+}
+
+// findRule returns the rule with the name.
+func findRule(name string) (rule, bool) {
+	for _, r := range rules {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return rule{}, false
+}
+
+// lint returns the violations of the rules by the schemas.
+func lint(schemas []*schema, rules []rule) []violation {
+	var violations []violation
+	for _, s := range schemas {
+		for _, r := range rules {
+			for _, v := range r.check(s) {
+				v.Rule, v.Kind, v.TypeName = r.Name, s.Kind, s.TypeName
+				violations = append(violations, v)
+			}
+		}
+	}
+	return violations
+}
+
+// address returns the address of the resource or the data source, as in the Terraform configurations.
+func (v violation) address() string {
+	if v.Kind == kindDataSource {
+		return "data." + v.TypeName
+	}
+	return v.TypeName
+}
+
+// String returns the violation as an actionable report line.
+func (v violation) String() string {
+	target := v.address()
+	if v.Path != "" {
+		target += " " + v.Path
+	}
+	return fmt.Sprintf("%s: [%s] %s. Fix: %s.", target, v.Rule, v.Message, v.Fix)
+}
+
+func checkMarkdownDescription(s *schema) (violations []violation) {
+	s.Root.walk(func(a *attribute) {
+		// superschema adds a dot to the end of the descriptions, even the empty ones.
+		if strings.Trim(a.MarkdownDescription, ". ") != "" {
+			return
+		}
+		// The timeouts attribute is generated by the timeouts package with plain descriptions.
+		if a.Path == "timeouts" || strings.HasPrefix(a.Path, "timeouts.") {
+			return
+		}
+
+		v := violation{
+			Path:    a.Path,
+			Message: "the attribute has no MarkdownDescription",
+			Fix:     "set the MarkdownDescription in the Common, Resource or DataSource part of the superschema attribute",
+		}
+		if a.Path == "" {
+			v.Message = "the schema has no MarkdownDescription"
+			v.Fix = "set the MarkdownDescription of the superschema.Schema"
+		}
+		violations = append(violations, v)
+	})
+	return violations
+}
+
+func checkForceNewDescription(s *schema) (violations []violation) {
+	if s.Kind != kindResource {
+		return nil
+	}
+
+	s.Root.walk(func(a *attribute) {
+		if !requiresReplace(a) {
+			return
+		}
+
+		d := a.description()
+		if strings.Contains(d, forceNewTag) || strings.Contains(d, helpers.ForceNewDescription) {
+			return
+		}
+
+		violations = append(violations, violation{
+			Path:    a.Path,
+			Message: "the attribute forces a new resource but its description does not say it",
+			Fix:     "use the RequiresReplace plan modifier, for which superschema adds " + forceNewTag + " to the description, or add helpers.ForceNewDescription to the MarkdownDescription",
+		})
+	})
+	return violations
+}
+
+func checkExactlyOneOf(s *schema) (violations []violation) {
+	s.Root.walk(func(a *attribute) {
+		for _, id := range a.Attributes {
+			prefix, ok := strings.CutSuffix(id.Name, "_id")
+			if !ok {
+				continue
+			}
+			name := a.attribute(prefix + "_name")
+			if name == nil || !id.Optional || !name.Optional {
+				continue
+			}
+
+			for _, c := range []*attribute{id, name} {
+				if hasValidator(c, "ExactlyOneOf") {
+					continue
+				}
+				violations = append(violations, violation{
+					Path:    c.Path,
+					Message: fmt.Sprintf("the attributes %s and %s are both optional but %s has no ExactlyOneOf validator", id.Name, name.Name, c.Name),
+					Fix:     fmt.Sprintf("add the validator stringvalidator.ExactlyOneOf on the paths of %s and %s", id.Name, name.Name),
+				})
+			}
+		}
+	})
+	return violations
+}
+
+func checkTimeouts(s *schema) []violation {
+	if s.Kind != kindResource || !longRunningResources[s.TypeName] || s.Root.attribute("timeouts") != nil {
+		return nil
+	}
+
+	return []violation{{
+		Message: "the resource waits for long-running tasks but has no timeouts attribute",
+		Fix:     "add a superschema.TimeoutAttribute named timeouts and use its values in the CRUD methods",
+	}}
+}
+
+// requiresReplace returns true if a plan modifier of the attribute forces a new resource.
+func requiresReplace(a *attribute) bool {
+	for _, pm := range a.PlanModifiers {
+		name := strings.ToLower(typeName(pm))
+		if strings.Contains(name, "requiresreplace") || strings.Contains(name, "requirereplace") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasValidator returns true if the attribute has a validator whose type name contains the name.
+func hasValidator(a *attribute, name string) bool {
+	for _, v := range a.Validators {
+		if strings.Contains(typeName(v), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeName returns the name of the type of the value, without its package and pointer.
+func typeName(v any) string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+	return t.Name()
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	exactlyOneOf := []validator.String{
+		stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_id"), path.MatchRoot("edge_gateway_name")),
+	}
+
+	resourceSchema := schemaR.Schema{
+		MarkdownDescription: "The vm resource.",
+		Attributes: map[string]schemaR.Attribute{
+			"edge_gateway_id": schemaR.StringAttribute{
+				MarkdownDescription: "(ForceNew) The ID of the edge gateway.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          exactlyOneOf,
+			},
+			"edge_gateway_name": schemaR.StringAttribute{
+				MarkdownDescription: "The name of the edge gateway.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured()},
+			},
+			"vapp_id": schemaR.StringAttribute{
+				MarkdownDescription: "The ID of the vApp. " + helpers.ForceNewDescription,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"vapp_name": schemaR.StringAttribute{
+				MarkdownDescription: "The name of the vApp.",
+				Computed:            true,
+			},
+			"settings": schemaR.SingleNestedAttribute{
+				MarkdownDescription: "The settings of the VM.",
+				Optional:            true,
+				Attributes: map[string]schemaR.Attribute{
+					"name": schemaR.StringAttribute{
+						MarkdownDescription: ".",
+						Optional:            true,
+					},
+				},
+			},
+			"disks": schemaR.ListNestedAttribute{
+				MarkdownDescription: "The disks of the VM.",
+				Optional:            true,
+				NestedObject: schemaR.NestedAttributeObject{
+					Attributes: map[string]schemaR.Attribute{
+						"size": schemaR.Int64Attribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	dataSourceSchema := schemaD.Schema{
+		Attributes: map[string]schemaD.Attribute{
+			"edge_gateway_id": schemaD.StringAttribute{
+				MarkdownDescription: "The ID of the edge gateway.",
+				Optional:            true,
+			},
+			"edge_gateway_name": schemaD.StringAttribute{
+				MarkdownDescription: "The name of the edge gateway.",
+				Optional:            true,
+				Validators:          exactlyOneOf,
+			},
+		},
+	}
+
+	violations := lint([]*schema{
+		newSchema(kindResource, "cloudavenue_vm", resourceSchema),
+		newSchema(kindDataSource, "cloudavenue_vm", dataSourceSchema),
+	}, rules)
+
+	expected := []string{
+		"cloudavenue_vm disks.size markdown-description",
+		"cloudavenue_vm settings.name markdown-description",
+		"cloudavenue_vm edge_gateway_name force-new-description",
+		"cloudavenue_vm edge_gateway_name exactly-one-of",
+		"cloudavenue_vm  timeouts",
+		"data.cloudavenue_vm  markdown-description",
+		"data.cloudavenue_vm edge_gateway_id exactly-one-of",
+	}
+
+	if len(violations) != len(expected) {
+		t.Fatalf("expected %d violations, got %d: %v", len(expected), len(violations), violations)
+	}
+	for i, v := range violations {
+		if got := v.address() + " " + v.Path + " " + v.Rule; got != expected[i] {
+			t.Fatalf("expected the violation %q, got %q", expected[i], got)
+		}
+		if v.Message == "" || v.Fix == "" {
+			t.Fatalf("expected an actionable violation, got %v", v)
+		}
+	}
+}
+
+func TestAllowlist(t *testing.T) {
+	t.Parallel()
+
+	allowed, err := parseAllowlist(`
+# The name is computed.
+exactly-one-of cloudavenue_vm edge_gateway_name
+markdown-description data.cloudavenue_vm
+timeouts cloudavenue_vapp
+`)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	violations := allowed.filter([]violation{
+		{Rule: "exactly-one-of", Kind: kindResource, TypeName: "cloudavenue_vm", Path: "edge_gateway_name"},
+		{Rule: "exactly-one-of", Kind: kindResource, TypeName: "cloudavenue_vm", Path: "edge_gateway_id"},
+		{Rule: "markdown-description", Kind: kindDataSource, TypeName: "cloudavenue_vm", Path: "name"},
+		{Rule: "markdown-description", Kind: kindResource, TypeName: "cloudavenue_vm", Path: "name"},
+	})
+
+	if len(violations) != 2 || violations[0].Path != "edge_gateway_id" || violations[1].Kind != kindResource {
+		t.Fatalf("expected the edge_gateway_id and resource name violations, got %v", violations)
+	}
+
+	timeouts, _ := findRule("timeouts")
+	if unused := allowed.unused([]rule{timeouts}); len(unused) != 1 || unused[0].String() != "timeouts cloudavenue_vapp" {
+		t.Fatalf("expected the timeouts entry to be unused, got %v", unused)
+	}
+	if unused := allowed.unused(nil); len(unused) != 0 {
+		t.Fatalf("expected no unused entry of the unchecked rules, got %v", unused)
+	}
+
+	for _, content := range []string{"timeouts", "unknown cloudavenue_vm", "timeouts cloudavenue_vm a b"} {
+		if _, err := parseAllowlist(content); err == nil {
+			t.Fatalf("expected an error for %q, got none", content)
+		}
+	}
+}
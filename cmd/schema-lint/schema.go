@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	kindResource   = "resource"
+	kindDataSource = "data source"
+)
+
+// schema is the schema of a resource or a data source.
+type schema struct {
+	Kind     string
+	TypeName string
+	// Root holds the description and the attributes of the schema.
+	Root *attribute
+}
+
+// attribute is an attribute or a block of a schema, whatever its type.
+type attribute struct {
+	Name                string
+	Path                string
+	MarkdownDescription string
+	Description         string
+	Optional            bool
+	Required            bool
+	Computed            bool
+	Validators          []any
+	PlanModifiers       []any
+	Attributes          []*attribute
+}
+
+// loadSchemas returns the schemas of the resources and the data sources of the provider sorted by type name.
+func loadSchemas(p provider.Provider) ([]*schema, error) {
+	ctx := context.Background()
+
+	metadata := &provider.MetadataResponse{}
+	p.Metadata(ctx, provider.MetadataRequest{}, metadata)
+
+	var schemas []*schema
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		m := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: metadata.TypeName}, m)
+
+		s := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, s)
+		if s.Diagnostics.HasError() {
+			return nil, fmt.Errorf("unable to get the schema of the resource %s: %v", m.TypeName, s.Diagnostics)
+		}
+
+		schemas = append(schemas, newSchema(kindResource, m.TypeName, s.Schema))
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+
+		m := &datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: metadata.TypeName}, m)
+
+		s := &datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, s)
+		if s.Diagnostics.HasError() {
+			return nil, fmt.Errorf("unable to get the schema of the data source %s: %v", m.TypeName, s.Diagnostics)
+		}
+
+		schemas = append(schemas, newSchema(kindDataSource, m.TypeName, s.Schema))
+	}
+
+	sort.SliceStable(schemas, func(i, j int) bool {
+		if schemas[i].TypeName != schemas[j].TypeName {
+			return schemas[i].TypeName < schemas[j].TypeName
+		}
+		return schemas[i].Kind == kindResource && schemas[j].Kind != kindResource
+	})
+
+	return schemas, nil
+}
+
+// newSchema returns the schema of a resource/schema.Schema or a datasource/schema.Schema.
+func newSchema(kind, typeName string, s any) *schema {
+	return &schema{
+		Kind:     kind,
+		TypeName: typeName,
+		Root:     newAttribute("", "", reflect.ValueOf(s)),
+	}
+}
+
+// newAttribute returns the attribute of a schema, an attribute or a block.
+// The schema packages of the framework have a struct per attribute type, so the fields
+// shared by the types (MarkdownDescription, Validators, Attributes...) are read by reflection.
+func newAttribute(name, parentPath string, v reflect.Value) *attribute {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	a := &attribute{
+		Name: name,
+		Path: name,
+	}
+	if parentPath != "" {
+		a.Path = parentPath + "." + name
+	}
+	if v.Kind() != reflect.Struct {
+		return a
+	}
+
+	a.MarkdownDescription = stringField(v, "MarkdownDescription")
+	a.Description = stringField(v, "Description")
+	a.Optional = boolField(v, "Optional")
+	a.Required = boolField(v, "Required")
+	a.Computed = boolField(v, "Computed")
+	a.Validators = elements(field(v, "Validators"))
+	a.PlanModifiers = elements(field(v, "PlanModifiers"))
+
+	// The nested attributes are in the Attributes and Blocks fields of the schema,
+	// of the single nested attributes and blocks, or of the NestedObject of the others.
+	children := []reflect.Value{field(v, "Attributes"), field(v, "Blocks")}
+	if nested := field(v, "NestedObject"); nested.Kind() == reflect.Struct {
+		children = append(children, field(nested, "Attributes"), field(nested, "Blocks"))
+	}
+
+	for _, c := range children {
+		if c.Kind() != reflect.Map {
+			continue
+		}
+		for _, key := range c.MapKeys() {
+			a.Attributes = append(a.Attributes, newAttribute(key.String(), a.Path, c.MapIndex(key)))
+		}
+	}
+	sort.Slice(a.Attributes, func(i, j int) bool {
+		return a.Attributes[i].Name < a.Attributes[j].Name
+	})
+
+	return a
+}
+
+// field returns the field of the struct, or the zero value if the struct has no such field.
+func field(v reflect.Value, name string) reflect.Value {
+	f := v.FieldByName(name)
+	if !f.IsValid() || !f.CanInterface() {
+		return reflect.Value{}
+	}
+	return f
+}
+
+// stringField returns the value of the string field of the struct, "" if the struct has no such field.
+func stringField(v reflect.Value, name string) string {
+	if f := field(v, name); f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+// boolField returns the value of the bool field of the struct, false if the struct has no such field.
+func boolField(v reflect.Value, name string) bool {
+	f := field(v, name)
+	return f.Kind() == reflect.Bool && f.Bool()
+}
+
+// elements returns the elements of the slice.
+func elements(v reflect.Value) []any {
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+
+	values := make([]any, 0, v.Len())
+	for i := range v.Len() {
+		values = append(values, v.Index(i).Interface())
+	}
+	return values
+}
+
+// attribute returns the nested attribute with the name.
+func (a *attribute) attribute(name string) *attribute {
+	for _, c := range a.Attributes {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// walk calls fn for the attribute and all its nested attributes.
+func (a *attribute) walk(fn func(*attribute)) {
+	fn(a)
+	for _, c := range a.Attributes {
+		c.walk(fn)
+	}
+}
+
+// description returns the description of the attribute, the markdown one if it is set.
+func (a *attribute) description() string {
+	if a.MarkdownDescription != "" {
+		return a.MarkdownDescription
+	}
+	return a.Description
+}
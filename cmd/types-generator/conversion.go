@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+)
+
+const conversionTemplate = `package {{ .PackageName }}
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	{{ .APIAlias }} "{{ .APIPath }}"
+)
+
+// To{{ .APIName }} returns the {{ .APIName }} representation of the model.
+func (rm *{{ .Model }}) To{{ .APIName }}(ctx context.Context) (*{{ .APIAlias }}.{{ .APIName }}, diag.Diagnostics) {
+	return &{{ .APIAlias }}.{{ .APIName }}{
+		{{- range .Conversions }}
+		{{- if .Getter }}
+		{{ .Field }}: rm.{{ .Attribute }}.{{ .Getter }}(),
+		{{- else }}
+		// TODO rm.{{ .Attribute }}: {{ .Reason }}
+		{{- end }}
+		{{- end }}
+	}, nil
+}
+
+// From{{ .APIName }} sets the model from the {{ .APIName }} representation.
+func (rm *{{ .Model }}) From{{ .APIName }}(ctx context.Context, v *{{ .APIAlias }}.{{ .APIName }}) diag.Diagnostics {
+	{{- range .Conversions }}
+	{{- if .Setter }}
+	rm.{{ .Attribute }}.{{ .Setter }}(v.{{ .Field }})
+	{{- else }}
+	// TODO rm.{{ .Attribute }}: {{ .Reason }}
+	{{- end }}
+	{{- end }}
+
+	return nil
+}
+`
+
+// apiAliases are the aliases used in the provider to import the API packages.
+var apiAliases = map[string]string{
+	"github.com/vmware/go-vcloud-director/v2/govcd":     "govcd",
+	"github.com/vmware/go-vcloud-director/v2/types/v56": "govcdtypes",
+}
+
+// accessors are the supertypes getters and setters of the Go types by schema type.
+var accessors = map[schemaType]map[string][2]string{
+	schemaTypeString: {
+		"string":  {"Get", "Set"},
+		"*string": {"GetPtr", "SetPtr"},
+	},
+	schemaTypeBool: {
+		"bool":  {"Get", "Set"},
+		"*bool": {"GetPtr", "SetPtr"},
+	},
+	schemaTypeInt64: {
+		"int64":  {"Get", "Set"},
+		"*int64": {"GetPtr", "SetPtr"},
+		"int":    {"GetInt", "SetInt"},
+		"*int":   {"GetIntPtr", "SetIntPtr"},
+		"int32":  {"GetInt32", "SetInt32"},
+		"*int32": {"GetInt32Ptr", "SetInt32Ptr"},
+	},
+	schemaTypeFloat64: {
+		"float64":  {"Get", "Set"},
+		"*float64": {"GetPtr", "SetPtr"},
+		"float32":  {"GetFloat32", "SetFloat32"},
+		"*float32": {"GetFloat32Ptr", "SetFloat32Ptr"},
+	},
+}
+
+// apiStruct is a struct of an API package (govcd, cloudavenue-sdk-go...).
+type apiStruct struct {
+	PackagePath string
+	PackageName string
+	Name        string
+	Fields      []apiField
+}
+
+// apiField is a field of an API struct.
+type apiField struct {
+	Name string
+	// Tag is the name of the field in its json or xml tag.
+	Tag  string
+	Type string
+}
+
+// conversion is the conversion of an attribute of the model.
+type conversion struct {
+	Attribute string
+	Field     string
+	Getter    string
+	Setter    string
+	Reason    string
+}
+
+// loadAPIStruct loads the struct of the API type (github.com/vmware/go-vcloud-director/v2/types/v56.NsxtAlbPool).
+func loadAPIStruct(apiType string) (*apiStruct, error) {
+	i := strings.LastIndex(apiType, ".")
+	if i <= 0 || strings.LastIndex(apiType, "/") > i {
+		return nil, fmt.Errorf("invalid API type %q, expected an import path followed by a type name", apiType)
+	}
+
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", apiType[:i]).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the package %s: %w", apiType[:i], err)
+	}
+
+	return parseAPIStruct(strings.TrimSpace(string(out)), apiType[:i], apiType[i+1:])
+}
+
+// parseAPIStruct parses the struct from the sources of the package in dir.
+func parseAPIStruct(dir, packagePath, name string) (*apiStruct, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					structType, ok := typeSpec.Type.(*ast.StructType)
+					if !ok || typeSpec.Name.Name != name {
+						continue
+					}
+
+					s := &apiStruct{
+						PackagePath: packagePath,
+						PackageName: pkg.Name,
+						Name:        name,
+					}
+					for _, field := range structType.Fields.List {
+						for _, fieldName := range field.Names {
+							if !fieldName.IsExported() {
+								continue
+							}
+							s.Fields = append(s.Fields, apiField{
+								Name: fieldName.Name,
+								Tag:  fieldTag(field.Tag),
+								Type: types.ExprString(field.Type),
+							})
+						}
+					}
+
+					return s, nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("struct %s not found in %s", name, dir)
+}
+
+// fieldTag returns the name of the field in its json tag, or in its xml tag if it has no json tag.
+func fieldTag(tag *ast.BasicLit) string {
+	if tag == nil {
+		return ""
+	}
+
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return ""
+	}
+
+	for _, key := range []string{"json", "xml"} {
+		name, _, _ := strings.Cut(reflect.StructTag(value).Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// field returns the field matching the attribute by its tag or its name.
+func (s *apiStruct) field(attribute string) *apiField {
+	for i, f := range s.Fields {
+		if f.Tag != "" && strcase.ToSnake(f.Tag) == attribute {
+			return &s.Fields[i]
+		}
+	}
+	for i, f := range s.Fields {
+		if strcase.ToSnake(f.Name) == attribute {
+			return &s.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+// conversions returns the conversions of the attributes sorted by name.
+func (s *apiStruct) conversions(attributes map[string]schemaType) []conversion {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	conversions := make([]conversion, 0, len(names))
+	for _, name := range names {
+		c := conversion{Attribute: goName(name)}
+
+		f := s.field(name)
+		if f == nil {
+			c.Reason = fmt.Sprintf("no field of %s matches the attribute %s", s.Name, name)
+			conversions = append(conversions, c)
+			continue
+		}
+
+		c.Field = f.Name
+		if a, ok := accessors[attributes[name]][f.Type]; ok {
+			c.Getter, c.Setter = a[0], a[1]
+		} else {
+			c.Reason = fmt.Sprintf("convert %s.%s (%s) from and to %s", s.Name, f.Name, f.Type, attributes[name].ToTerraformValue())
+		}
+		conversions = append(conversions, c)
+	}
+
+	return conversions
+}
+
+// generateConversion returns the content of the file converting the model from and to the API struct.
+func generateConversion(packageName, model string, s *apiStruct, attributes map[string]schemaType) ([]byte, error) {
+	alias, ok := apiAliases[s.PackagePath]
+	if !ok {
+		alias = s.PackageName
+	}
+
+	data := struct {
+		PackageName string
+		Model       string
+		APIAlias    string
+		APIPath     string
+		APIName     string
+		Conversions []conversion
+	}{
+		PackageName: packageName,
+		Model:       model,
+		APIAlias:    alias,
+		APIPath:     s.PackagePath,
+		APIName:     s.Name,
+		Conversions: s.conversions(attributes),
+	}
+
+	tmpl, err := template.New("conversion").Parse(conversionTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
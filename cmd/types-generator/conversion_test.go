@@ -0,0 +1,134 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testAPISource = `package v56
+
+type NsxtPool struct {
+	ID          string   ` + "`json:\"id,omitempty\"`" + `
+	Name        string   ` + "`json:\"name\"`" + `
+	Enabled     *bool    ` + "`json:\"enabled,omitempty\"`" + `
+	MemberCount int      ` + "`json:\"memberCount\"`" + `
+	Members     []string ` + "`json:\"members\"`" + `
+	GatewayRef  string   ` + "`xml:\"gatewayRef,attr\"`" + `
+	internal    string
+}
+`
+
+func TestGoName(t *testing.T) { //nolint:paralleltest // goName reads the global acronyms
+	acronyms["ID"] = true
+
+	tests := map[string]string{
+		"name":            "Name",
+		"edge_gateway_id": "EdgeGatewayID",
+		"dhcp_servers":    "DhcpServers",
+	}
+
+	for name, want := range tests {
+		if got := goName(name); got != want {
+			t.Fatalf("expected %s, got %s", want, got)
+		}
+	}
+}
+
+func TestConversions(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pool.go"), []byte(testAPISource), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := parseAPIStruct(dir, "github.com/vmware/go-vcloud-director/v2/types/v56", "NsxtPool")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(s.Fields) != 6 {
+		t.Fatalf("expected 6 exported fields, got %d", len(s.Fields))
+	}
+
+	conversions := s.conversions(map[string]schemaType{
+		"name":         schemaTypeString,
+		"enabled":      schemaTypeBool,
+		"member_count": schemaTypeInt64,
+		"members":      schemaTypeSet,
+		"gateway_ref":  schemaTypeString,
+		"description":  schemaTypeString,
+	})
+
+	tests := []struct {
+		attribute, field, getter, setter string
+	}{
+		{"Description", "", "", ""},
+		{"Enabled", "Enabled", "GetPtr", "SetPtr"},
+		{"GatewayRef", "GatewayRef", "Get", "Set"},
+		{"MemberCount", "MemberCount", "GetInt", "SetInt"},
+		{"Members", "Members", "", ""},
+		{"Name", "Name", "Get", "Set"},
+	}
+
+	if len(conversions) != len(tests) {
+		t.Fatalf("expected %d conversions, got %d", len(tests), len(conversions))
+	}
+	for i, tt := range tests {
+		c := conversions[i]
+		if c.Attribute != tt.attribute || c.Field != tt.field || c.Getter != tt.getter || c.Setter != tt.setter {
+			t.Fatalf("expected %+v, got %+v", tt, c)
+		}
+		if (c.Getter == "") != (c.Reason != "") {
+			t.Fatalf("expected a reason for the conversions without accessors, got %+v", c)
+		}
+	}
+
+	content, err := generateConversion("edgegw", "PoolModel", s, map[string]schemaType{"name": schemaTypeString})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, want := range []string{
+		`govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"`,
+		"func (rm *PoolModel) ToNsxtPool(ctx context.Context) (*govcdtypes.NsxtPool, diag.Diagnostics) {",
+		"Name: rm.Name.Get(),",
+		"func (rm *PoolModel) FromNsxtPool(ctx context.Context, v *govcdtypes.NsxtPool) diag.Diagnostics {",
+		"rm.Name.Set(v.Name)",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Fatalf("expected the conversion to contain %q, got %s", want, content)
+		}
+	}
+
+	if _, err := parseAPIStruct(dir, "v56", "Unknown"); err == nil {
+		t.Fatalf("expected an error for an unknown struct, got none")
+	}
+}
+
+func TestStaleFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	upToDate := filepath.Join(dir, "up_to_date.go")
+	changed := filepath.Join(dir, "changed.go")
+	missing := filepath.Join(dir, "missing.go")
+
+	for file, content := range map[string]string{upToDate: "package x\n", changed: "package y\n"} {
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stale, err := staleFiles(map[string][]byte{
+		upToDate: []byte("package x\n"),
+		changed:  []byte("package x\n"),
+		missing:  []byte("package x\n"),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(stale) != 2 || stale[0] != changed || stale[1] != missing {
+		t.Fatalf("expected %s and %s to be stale, got %v", changed, missing, stale)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"reflect"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -173,15 +174,30 @@ type golangCI struct {
 
 var (
 	KeyValueStore = &map[string]any{}
+
+	// acronyms are the words written in upper case in the go names (ID, VDC...).
+	acronyms = map[string]bool{}
 )
 
+// goName returns the go name of the snake case name with the acronyms in upper case (edge_gateway_id => EdgeGatewayID).
+func goName(s string) string {
+	words := strings.Split(s, "_")
+	for i, w := range words {
+		if acronyms[strings.ToUpper(w)] {
+			words[i] = strings.ToUpper(w)
+		} else {
+			words[i] = strcase.ToCamel(w)
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
 var templateFuncs = template.FuncMap{
 	"toLowerCamel": func(s string) string {
 		return strcase.ToLowerCamel(s)
 	},
-	"toUpperCamel": func(s string) string {
-		return strcase.ToCamel(s)
-	},
+	"toUpperCamel": goName,
 	"toSnakeCase": func(s string) string {
 		return strcase.ToSnake(s)
 	},
@@ -267,11 +283,19 @@ func main() {
 	isResource := new(bool)
 	isDataSource := new(bool)
 	filePath := new(string)
+	genTypes := new(bool)
+	genSchemaTest := new(bool)
+	apiType := new(string)
+	check := new(bool)
 
 	flag.StringVar(filePath, "file", "", "file path")
 	flag.StringVar(resourceName, "resource", "", "resource name")
 	flag.BoolVar(isResource, "is-resource", false, "is resource")
 	flag.BoolVar(isDataSource, "is-data-source", false, "is data source")
+	flag.BoolVar(genTypes, "types", true, "generate the model types")
+	flag.BoolVar(genSchemaTest, "schema-test", false, "generate the unit tests of the schemas")
+	flag.StringVar(apiType, "api-type", "", "generate the conversion stubs between the model and the API type (github.com/vmware/go-vcloud-director/v2/types/v56.NsxtAlbPool) if they do not exist")
+	flag.BoolVar(check, "check", false, "fail if the generated files are stale instead of writing them")
 	flag.Parse()
 
 	if *resourceName == "" || (!*isResource && !*isDataSource) || *filePath == "" {
@@ -291,28 +315,36 @@ func main() {
 		log.Fatal().Err(err).Msg("Failed to parse file")
 	}
 
-	varNaming := make([]string, 0)
-
-	// get all var-naming rules
+	// get all var-naming rules, the second argument lists the forced rules
 	for _, rule := range golangCI.LintersSettings.Revive.Rules {
-		if rule.Name == "var-naming" {
-			for _, arg := range rule.Arguments {
-				varNaming = append(varNaming, arg[0].(string))
+		if rule.Name == "var-naming" && len(rule.Arguments) > 1 {
+			for _, arg := range rule.Arguments[1] {
+				acronyms[strings.ToUpper(arg.(string))] = true
 			}
 		}
 	}
 
-	// configure var-naming rules
-	for _, v := range varNaming {
-		strcase.ConfigureAcronym(strings.ToUpper(v), strings.ToLower(v))
-	}
-
 	log.Info().Msgf("Looking for resource %s", *resourceName)
 
+	packageName, err := getPackageName(*filePath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to get package name")
+	}
+
 	ctx := context.Background()
 
 	cavP := provider.New(provider.VCDVersion)
 
+	var (
+		// files are the generated files by path.
+		files       = make(map[string][]byte)
+		schemaTests []schemaTest
+		typeData    any
+		typesFile   string
+		modelName   string
+		attributes  map[string]schemaType
+	)
+
 	if *isResource {
 		for _, res := range cavP().Resources(ctx) {
 			metadataResponse := &resource.MetadataResponse{}
@@ -335,46 +367,26 @@ func main() {
 					metadataResponse.TypeName = strings.TrimPrefix(metadataResponse.TypeName, first+"_")
 				}
 
-				packageName, err := getPackageName(*filePath)
-				if err != nil {
-					log.Fatal().Err(err).Msg("Failed to get package name")
-				}
-
-				tD := templateDataResource{
+				typeData = templateDataResource{
 					Name:        metadataResponse.TypeName,
 					PackageName: packageName,
 					Attributes:  resp.Schema.Attributes,
 				}
-
-				tmpl, err := template.New("template").Funcs(templateFuncs).Parse(typeTemplate)
-				if err != nil {
-					log.Fatal().Err(err).Msg("Failed to parse template")
-				}
-
-				var tplTypes bytes.Buffer
-
-				if err := tmpl.Execute(&tplTypes, tD); err != nil {
-					log.Fatal().Err(err).Msg("Failed to execute template")
-					return
-				}
-
-				file := strings.TrimSuffix(*filePath, "_schema.go") + "_types.go"
-
-				if err := os.WriteFile(file, tplTypes.Bytes(), 0600); err != nil {
-					log.Fatal().Err(err).Msg("Failed to write file")
-					return
-				}
-
-				// format go file
-				cmd := exec.Command("gofmt", "-s", "-w", file)
-				if err := cmd.Run(); err != nil {
-					log.Fatal().Err(err).Msg("Failed to format file")
-					return
-				}
-				return
+				typesFile = strings.TrimSuffix(*filePath, "_schema.go") + "_types.go"
+				modelName = goName(metadataResponse.TypeName) + "Model"
+				attributes = schemaTypes(resp.Schema.Attributes)
+				schemaTests = append(schemaTests, newSchemaTest("resource", *resourceName, res))
+				break
 			}
 		}
-	} else if *isDataSource {
+
+		if typeData == nil {
+			log.Fatal().Msgf("Resource %s not found", *resourceName)
+		}
+	}
+
+	if *isDataSource {
+		found := false
 		for _, res := range cavP().DataSources(ctx) {
 			metadataResponse := &datasource.MetadataResponse{}
 			res().Metadata(ctx, datasource.MetadataRequest{}, metadataResponse)
@@ -382,52 +394,140 @@ func main() {
 			log.Info().Msgf("Find data source %s", metadataResponse.TypeName)
 			if "cloudavenue"+metadataResponse.TypeName == *resourceName {
 				log.Info().Msgf("Found data source %s", *resourceName)
+				found = true
 
-				resp := &datasource.SchemaResponse{}
-				res().Schema(ctx, datasource.SchemaRequest{}, resp)
+				schemaTests = append(schemaTests, newSchemaTest("data source", *resourceName, res))
 
-				packageName, err := getPackageName(*filePath)
-				if err != nil {
-					log.Fatal().Err(err).Msg("Failed to get package name")
+				// The model of the resource is used if both are generated.
+				if typeData != nil {
+					break
 				}
 
-				tD := templateDataDataSource{
+				resp := &datasource.SchemaResponse{}
+				res().Schema(ctx, datasource.SchemaRequest{}, resp)
+
+				typeData = templateDataDataSource{
 					Name:        metadataResponse.TypeName,
 					PackageName: packageName,
 					Attributes:  resp.Schema.Attributes,
 				}
+				typesFile = strings.TrimSuffix(*filePath, ".go") + "_types.go"
+				modelName = goName(metadataResponse.TypeName) + "Model"
+				attributes = schemaTypes(resp.Schema.Attributes)
+				break
+			}
+		}
 
-				tmpl, err := template.New("template").Funcs(templateFuncs).Parse(typeTemplate)
-				if err != nil {
-					log.Fatal().Err(err).Msg("Failed to parse template")
-				}
+		if !found {
+			log.Fatal().Msgf("Data source %s not found", *resourceName)
+		}
+	}
 
-				var tplTypes bytes.Buffer
-				if err := tmpl.Execute(&tplTypes, tD); err != nil {
-					log.Fatal().Err(err).Msg("Failed to execute template")
-					return
-				}
+	if *genTypes {
+		tmpl, err := template.New("template").Funcs(templateFuncs).Parse(typeTemplate)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse template")
+		}
 
-				file := strings.TrimSuffix(*filePath, ".go") + "_types.go"
+		var tplTypes bytes.Buffer
+		if err := tmpl.Execute(&tplTypes, typeData); err != nil {
+			log.Fatal().Err(err).Msg("Failed to execute template")
+		}
+		files[typesFile] = tplTypes.Bytes()
+	}
 
-				if err := os.WriteFile(file, tplTypes.Bytes(), 0600); err != nil {
-					log.Fatal().Err(err).Msg("Failed to write file")
-					return
-				}
+	if *genSchemaTest {
+		tests, err := generateSchemaTests(packageName, schemaTests)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to generate the schema tests")
+		}
+		files[strings.TrimSuffix(strings.TrimSuffix(*filePath, ".go"), "_schema")+"_schema_test.go"] = tests
+	}
 
-				// format go file
-				cmd := exec.Command("gofmt", "-s", "-w", file)
-				if err := cmd.Run(); err != nil {
-					log.Fatal().Err(err).Msg("Failed to format file")
-					return
-				}
-				return
-			}
+	for file, content := range files {
+		if files[file], err = formatSource(content); err != nil {
+			log.Fatal().Err(err).Msgf("Failed to format file %s", file)
+		}
+	}
+
+	if *check {
+		stale, err := staleFiles(files)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to check the generated files")
+		}
+		if len(stale) > 0 {
+			log.Fatal().Strs("files", stale).Msg("Generated files are stale, run the types-generator without --check")
+		}
+		log.Info().Msg("Generated files are up to date")
+		return
+	}
+
+	for file, content := range files {
+		if err := os.WriteFile(file, content, 0o600); err != nil {
+			log.Fatal().Err(err).Msg("Failed to write file")
+		}
+	}
+
+	// The conversion stubs are completed by hand so they are never overwritten.
+	if *apiType != "" {
+		file := strings.TrimSuffix(strings.TrimSuffix(*filePath, ".go"), "_schema") + "_conversion.go"
+		if _, err := os.Stat(file); err == nil {
+			log.Info().Msgf("File %s already exists, the conversion stubs are not generated", file)
+			return
+		}
+
+		s, err := loadAPIStruct(*apiType)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to load the API type")
+		}
 
+		content, err := generateConversion(packageName, modelName, s, attributes)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to generate the conversion stubs")
+		}
+		if content, err = formatSource(content); err != nil {
+			log.Fatal().Err(err).Msgf("Failed to format file %s", file)
+		}
+		if err := os.WriteFile(file, content, 0o600); err != nil {
+			log.Fatal().Err(err).Msg("Failed to write file")
+		}
+	}
+}
+
+// schemaTypes returns the schema types of the attributes.
+func schemaTypes[T any](attributes map[string]T) map[string]schemaType {
+	schemaTypes := make(map[string]schemaType, len(attributes))
+	for name, a := range attributes {
+		schemaTypes[name] = NewSchemaType(reflect.TypeOf(a).String())
+	}
+
+	return schemaTypes
+}
+
+// formatSource formats the go source with gofmt -s.
+func formatSource(content []byte) ([]byte, error) {
+	cmd := exec.Command("gofmt", "-s")
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stderr = os.Stderr
+
+	return cmd.Output()
+}
+
+// staleFiles returns the sorted paths of the files whose content differs from the generated one.
+func staleFiles(files map[string][]byte) ([]string, error) {
+	stale := make([]string, 0)
+	for file, content := range files {
+		current, err := os.ReadFile(file)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if !bytes.Equal(current, content) {
+			stale = append(stale, file)
 		}
 	}
+	sort.Strings(stale)
 
-	log.Error().Msgf("Resource %s not found", *resourceName)
+	return stale, nil
 }
 
 func getPackageName(filename string) (string, error) {
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"path"
+	"reflect"
+	"runtime"
+	"strings"
+	"text/template"
+)
+
+const schemaTestTemplate = `package {{ .PackageName }}_test
+
+import (
+	"context"
+	"testing"
+{{ if .HasResource }}
+	// The fwresource import alias is so there is no collision
+	// with the more typical acceptance testing import:
+	// "github.com/hashicorp/terraform-plugin-testing/helper/resource".
+{{- end }}
+{{- if .HasDataSource }}
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+{{- end }}
+{{- if .HasResource }}
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+{{- end }}
+
+	{{ .Import }}
+)
+
+{{- range .Tests }}
+
+// Unit test for the schema of the {{ .Kind }} {{ .TypeName }}.
+func Test{{ .TestName }}Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &{{ .Alias }}.SchemaResponse{}
+
+	// Instantiate the {{ .Interface }} and call its Schema method
+	{{ $.PackageName }}.{{ .Constructor }}().Schema(ctx, {{ .Alias }}.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+{{- end }}
+`
+
+// schemaTest is the unit test of the schema of a resource or a data source.
+type schemaTest struct {
+	Kind        string
+	TypeName    string
+	Interface   string
+	Alias       string
+	Constructor string
+	TestName    string
+
+	packagePath string
+}
+
+// newSchemaTest returns the schema test of the resource or data source created by the constructor.
+func newSchemaTest(kind, typeName string, constructor any) schemaTest {
+	// The name of the function is the import path of its package followed by its name.
+	fullName := runtime.FuncForPC(reflect.ValueOf(constructor).Pointer()).Name()
+	dir, file := path.Split(fullName)
+	packageName, funcName, _ := strings.Cut(file, ".")
+
+	t := schemaTest{
+		Kind:        kind,
+		TypeName:    typeName,
+		Constructor: funcName,
+		TestName:    strings.TrimPrefix(funcName, "New"),
+		packagePath: dir + packageName,
+	}
+
+	if kind == "resource" {
+		t.Interface = "resource.Resource"
+		t.Alias = "fwresource"
+	} else {
+		t.Interface = "datasource.DataSource"
+		t.Alias = "fwdatasource"
+	}
+
+	return t
+}
+
+// generateSchemaTests returns the content of the schema test file.
+func generateSchemaTests(packageName string, tests []schemaTest) ([]byte, error) {
+	data := struct {
+		PackageName   string
+		Import        string
+		HasResource   bool
+		HasDataSource bool
+		Tests         []schemaTest
+	}{
+		PackageName: packageName,
+		Tests:       tests,
+	}
+
+	for _, t := range tests {
+		data.HasResource = data.HasResource || t.Alias == "fwresource"
+		data.HasDataSource = data.HasDataSource || t.Alias == "fwdatasource"
+	}
+
+	data.Import = `"` + tests[0].packagePath + `"`
+	if path.Base(tests[0].packagePath) != packageName {
+		data.Import = packageName + " " + data.Import
+	}
+
+	tmpl, err := template.New("schemaTest").Parse(schemaTestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,7 @@
+data "cloudavenue_alb_edgegateway_settings" "example" {
+  edge_gateway_name = "MyEdgeGatewayName"
+}
+
+output "alb_enabled" {
+  value = data.cloudavenue_alb_edgegateway_settings.example.enabled
+}
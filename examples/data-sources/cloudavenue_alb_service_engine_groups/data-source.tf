@@ -0,0 +1,7 @@
+data "cloudavenue_alb_service_engine_groups" "example" {
+  edge_gateway_name = "MyEdgeGatewayName"
+}
+
+output "service_engine_groups" {
+  value = data.cloudavenue_alb_service_engine_groups.example.service_engine_groups
+}
@@ -0,0 +1,3 @@
+data "cloudavenue_edgegateway_dns_forwarder" "example" {
+  edge_gateway_name = "myEdgeName"
+}
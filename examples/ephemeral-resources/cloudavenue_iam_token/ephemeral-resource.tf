@@ -0,0 +1,8 @@
+ephemeral "cloudavenue_iam_token" "example" {
+  name = "example"
+}
+
+provider "cloudavenue" {
+  alias = "token"
+  token = ephemeral.cloudavenue_iam_token.example.token
+}
@@ -0,0 +1,16 @@
+ephemeral "cloudavenue_s3_credential" "example" {}
+
+provider "aws" {
+  access_key = ephemeral.cloudavenue_s3_credential.example.access_key
+  secret_key = ephemeral.cloudavenue_s3_credential.example.secret_key
+  region     = "region01"
+
+  endpoints {
+    s3 = "https://s3-region01.cloudavenue.orange-business.com"
+  }
+
+  skip_credentials_validation = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+  s3_use_path_style           = true
+}
@@ -0,0 +1,3 @@
+output "vm_id" {
+  value = provider::cloudavenue::build_urn("vm", "12345678-1234-1234-1234-123456789012")
+}
@@ -0,0 +1,8 @@
+data "cloudavenue_vm" "example" {
+  name      = "my-vm"
+  vapp_name = "my-vapp"
+}
+
+output "vm_uuid" {
+  value = provider::cloudavenue::extract_uuid(data.cloudavenue_vm.example.id)
+}
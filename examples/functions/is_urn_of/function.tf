@@ -0,0 +1,8 @@
+variable "vdc_group_id" {
+  type = string
+
+  validation {
+    condition     = provider::cloudavenue::is_urn_of("vdcGroup", var.vdc_group_id)
+    error_message = "The vdc_group_id must be a VDC Group URN (urn:vcloud:vdcGroup:<uuid>)."
+  }
+}
@@ -0,0 +1,7 @@
+data "cloudavenue_vdc" "example" {
+  name = "my-vdc"
+}
+
+output "vdc_uuid" {
+  value = provider::cloudavenue::parse_urn(data.cloudavenue_vdc.example.id).uuid
+}
@@ -1,2 +1,3 @@
-# use the edge_gateway_name.alb_pool_name to import the ALB Pool
-terraform import cloudavenue_alb_pool.test edge_gateway_name.alb_pool_name
\ No newline at end of file
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# alb_pool is the name of the ALB pool
+terraform import cloudavenue_alb_pool.example edge_gateway.alb_pool
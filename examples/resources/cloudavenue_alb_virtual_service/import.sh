@@ -0,0 +1,3 @@
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# virtual_service is the name of the ALB virtual service
+terraform import cloudavenue_alb_virtual_service.example edge_gateway.virtual_service
@@ -0,0 +1,33 @@
+resource "cloudavenue_publicip" "example" {
+  edge_gateway_name = "MyEdgeGatewayName"
+}
+
+resource "cloudavenue_alb_pool" "example" {
+  edge_gateway_name = "MyEdgeGatewayName"
+  name              = "Example"
+
+  members = [
+    {
+      ip_address = "192.168.1.1"
+      port       = "80"
+    },
+    {
+      ip_address = "192.168.1.2"
+      port       = "80"
+    }
+  ]
+}
+
+resource "cloudavenue_alb_virtual_service" "example" {
+  edge_gateway_name        = "MyEdgeGatewayName"
+  name                     = "Example"
+  pool_id                  = cloudavenue_alb_pool.example.id
+  virtual_ip               = cloudavenue_publicip.example.public_ip
+  application_profile_type = "HTTP"
+
+  service_ports = [
+    {
+      start = 80
+    }
+  ]
+}
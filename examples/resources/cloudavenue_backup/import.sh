@@ -1 +1,3 @@
-terraform import cloudavenue_backup.example type.targetName
\ No newline at end of file
+# type is the scope of the backup (vdc, vapp or vm)
+# target is the name or URN of the VDC, vApp or VM
+terraform import cloudavenue_backup.example type.target
@@ -1,2 +1,2 @@
-# use the catalog_name to import the Catalog
-terraform import cloudavenue_catalog.example catalog_name
+# catalog is the name or URN (urn:vcloud:catalog:<uuid>) of the catalog
+terraform import cloudavenue_catalog.example catalog
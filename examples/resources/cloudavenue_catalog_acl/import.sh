@@ -1,3 +1,2 @@
-# use the catalog_name to import the Catalog
-terraform import cloudavenue_catalog_acl.example catalogNameOrId
-
+# catalog is the name or URN (urn:vcloud:catalog:<uuid>) of the catalog
+terraform import cloudavenue_catalog_acl.example catalog
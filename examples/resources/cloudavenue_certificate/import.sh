@@ -0,0 +1,2 @@
+# certificate is the alias or URN (urn:vcloud:certificateLibraryItem:<uuid>) of the certificate
+terraform import cloudavenue_certificate.example certificate
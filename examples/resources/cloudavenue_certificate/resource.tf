@@ -0,0 +1,10 @@
+resource "cloudavenue_certificate" "example" {
+  alias       = "example-certificate"
+  description = "Certificate of www.example.com"
+  certificate = file("${path.module}/certificate.pem")
+  private_key = file("${path.module}/private_key.pem")
+}
+
+output "certificate_expiration_date" {
+  value = cloudavenue_certificate.example.not_after
+}
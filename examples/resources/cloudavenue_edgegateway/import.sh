@@ -1,2 +1,2 @@
-# use the name to import the edge gateway
-terraform import cloudavenue_edgegateway.example MyEdgeName
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+terraform import cloudavenue_edgegateway.example edge_gateway
@@ -1 +1,3 @@
-terraform import cloudavenue.edgegateway_app_port_profile vdc-or-vdc-group-id.RuleName
+# vdc is the name or URN (urn:vcloud:vdc:<uuid> or urn:vcloud:vdcGroup:<uuid>) of the VDC or VDC group
+# app_port_profile is the name of the application port profile
+terraform import cloudavenue_edgegateway_app_port_profile.example vdc.app_port_profile
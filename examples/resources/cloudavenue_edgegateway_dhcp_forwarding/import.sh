@@ -1,2 +1,2 @@
-# use the name to import the edge gateway dhcp forwarding
-terraform import cloudavenue_edgegateway_dhcp_forwarding.example EdgeGatewayNameOrID
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+terraform import cloudavenue_edgegateway_dhcp_forwarding.example edge_gateway
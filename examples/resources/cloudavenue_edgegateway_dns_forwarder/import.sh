@@ -0,0 +1,2 @@
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+terraform import cloudavenue_edgegateway_dns_forwarder.example edge_gateway
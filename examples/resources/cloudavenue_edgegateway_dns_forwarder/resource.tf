@@ -0,0 +1,27 @@
+resource "cloudavenue_edgegateway_dns_forwarder" "example" {
+  edge_gateway_id = data.cloudavenue_edgegateway.example.id
+
+  default_forwarder_zone = {
+    name = "default"
+    upstream_servers = [
+      "1.1.1.1",
+      "8.8.8.8"
+    ]
+  }
+
+  conditional_forwarder_zones = [
+    {
+      name = "internal"
+      domain_names = [
+        "example.internal"
+      ]
+      upstream_servers = [
+        "192.168.10.10"
+      ]
+    }
+  ]
+}
+
+data "cloudavenue_edgegateway" "example" {
+  name = "myEdgeName"
+}
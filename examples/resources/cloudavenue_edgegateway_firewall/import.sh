@@ -1,2 +1,2 @@
-# use the name to import the edge gateway firewall
-terraform import cloudavenue_edgegateway_firewall.example EdgeGatewayNameOrID
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+terraform import cloudavenue_edgegateway_firewall.example edge_gateway
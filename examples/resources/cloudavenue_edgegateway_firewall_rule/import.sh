@@ -0,0 +1,3 @@
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# firewall_rule is the name or ID of the firewall rule
+terraform import cloudavenue_edgegateway_firewall_rule.example edge_gateway.firewall_rule
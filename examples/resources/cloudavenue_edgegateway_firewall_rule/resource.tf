@@ -0,0 +1,26 @@
+resource "cloudavenue_edgegateway_ip_set" "example" {
+  name              = "example"
+  edge_gateway_name = "myEdgeName"
+  ip_addresses = [
+    "192.168.1.0/24",
+  ]
+}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example" {
+  edge_gateway_name = "myEdgeName"
+  name              = "allow IN from example"
+  direction         = "IN"
+  action            = "ALLOW"
+  source_ids        = [cloudavenue_edgegateway_ip_set.example.id]
+  position          = "FIRST"
+}
+
+resource "cloudavenue_edgegateway_firewall_rule" "example_after" {
+  edge_gateway_name = "myEdgeName"
+  name              = "drop OUT to example"
+  direction         = "OUT"
+  action            = "DROP"
+  destination_ids   = [cloudavenue_edgegateway_ip_set.example.id]
+  position          = "AFTER"
+  position_rule_id  = cloudavenue_edgegateway_firewall_rule.example.id
+}
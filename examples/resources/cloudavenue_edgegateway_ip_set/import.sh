@@ -1 +1,3 @@
-terraform import cloudavenue_edgegateway_ip_set.example edgeGatewayIDOrName.ipSetName
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# ip_set is the name of the IP set
+terraform import cloudavenue_edgegateway_ip_set.example edge_gateway.ip_set
@@ -1 +1,3 @@
-terraform import cloudavenue_edgegateway_nat_rule.example edgeGatewayIDOrName.natRuleNameOrID
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# nat_rule is the name or ID of the NAT rule
+terraform import cloudavenue_edgegateway_nat_rule.example edge_gateway.nat_rule
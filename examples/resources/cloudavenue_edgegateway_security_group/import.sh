@@ -1 +1,3 @@
-terraform import cloudavenue_edgegateway_security_group.example edgeGatewayIDOrName.securityGroupIDOrName
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# security_group is the name or URN (urn:vcloud:firewallGroup:<uuid>) of the security group
+terraform import cloudavenue_edgegateway_security_group.example edge_gateway.security_group
@@ -1 +1,3 @@
-terraform import cloudavenue_edgegateway_static_route.example edgeGatewayIDOrName.staticRouteIDOrName
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# static_route is the name or ID of the static route
+terraform import cloudavenue_edgegateway_static_route.example edge_gateway.static_route
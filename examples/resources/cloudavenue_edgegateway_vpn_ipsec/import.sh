@@ -1 +1,3 @@
-terraform import cloudavenue_edgegateway_vpn_ipsec.example edgeGatewayIDOrName.vpnIPSecNameOrID
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# vpn_ipsec is the name or ID of the IPSec VPN tunnel
+terraform import cloudavenue_edgegateway_vpn_ipsec.example edge_gateway.vpn_ipsec
@@ -1,2 +1,2 @@
-# use the name to import the resource
-terraform import cloudavenue_iam_role.example name
\ No newline at end of file
+# role is the name of the role
+terraform import cloudavenue_iam_role.example role
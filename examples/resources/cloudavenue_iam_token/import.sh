@@ -0,0 +1,3 @@
+# token is the name or URN (urn:vcloud:token:<uuid>) of the API token of the user of the provider
+# The token itself cannot be retrieved after its creation.
+terraform import cloudavenue_iam_token.example token
@@ -1,2 +1,2 @@
-# use the user-name to import the User
-terraform import cloudavenue_iam_user.example exampleuser
+# user is the name or URN (urn:vcloud:user:<uuid>) of the user
+terraform import cloudavenue_iam_user.example user
@@ -1 +1,2 @@
-terraform import cloudavenue_network_dhcp.example urn:vcloud:network:xxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxx
\ No newline at end of file
+# org_network is the URN (urn:vcloud:network:<uuid>) of the org network
+terraform import cloudavenue_network_dhcp.example org_network
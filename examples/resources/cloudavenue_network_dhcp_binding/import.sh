@@ -1 +1,3 @@
-terraform import cloudavenue_network_dhcp_binding.example orgNetworkID.dhcpBindingName
\ No newline at end of file
+# org_network is the URN (urn:vcloud:network:<uuid>) of the org network
+# dhcp_binding is the name of the DHCP binding
+terraform import cloudavenue_network_dhcp_binding.example org_network.dhcp_binding
@@ -1 +1,3 @@
-terraform import cloudavenue_network_isolated.example vdc-or-vdc-group-name.NetworkName
+# vdc is the name or URN (urn:vcloud:vdc:<uuid> or urn:vcloud:vdcGroup:<uuid>) of the VDC or VDC group
+# network is the name of the isolated network
+terraform import cloudavenue_network_isolated.example vdc.network
@@ -1,2 +1,3 @@
-terraform import EdgeGatewayName.NetworkName Or EdgeGatewayID.NetworkName
-
+# edge_gateway is the name or URN (urn:vcloud:gateway:<uuid>) of the edge gateway
+# network is the name of the routed network
+terraform import cloudavenue_network_routed.example edge_gateway.network
@@ -1,3 +1,2 @@
-# use the `id` to import an existing public IP
-# `id` is the public IP
-terraform import cloudavenue_publicip.example <id>
+# public_ip is the public IP
+terraform import cloudavenue_publicip.example public_ip
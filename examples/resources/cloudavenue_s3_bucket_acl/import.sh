@@ -1 +1,2 @@
-terraform import cloudavenue_s3_bucket_acl.example bucketName
+# bucket is the name of the S3 bucket
+terraform import cloudavenue_s3_bucket_acl.example bucket
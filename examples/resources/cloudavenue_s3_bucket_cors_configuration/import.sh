@@ -1 +1,2 @@
-terraform import cloudavenue_s3_bucket_cors_configuration.example bucketName
\ No newline at end of file
+# bucket is the name of the S3 bucket
+terraform import cloudavenue_s3_bucket_cors_configuration.example bucket
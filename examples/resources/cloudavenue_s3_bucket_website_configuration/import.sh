@@ -1 +1,2 @@
-terraform import cloudavenue_s3_bucket_website_configuration.example bucket-name
+# bucket is the name of the S3 bucket
+terraform import cloudavenue_s3_bucket_website_configuration.example bucket
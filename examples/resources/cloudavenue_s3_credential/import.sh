@@ -0,0 +1,3 @@
+# access_key is the access key of the credential of the user of the provider
+# The secret key cannot be retrieved after its creation.
+terraform import cloudavenue_s3_credential.example access_key
@@ -1 +1,5 @@
-terraform import cloudavenue_vapp.example VDCName.VAppNameOrID Or VAppNameOrID
+# If vdc is not specified, the default VDC of the provider is used.
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+# vapp is the name or URN (urn:vcloud:vapp:<uuid>) of the vApp
+terraform import cloudavenue_vapp.example vapp
+terraform import cloudavenue_vapp.example vdc.vapp
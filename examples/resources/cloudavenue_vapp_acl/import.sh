@@ -1,5 +1,5 @@
-# if vdc is not specified, the default vdc will be used
-terraform import cloudavenue_vapp_acl.example vapp_name
-
-# if vdc is specified, the vdc will be used
-terraform import cloudavenue_vapp_acl.example vdc_name.vapp_name
\ No newline at end of file
+# If vdc is not specified, the default VDC of the provider is used.
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+# vapp is the name or URN (urn:vcloud:vapp:<uuid>) of the vApp
+terraform import cloudavenue_vapp_acl.example vapp
+terraform import cloudavenue_vapp_acl.example vdc.vapp
@@ -1,5 +1,6 @@
-# if vdc is not specified, the default vdc will be used
-terraform import cloudavenue_vm_disk.example vapp_name.network_name
-
-# if vdc is specified, the vdc will be used
-terraform import cloudavenue_vm_disk.example vdc.vapp_name.network_name
+# If vdc is not specified, the default VDC of the provider is used.
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+# vapp is the name or URN (urn:vcloud:vapp:<uuid>) of the vApp
+# network is the name of the org network
+terraform import cloudavenue_vapp_org_network.example vapp.network
+terraform import cloudavenue_vapp_org_network.example vdc.vapp.network
@@ -1,3 +1,2 @@
-# You can import an existing VCDA IP resource with the `ip_address` attribute.
-
-terraform import cloudavenue_vcda_ip.example xx.xx.xx.xx
\ No newline at end of file
+# ip_address is the IP address
+terraform import cloudavenue_vcda_ip.example ip_address
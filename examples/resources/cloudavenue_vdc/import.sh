@@ -1,3 +1,2 @@
-# VDC can be imported using the name.
-
-terraform import cloudavenue_vdc.example name
\ No newline at end of file
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+terraform import cloudavenue_vdc.example vdc
@@ -1,2 +1,2 @@
-# use the vdc to import the resource
-terraform import cloudavenue_vdc_acl.example vdc
\ No newline at end of file
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+terraform import cloudavenue_vdc_acl.example vdc
@@ -1 +1,2 @@
-terraform import cloudavenue_vdc_group.example vdcGroupNameOrID
\ No newline at end of file
+# vdc_group is the name or URN (urn:vcloud:vdcGroup:<uuid>) of the VDC group
+terraform import cloudavenue_vdc_group.example vdc_group
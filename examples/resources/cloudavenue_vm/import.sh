@@ -1,6 +1,6 @@
-# If `vDC` is not specified, the default `vDC` will be used
-# The `myVMID` is the ID of the VM. 
-terraform import cloudavenue_vm.example myVAPP.myVMID
-
-# or you can specify the vDC
-terraform import cloudavenue_vm.example myVDC.myVAPP.myVMID
+# If vdc is not specified, the default VDC of the provider is used.
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+# vapp is the name or URN (urn:vcloud:vapp:<uuid>) of the vApp
+# vm is the URN (urn:vcloud:vm:<uuid>) of the VM
+terraform import cloudavenue_vm.example vapp.vm
+terraform import cloudavenue_vm.example vdc.vapp.vm
@@ -1,6 +1,5 @@
-# If `vDC` is not specified, the default `vDC` will be used
-# The `affinityRuleIdentifier` can be either a name or an ID. If it is a name, it will succeed only if the name is unique.
-terraform import cloudavenue_vm_affinity_rule.example affinityRuleIdentifier
-
-# or you can specify the vDC
-terraform import cloudavenue_vm_affinity_rule.example myVDC.affinityRuleIdentifier
+# If vdc is not specified, the default VDC of the provider is used.
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+# affinity_rule is the name or ID of the affinity rule. A name must be unique in the VDC.
+terraform import cloudavenue_vm_affinity_rule.example affinity_rule
+terraform import cloudavenue_vm_affinity_rule.example vdc.affinity_rule
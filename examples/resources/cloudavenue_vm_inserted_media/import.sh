@@ -0,0 +1,6 @@
+# vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC
+# vapp is the name or URN (urn:vcloud:vapp:<uuid>) of the vApp
+# vm is the name of the VM
+# catalog is the name of the catalog
+# media is the name of the media
+terraform import cloudavenue_vm_inserted_media.example vdc.vapp.vm.catalog.media
@@ -1,2 +1,2 @@
-# use the id to import the resource
-terraform import cloudavenue_vm_security_tag.example securityTagName
\ No newline at end of file
+# security_tag is the name of the security tag
+terraform import cloudavenue_vm_security_tag.example security_tag
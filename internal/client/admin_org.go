@@ -13,7 +13,12 @@ type AdminOrg struct {
 
 // GetAdminOrg return the admin org using the name provided in the provider.
 func (c *CloudAvenue) GetAdminOrg() (*AdminOrg, error) {
-	x, err := c.Vmware.GetAdminOrgByNameOrId(c.Org)
+	vmware, err := c.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
+	x, err := vmware.GetAdminOrgByNameOrId(c.Org)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrg, err)
 	}
@@ -5,14 +5,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"os"
+	"sync"
+	"time"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
 	clientca "github.com/orange-cloudavenue/cloudavenue-sdk-go"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/consoles"
+	clients3 "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/s3"
+	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
+	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/netbackup"
 	apiclient "github.com/orange-cloudavenue/infrapi-sdk-go"
 )
 
+const (
+	// DefaultNetBackupEndpoint is the endpoint of the NetBackup API used when none is configured.
+	DefaultNetBackupEndpoint = "https://backup1.cloudavenue.orange-business.com/NetBackupSelfServiceNetBackupPanels/Api"
+
+	// s3EndpointEnv is the environment variable of the SDK overriding the endpoint of the S3 credentials API.
+	s3EndpointEnv = "S3_ENDPOINT"
+)
+
 var (
 	// ErrAuthFailed is returned when the authentication failed.
 	ErrAuthFailed = errors.New("authentication error")
@@ -23,6 +39,14 @@ var (
 	ErrVCDVersionEmpty = errors.New("empty vcd version")
 	// ErrConfigureNetBackup is returned when the configuration of netbackup failed.
 	ErrConfigureNetBackup = errors.New("error configuring netbackup")
+	// ErrConfigureCAVSDK is returned when the configuration of the cloudavenue sdk failed.
+	ErrConfigureCAVSDK = errors.New("error configuring cloudavenue sdk")
+	// ErrConfigureS3 is returned when the configuration of s3 failed.
+	ErrConfigureS3 = errors.New("error configuring s3")
+	// ErrConfigureTransport is returned when the TLS or proxy settings are invalid.
+	ErrConfigureTransport = errors.New("error configuring http transport")
+	// ErrAPITokenAuthFailed is returned when the authentication with an API token failed.
+	ErrAPITokenAuthFailed = errors.New("api token authentication error")
 )
 
 // CloudAvenue is the main struct for the CloudAvenue client.
@@ -31,60 +55,391 @@ type CloudAvenue struct {
 	VDC                string
 	User               string
 	Password           string
+	Token              string
 	URL                string
 	TerraformVersion   string
 	CloudAvenueVersion string
 
+	// RetryPolicy defines how transient API failures are retried.
+	RetryPolicy RetryPolicy
+	// Limiter limits the requests sent to the APIs, nil means unlimited.
+	Limiter *RequestLimiter
+	// TransportOpts defines the TLS and proxy settings of the HTTP clients.
+	TransportOpts TransportOpts
+	// VCR defines the record/replay transport used by the acceptance tests, disabled if empty.
+	VCR VCROpts
+
 	// API CLOUDAVENUE
-	APIClient *apiclient.APIClient
+	apiClient *apiclient.APIClient
 	Auth      context.Context
 
 	// API VMWARE
-	Vmware     *govcd.VCDClient
-	urlVmware  *url.URL
-	VCDVersion string
+	vmware           *govcd.VCDClient
+	vmwareAuthHeader string
+	vmwareInit       *lazyInit
+	sessionUser      *sessionUser
+	urlVmware        *url.URL
+	VCDVersion       string
 
 	// SDK CLOUDAVENUE
-	CAVSDK     *clientca.Client
-	CAVSDKOpts *clientca.ClientOpts
+	cavSDK        *clientca.Client
+	cavSDKInit    *lazyInit
+	netBackupSDK  *clientca.Client
+	netBackupInit *lazyInit
+	s3Init        *lazyInit
+	s3Config      *s3Config
+	CAVSDKOpts    *clientca.ClientOpts
+	// sdkRoutes are the routes of the SDK forwarder used by the SDK clients.
+	sdkRoutes *sdkRoutes
+
+	// token is the access token shared by the CloudAvenue and Vmware clients.
+	token *accessToken
+	// httpTransport is the HTTP transport shared by the clients.
+	httpTransport *http.Transport
+	// vcrTransport records or replays the requests sent with httpTransport.
+	vcrTransport *vcrTransport
 }
 
 // New creates a new CloudAvenue client.
+// No API is contacted here: each backend client is authenticated on first use
+// by its accessor (APIClient, Vmware, CAVSDK, NetBackup and S3).
 func (c *CloudAvenue) New() (*CloudAvenue, error) {
+	// API VMWARE
+	if err := c.configureVmware(); err != nil {
+		return nil, fmt.Errorf("%w : %w", ErrConfigureVmware, err)
+	}
+
+	if c.VCDVersion == "" {
+		return nil, ErrVCDVersionEmpty
+	}
+
+	// HTTP transport shared by the clients
+	httpTransport, err := c.TransportOpts.createHTTPTransport()
+	if err != nil {
+		return nil, fmt.Errorf("%w : %w", ErrConfigureTransport, err)
+	}
+	c.httpTransport = httpTransport
+
+	// Record/replay transport of the acceptance tests
+	if c.VCR.Enabled() {
+		values := map[string]string{
+			"REDACTED_PASSWORD":   c.Password,
+			"REDACTED_TOKEN":      c.Token,
+			vcrUserPlaceholder:    c.User,
+			"cav00ev00ocb0000000": c.Org,
+			"REDACTED_VDC":        c.VDC,
+		}
+		if c.CAVSDKOpts != nil && c.CAVSDKOpts.Netbackup != nil {
+			values["REDACTED_NETBACKUP_USER"] = c.CAVSDKOpts.Netbackup.Username
+			values["REDACTED_NETBACKUP_PASSWORD"] = c.CAVSDKOpts.Netbackup.Password
+		}
+		c.vcrTransport, err = newVCRTransport(c.VCR, c.httpTransport, values)
+		if err != nil {
+			return nil, fmt.Errorf("%w : %w", ErrConfigureVCR, err)
+		}
+	}
+
+	c.vmware = govcd.NewVCDClient(*c.urlVmware, c.TransportOpts.Insecure, govcd.WithAPIVersion(c.VCDVersion))
+
+	// The access token is shared by the CloudAvenue and Vmware clients
+	// and is refreshed transparently when it expires.
+	c.vmwareAuthHeader = govcd.AuthorizationHeader
+	c.token = newAccessToken(c.getTokenWithCredentials)
+	if c.UseAPIToken() {
+		c.vmwareAuthHeader = govcd.BearerTokenHeader
+		c.token = newAccessToken(c.getTokenWithAPIToken)
+	}
+	// govcd keeps the first token in the client, the transport replaces it in the headers
+	// (including the Authorization header) and in the JWT bearer grants after a refresh.
+	c.vmware.Client.Http.Transport = c.createTransport(c.vmwareAuthHeader, "")
+
 	// API CLOUDAVENUE
-	c.APIClient = apiclient.NewAPIClient(c.createConfiguration())
-	_, ret, err := c.APIClient.AuthenticationApi.GetToken(c.createBasicAuthContext())
+	c.apiClient = apiclient.NewAPIClient(c.createConfiguration())
+	// The access token is set on each request by the transport of the client.
+	c.Auth = createTokenInContext("")
+
+	c.vmwareInit = &lazyInit{}
+	c.sessionUser = &sessionUser{}
+	c.cavSDKInit = &lazyInit{}
+	c.netBackupInit = &lazyInit{}
+	c.s3Init = &lazyInit{}
+	c.s3Config = &s3Config{}
+	c.sdkRoutes = newSDKRoutes()
+
+	return c, nil
+}
+
+// APIClient returns the CloudAvenue API client.
+// The access token is retrieved on first use.
+func (c *CloudAvenue) APIClient() (*apiclient.APIClient, error) {
+	if _, err := c.token.Get(); err != nil {
+		return nil, err
+	}
+	return c.apiClient, nil
+}
+
+// Vmware returns the VMware VCD client.
+// The client is authenticated on first use.
+func (c *CloudAvenue) Vmware() (*govcd.VCDClient, error) {
+	if err := c.vmwareInit.Do(c.initVmware); err != nil {
+		return nil, err
+	}
+	return c.vmware, nil
+}
+
+// initVmware authenticates the VMware VCD client with the access token.
+func (c *CloudAvenue) initVmware() error {
+	token, err := c.token.Get()
 	if err != nil {
-		return nil, fmt.Errorf("%w : %w", ErrAuthFailed, err)
+		return err
 	}
-	token := ret.Header.Get("x-vmware-vcloud-access-token")
-	if token == "" {
-		return nil, ErrTokenEmpty
+
+	if err := c.vmware.SetToken(c.Org, c.vmwareAuthHeader, token); err != nil {
+		return fmt.Errorf("%w : %w", ErrConfigureVmware, err)
 	}
 
-	c.Auth = createTokenInContext(token)
+	session, err := c.vmware.Client.GetSessionInfo()
+	if err != nil {
+		return fmt.Errorf("%w : %w", ErrConfigureVmware, err)
+	}
+	c.token.SetLifetime(time.Duration(session.SessionIdleTimeoutMinutes) * time.Minute)
 
-	// API VMWARE
-	if err = c.configureVmware(); err != nil {
-		return nil, fmt.Errorf("%w : %w", ErrConfigureVmware, err)
+	if c.UseAPIToken() {
+		// The user is not provided with an API token, retrieve it from the session.
+		c.sessionUser.Set(session.User.Name)
+
+		if c.vcrTransport != nil {
+			if err := c.vcrTransport.addRedaction(vcrUserPlaceholder, session.User.Name); err != nil {
+				return fmt.Errorf("%w : %w", ErrConfigureVCR, err)
+			}
+		}
 	}
 
-	if c.VCDVersion == "" {
-		return nil, fmt.Errorf("%w : %w", ErrVCDVersionEmpty, err)
+	return nil
+}
+
+// CAVSDK returns the SDK client for the CloudAvenue services (edge gateways, VCDA...).
+// The client is authenticated on first use.
+func (c *CloudAvenue) CAVSDK() (*clientca.Client, error) {
+	if err := c.cavSDKInit.Do(func() (err error) {
+		opts := *c.CAVSDKOpts.CloudAvenue
+		if opts.Endpoint == "" {
+			console, err := consoles.FingByOrganizationName(opts.Org)
+			if err != nil {
+				return fmt.Errorf("%w : %w", ErrConfigureCAVSDK, err)
+			}
+			opts.Endpoint = console.GetURL()
+		}
+		transport := c.sdkTransport()
+		if c.UseAPIToken() {
+			// The CloudAvenue client of the SDK only supports basic authentication,
+			// its session is opened with the access token exchanged for the API token.
+			transport = &sessionTransport{
+				base: &tokenTransport{
+					base:   transport,
+					token:  c.token,
+					header: authorizationHeader,
+					prefix: bearerPrefix,
+				},
+				token: c.token,
+			}
+		}
+		if opts.Endpoint, err = c.forwardSDKEndpoint(opts.Endpoint, transport); err != nil {
+			return fmt.Errorf("%w : %w", ErrConfigureCAVSDK, err)
+		}
+
+		c.cavSDK, err = clientca.New(clientca.ClientOpts{CloudAvenue: &opts})
+		if err != nil {
+			return fmt.Errorf("%w : %w", ErrConfigureCAVSDK, err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	c.Vmware = govcd.NewVCDClient(*c.urlVmware, false, govcd.WithAPIVersion(c.VCDVersion))
-	if err = c.Vmware.SetToken(c.Org, govcd.AuthorizationHeader, token); err != nil {
-		return nil, fmt.Errorf("%w : %w", ErrConfigureVmware, err)
+	return c.cavSDK, nil
+}
+
+// NetBackup returns the NetBackup client of the SDK.
+// The client is configured on first use.
+func (c *CloudAvenue) NetBackup() (*netbackup.Netbackup, error) {
+	if err := c.netBackupInit.Do(func() (err error) {
+		opts := *c.CAVSDKOpts.Netbackup
+		if opts.Endpoint == "" {
+			opts.Endpoint = DefaultNetBackupEndpoint
+		}
+		if opts.Endpoint, err = c.forwardSDKEndpoint(opts.Endpoint, c.sdkTransport()); err != nil {
+			return fmt.Errorf("%w : %w", ErrConfigureNetBackup, err)
+		}
+
+		c.netBackupSDK, err = clientca.New(clientca.ClientOpts{Netbackup: &opts})
+		if err != nil {
+			return fmt.Errorf("%w : %w", ErrConfigureNetBackup, err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	// New SDK CloudAvenue
-	c.CAVSDK, err = clientca.New(*c.CAVSDKOpts)
+	return &c.netBackupSDK.V1.Netbackup, nil
+}
+
+// S3 returns the S3 client of the SDK.
+// The client is configured with the access token on first use and after each refresh.
+// The requests use the TLS and proxy settings and the request limiter of the provider.
+func (c *CloudAvenue) S3() (v1.S3Client, error) {
+	if err := c.s3Init.Do(c.initS3); err != nil {
+		return v1.S3Client{}, err
+	}
+	if err := c.setS3Token(); err != nil {
+		return v1.S3Client{}, err
+	}
+
+	s3Client, err := clients3.New()
 	if err != nil {
-		return nil, fmt.Errorf("%w : %w", ErrConfigureNetBackup, err)
+		return v1.S3Client{}, fmt.Errorf("%w : %w", ErrConfigureS3, err)
 	}
 
-	return c, nil
+	s3Client.Config.HTTPClient = &http.Client{
+		Transport: &limitTransport{
+			base:    c.getHTTPTransport(),
+			limiter: c.Limiter,
+		},
+	}
+	return v1.S3Client{S3: s3Client.S3}, nil
+}
+
+// initS3 retrieves the user name and the endpoint of the S3 credentials API required by the S3 client of the SDK.
+func (c *CloudAvenue) initS3() error {
+	if c.UseAPIToken() {
+		// The user name is retrieved from the VCD session.
+		if _, err := c.Vmware(); err != nil {
+			return err
+		}
+	}
+
+	// The endpoint can be overridden with the environment variable of the SDK.
+	endpoint := os.Getenv(s3EndpointEnv)
+	if endpoint == "" {
+		console, err := consoles.FingByOrganizationName(c.Org)
+		if err != nil {
+			return fmt.Errorf("%w : %w", ErrConfigureS3, err)
+		}
+		if !console.S3IsEnabled() {
+			return fmt.Errorf("%w : S3 service is not available in site %s", ErrConfigureS3, console.GetSiteID())
+		}
+		endpoint = console.GetS3Endpoint()
+	}
+
+	oseEndpoint, err := c.forwardSDKEndpoint(endpoint, c.sdkTransport())
+	if err != nil {
+		return fmt.Errorf("%w : %w", ErrConfigureS3, err)
+	}
+
+	c.s3Config.mu.Lock()
+	defer c.s3Config.mu.Unlock()
+	c.s3Config.oseEndpoint = oseEndpoint
+
+	return nil
+}
+
+// s3Config is the configuration last given to the S3 client of the SDK.
+type s3Config struct {
+	mu          sync.Mutex
+	token       string
+	oseEndpoint string
+}
+
+// setS3Token configures the S3 client of the SDK with the current access token.
+// The SDK keeps the token given to Init, so it is configured again after each refresh.
+// The configuration is also overwritten by the CloudAvenue client of the SDK.
+func (c *CloudAvenue) setS3Token() error {
+	token, err := c.token.Get()
+	if err != nil {
+		return err
+	}
+
+	c.s3Config.mu.Lock()
+	defer c.s3Config.mu.Unlock()
+
+	if token == c.s3Config.token && clients3.GetOSEEndpoint() == c.s3Config.oseEndpoint {
+		return nil
+	}
+
+	if err := clients3.Init(clients3.Opts{
+		OSEEndpoint:      c.s3Config.oseEndpoint,
+		Username:         c.GetUserName(),
+		OrganizationName: c.Org,
+		CAVToken:         token,
+	}); err != nil {
+		return fmt.Errorf("%w : %w", ErrConfigureS3, err)
+	}
+	c.s3Config.token = token
+
+	return nil
+}
+
+// getTokenWithCredentials retrieves an access token with the user and password.
+func (c *CloudAvenue) getTokenWithCredentials() (string, time.Duration, error) {
+	_, ret, err := c.apiClient.AuthenticationApi.GetToken(c.createBasicAuthContext())
+	if err != nil {
+		return "", 0, fmt.Errorf("%w : %w", ErrAuthFailed, err)
+	}
+	token := ret.Header.Get("x-vmware-vcloud-access-token")
+	if token == "" {
+		return "", 0, ErrTokenEmpty
+	}
+
+	return token, 0, nil
+}
+
+// getTokenWithAPIToken swaps the API token (refresh token) for an access token.
+func (c *CloudAvenue) getTokenWithAPIToken() (string, time.Duration, error) {
+	tokenRefresh, err := c.vmware.GetBearerTokenFromApiToken(c.Org, c.Token)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w : check that the token is valid, not revoked and belongs to the organization %s : %w", ErrAPITokenAuthFailed, c.Org, err)
+	}
+	if tokenRefresh.AccessToken == "" {
+		return "", 0, ErrTokenEmpty
+	}
+
+	return tokenRefresh.AccessToken, time.Duration(tokenRefresh.ExpiresIn) * time.Second, nil
+}
+
+// createTransport wraps the HTTP transport with the retry policy, the access token refresh
+// and the request limiter. authHeader and authPrefix define how the token is sent.
+// The limiter is the innermost layer: a request waiting for a token refresh does not hold
+// a slot, so the refresh request can be sent even with a single slot.
+func (c *CloudAvenue) createTransport(authHeader, authPrefix string) http.RoundTripper {
+	return &retryTransport{
+		base: &tokenTransport{
+			base: &limitTransport{
+				base:    c.getHTTPTransport(),
+				limiter: c.Limiter,
+			},
+			token:  c.token,
+			header: authHeader,
+			prefix: authPrefix,
+		},
+		policy: c.RetryPolicy,
+	}
+}
+
+// getHTTPTransport returns the HTTP transport shared by the clients or http.DefaultTransport if not configured.
+// The record/replay transport is returned when enabled.
+func (c *CloudAvenue) getHTTPTransport() http.RoundTripper {
+	if c.vcrTransport != nil {
+		return c.vcrTransport
+	}
+	if c.httpTransport == nil {
+		return http.DefaultTransport
+	}
+	return c.httpTransport
+}
+
+// UseAPIToken returns true if the client is configured to authenticate with an API token.
+func (c *CloudAvenue) UseAPIToken() bool {
+	return c.Token != ""
 }
 
 // createBasicAuthContext creates a new context with the basic auth values.
@@ -102,6 +457,9 @@ func (c *CloudAvenue) createConfiguration() *apiclient.Configuration {
 		BasePath:      c.URL,
 		DefaultHeader: make(map[string]string),
 		UserAgent:     c.createUserAgent(),
+		HTTPClient: &http.Client{
+			Transport: c.createTransport(authorizationHeader, bearerPrefix),
+		},
 	}
 }
 
@@ -142,6 +500,31 @@ func (c *CloudAvenue) GetOrgName() string {
 }
 
 // GetUserName() returns the name of the user.
+// With an API token, the name is retrieved from the VCD session once the Vmware client is initialized.
 func (c *CloudAvenue) GetUserName() string {
+	if c.User == "" && c.sessionUser != nil {
+		return c.sessionUser.Get()
+	}
 	return c.User
 }
+
+// sessionUser is the name of the user retrieved from the VCD session.
+// It is set by the lazy initialization of the Vmware client and read concurrently.
+type sessionUser struct {
+	mu   sync.RWMutex
+	name string
+}
+
+// Set sets the name of the user.
+func (u *sessionUser) Set(name string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.name = name
+}
+
+// Get returns the name of the user.
+func (u *sessionUser) Get() string {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.name
+}
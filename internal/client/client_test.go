@@ -2,9 +2,16 @@
 package client
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	clientca "github.com/orange-cloudavenue/cloudavenue-sdk-go"
+	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
 	apiclient "github.com/orange-cloudavenue/infrapi-sdk-go"
 )
 
@@ -149,6 +156,27 @@ func TestCloudAvenueClient(t *testing.T) {
 		}
 	})
 
+	t.Run("UseAPIToken", func(t *testing.T) {
+		t.Parallel()
+
+		ca := CloudAvenue{
+			Token: "t0k3n",
+		}
+
+		if !ca.UseAPIToken() {
+			t.Fatalf("expected client to use API token")
+		}
+
+		ca = CloudAvenue{
+			User:     "dasilva",
+			Password: "dasilva",
+		}
+
+		if ca.UseAPIToken() {
+			t.Fatalf("expected client to not use API token")
+		}
+	})
+
 	t.Run("DefaultVDCExist", func(t *testing.T) {
 		t.Parallel()
 
@@ -162,4 +190,81 @@ func TestCloudAvenueClient(t *testing.T) {
 			t.Fatalf("expected default vdc to exist")
 		}
 	})
+
+	t.Run("LazyInitialization", func(t *testing.T) {
+		t.Parallel()
+
+		var requestCount atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount.Add(1)
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		t.Cleanup(server.Close)
+
+		ca := &CloudAvenue{
+			URL:        server.URL,
+			Token:      "t0k3n",
+			Org:        "acme",
+			VCDVersion: "37.2",
+			CAVSDKOpts: &clientca.ClientOpts{},
+		}
+
+		c, err := ca.New()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if requestCount.Load() != 0 {
+			t.Fatalf("expected no request, got %d", requestCount.Load())
+		}
+
+		// The authentication error is returned by the accessor.
+		if _, err := c.Vmware(); !errors.Is(err, ErrAPITokenAuthFailed) {
+			t.Fatalf("expected error %v, got %v", ErrAPITokenAuthFailed, err)
+		}
+		if requestCount.Load() == 0 {
+			t.Fatalf("expected requests, got none")
+		}
+	})
+	t.Run("CAVSDKWithAPIToken", func(t *testing.T) {
+		t.Parallel()
+
+		var sessions, exchanges atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/sessions"):
+				sessions.Add(1)
+			case strings.Contains(r.URL.Path, "/oauth/"):
+				exchanges.Add(1)
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		t.Cleanup(server.Close)
+
+		ca := &CloudAvenue{
+			URL:        server.URL,
+			Token:      "t0k3n",
+			Org:        "acme",
+			VCDVersion: "37.2",
+			CAVSDKOpts: &clientca.ClientOpts{
+				CloudAvenue: &clientcloudavenue.Opts{
+					Endpoint:   server.URL,
+					Org:        "acme",
+					VCDVersion: "37.2",
+				},
+			},
+		}
+
+		c, err := ca.New()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		// The session of the SDK is opened with the access token exchanged for the API token.
+		if _, err := c.CAVSDK(); !errors.Is(err, ErrConfigureCAVSDK) {
+			t.Fatalf("expected error %v, got %v", ErrConfigureCAVSDK, err)
+		}
+		if sessions.Load() != 0 || exchanges.Load() == 0 {
+			t.Fatalf("expected the API token to be exchanged instead of a session, got %d sessions and %d exchanges", sessions.Load(), exchanges.Load())
+		}
+	})
 }
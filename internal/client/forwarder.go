@@ -0,0 +1,222 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sdkForwarder is a reverse proxy listening on the loopback interface which sends the requests
+// of the SDK clients (CloudAvenue, NetBackup and S3 credentials) with the transport of the provider.
+// The SDK creates its own HTTP clients and does not accept a custom transport, so the endpoints
+// given to the SDK are replaced by the URLs of the forwarder.
+// The forwarder is shared by the clients of the process and runs until the process exits.
+// The SDK warns that credentials are sent over HTTP: they only go through the loopback interface.
+type sdkForwarder struct {
+	init     *lazyInit
+	listener net.Listener
+
+	mu sync.RWMutex
+	// routes are the upstreams of the forwarder indexed by their random path prefix.
+	routes map[string]*httputil.ReverseProxy
+}
+
+// sdkForwarderReadHeaderTimeout is the time allowed to the SDK clients to send the headers of a request.
+const sdkForwarderReadHeaderTimeout = 30 * time.Second
+
+// defaultSDKForwarder is the forwarder shared by the clients of the process.
+var defaultSDKForwarder = &sdkForwarder{
+	init:   &lazyInit{},
+	routes: map[string]*httputil.ReverseProxy{},
+}
+
+// start starts the HTTP server of the forwarder on a random port of the loopback interface.
+func (f *sdkForwarder) start() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("unable to start the SDK forwarder: %w", err)
+	}
+	f.listener = listener
+
+	server := &http.Server{
+		Handler:           f,
+		ReadHeaderTimeout: tokenRefreshMargin,
+	}
+	go server.Serve(listener) //nolint:errcheck // the server runs until the process exits
+
+	return nil
+}
+
+// Register adds a route to the upstream endpoint sent with transport.
+// It returns the URL of the forwarder to use instead of endpoint and the function removing the route.
+func (f *sdkForwarder) Register(endpoint string, transport http.RoundTripper) (string, func(), error) {
+	if err := f.init.Do(f.start); err != nil {
+		return "", nil, err
+	}
+
+	target, err := url.Parse(endpoint)
+	if err != nil || target.Scheme == "" || target.Host == "" {
+		return "", nil, fmt.Errorf("invalid SDK endpoint %q", endpoint)
+	}
+
+	// The random prefix prevents other local processes from guessing the routes.
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	prefix := "/" + hex.EncodeToString(b)
+
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			// The prefix is not escaped, the escaped path (e.g. %2F in a name) is kept as is.
+			r.Out.URL.Path = strings.TrimPrefix(r.In.URL.Path, prefix)
+			r.Out.URL.RawPath = strings.TrimPrefix(r.In.URL.RawPath, prefix)
+			r.SetURL(target)
+		},
+		Transport: transport,
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+		},
+	}
+
+	f.mu.Lock()
+	f.routes[prefix] = proxy
+	f.mu.Unlock()
+
+	unregister := func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.routes, prefix)
+	}
+
+	return "http://" + f.listener.Addr().String() + prefix, unregister, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (f *sdkForwarder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Path
+	if i := strings.Index(prefix[1:], "/"); i >= 0 {
+		prefix = prefix[:i+1]
+	}
+
+	f.mu.RLock()
+	proxy, ok := f.routes[prefix]
+	f.mu.RUnlock()
+
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	proxy.ServeHTTP(w, r)
+}
+
+// forwardSDKEndpoint returns the URL of the forwarder for the SDK endpoint sent with transport.
+// The route is removed when the client is no longer used.
+func (c *CloudAvenue) forwardSDKEndpoint(endpoint string, transport http.RoundTripper) (string, error) {
+	forwardedEndpoint, unregister, err := defaultSDKForwarder.Register(endpoint, transport)
+	if err != nil {
+		return "", err
+	}
+	c.sdkRoutes.add(unregister)
+
+	return forwardedEndpoint, nil
+}
+
+// sdkRoutes are the routes of the forwarder registered by a client.
+// The client (and its copies) is the only owner of sdkRoutes: the routes are removed by
+// the finalizer once the client is no longer used, e.g. after each configuration of the
+// provider in the acceptance tests. The clients of the SDK do not hold the routes, the
+// client must stay reachable while they are used.
+type sdkRoutes struct {
+	mu         sync.Mutex
+	unregister []func()
+}
+
+// newSDKRoutes creates the routes of a client removed by the garbage collector.
+func newSDKRoutes() *sdkRoutes {
+	r := &sdkRoutes{}
+	runtime.SetFinalizer(r, (*sdkRoutes).close)
+	return r
+}
+
+// add adds the function removing a route.
+func (r *sdkRoutes) add(unregister func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.unregister = append(r.unregister, unregister)
+}
+
+// close removes the routes.
+func (r *sdkRoutes) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, unregister := range r.unregister {
+		unregister()
+	}
+	r.unregister = nil
+}
+
+// sdkTransport returns the transport of the SDK clients.
+// The requests use the TLS and proxy settings, the request limiter and the record/replay
+// transport of the provider.
+func (c *CloudAvenue) sdkTransport() http.RoundTripper {
+	return &limitTransport{
+		base:    c.getHTTPTransport(),
+		limiter: c.Limiter,
+	}
+}
+
+// sdkSessionPath is the path of the API opening a session with the CloudAvenue client of the SDK.
+const sdkSessionPath = "/cloudapi/1.0.0/sessions"
+
+// sessionTransport answers the session requests of the CloudAvenue client of the SDK with the
+// access token of the provider, the other requests are sent with base.
+// The SDK only supports basic authentication, so the session cannot be opened with an API token.
+type sessionTransport struct {
+	base  http.RoundTripper
+	token *accessToken
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || !strings.HasSuffix(req.URL.Path, sdkSessionPath) {
+		return t.base.RoundTrip(req)
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	token, err := t.token.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	// The SDK opens a new session when the idle timeout is reached, the token
+	// sent afterwards is replaced by the current access token anyway.
+	body := fmt.Sprintf(`{"sessionIdleTimeoutMinutes":%d}`, int(t.token.Lifetime().Minutes()))
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type":                 []string{"application/json"},
+			"X-Vmware-Vcloud-Access-Token": []string{token},
+		},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,172 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSDKForwarder(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Method + " " + r.URL.RequestURI() + " " + r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	var calls atomic.Int32
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	endpoint, unregister, err := defaultSDKForwarder.Register(server.URL+"/api", transport)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(endpoint, "http://127.0.0.1:") {
+		t.Fatalf("expected a loopback URL, got %q", endpoint)
+	}
+	defer unregister()
+
+	t.Run("Forward", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, endpoint+"/auth/token?grant_type=password", strings.NewReader("body"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer t0k3n")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+		if want := "POST /api/auth/token?grant_type=password Bearer t0k3n"; string(body) != want {
+			t.Fatalf("expected body %q, got %q", want, string(body))
+		}
+		if calls.Load() != 1 {
+			t.Fatalf("expected the request to be sent with the transport, got %d calls", calls.Load())
+		}
+	})
+
+	t.Run("EscapedPath", func(t *testing.T) {
+		resp, err := http.Get(endpoint + "/catalogs/my%2Fcatalog")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		defer resp.Body.Close()
+
+		body, _ := io.ReadAll(resp.Body)
+		if want := "GET /api/catalogs/my%2Fcatalog "; string(body) != want {
+			t.Fatalf("expected body %q, got %q", want, string(body))
+		}
+	})
+
+	t.Run("UnknownRoute", func(t *testing.T) {
+		resp, err := http.Get(strings.TrimSuffix(endpoint, endpoint[strings.LastIndex(endpoint, "/"):]) + "/unknown/auth/token")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected status code %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+	})
+
+	t.Run("Unregister", func(t *testing.T) {
+		endpoint, unregister, err := defaultSDKForwarder.Register(server.URL, transport)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		routes := &sdkRoutes{}
+		routes.add(unregister)
+		routes.close()
+
+		resp, err := http.Get(endpoint + "/auth/token")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected status code %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+	})
+
+	t.Run("InvalidEndpoint", func(t *testing.T) {
+		if _, _, err := defaultSDKForwarder.Register("backup1.cloudavenue.orange-business.com", transport); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+	})
+}
+
+// roundTripperFunc is a http.RoundTripper implemented by a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSessionTransport(t *testing.T) {
+	t.Parallel()
+
+	token := newAccessToken(func() (string, time.Duration, error) {
+		return "t0k3n", 0, nil
+	})
+	token.SetLifetime(20 * time.Minute)
+
+	var calls atomic.Int32
+	transport := &sessionTransport{
+		base: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}),
+		token: token,
+	}
+
+	t.Run("Session", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "http://127.0.0.1/cloudapi/1.0.0/sessions", nil)
+		req.SetBasicAuth("@org", "")
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("X-VMWARE-VCLOUD-ACCESS-TOKEN") != "t0k3n" {
+			t.Fatalf("expected the access token in the response, got %d %v", resp.StatusCode, resp.Header)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		if want := `{"sessionIdleTimeoutMinutes":20}`; string(body) != want {
+			t.Fatalf("expected body %q, got %q", want, string(body))
+		}
+		if calls.Load() != 0 {
+			t.Fatalf("expected the session not to be sent, got %d calls", calls.Load())
+		}
+	})
+
+	t.Run("Forward", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "http://127.0.0.1/cloudapi/1.0.0/sessions/current", nil)
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		resp.Body.Close()
+
+		if calls.Load() != 1 {
+			t.Fatalf("expected the request to be sent, got %d calls", calls.Load())
+		}
+	})
+}
@@ -0,0 +1,30 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import "sync"
+
+// lazyInit runs the initialization of a client on first use.
+// Unlike sync.Once, a failed initialization is run again on the next call,
+// so a backend that is temporarily unavailable does not fail the whole run.
+type lazyInit struct {
+	mu   sync.Mutex
+	done bool
+}
+
+// Do calls f if no previous call succeeded.
+// Concurrent callers wait for the running initialization.
+func (l *lazyInit) Do(f func() error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.done {
+		return nil
+	}
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	l.done = true
+	return nil
+}
@@ -0,0 +1,73 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazyInit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("RunOnce", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			l         lazyInit
+			callCount atomic.Int32
+			wg        sync.WaitGroup
+		)
+
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := l.Do(func() error {
+					callCount.Add(1)
+					return nil
+				}); err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if callCount.Load() != 1 {
+			t.Fatalf("expected 1 call, got %d", callCount.Load())
+		}
+	})
+
+	t.Run("RetryOnError", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			l         lazyInit
+			callCount int
+			errInit   = errors.New("backend unavailable")
+		)
+
+		init := func() error {
+			callCount++
+			if callCount == 1 {
+				return errInit
+			}
+			return nil
+		}
+
+		if err := l.Do(init); !errors.Is(err, errInit) {
+			t.Fatalf("expected error %v, got %v", errInit, err)
+		}
+		if err := l.Do(init); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := l.Do(init); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if callCount != 2 {
+			t.Fatalf("expected 2 calls, got %d", callCount)
+		}
+	})
+}
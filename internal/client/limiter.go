@@ -0,0 +1,97 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// RequestLimiter limits the number of concurrent requests and the number of requests per second
+// sent to the APIs. The limiter is shared by all the clients.
+type RequestLimiter struct {
+	// slots limits the number of concurrent requests, nil means unlimited.
+	slots chan struct{}
+	// rate limits the number of requests per second, nil means unlimited.
+	rate *rate.Limiter
+}
+
+// NewRequestLimiter creates a new request limiter.
+// A value of 0 for maxConcurrent or maxPerSecond means unlimited.
+func NewRequestLimiter(maxConcurrent, maxPerSecond int) *RequestLimiter {
+	l := &RequestLimiter{}
+
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	if maxPerSecond > 0 {
+		l.rate = rate.NewLimiter(rate.Limit(maxPerSecond), maxPerSecond)
+	}
+
+	return l
+}
+
+// Acquire waits until the request can be sent.
+// The returned function must be called when the request is done.
+// The queued requests are logged with ctx, the context of the request.
+func (l *RequestLimiter) Acquire(ctx context.Context, method, url string) (release func(), err error) {
+	release = func() {}
+	if l == nil {
+		return release, nil
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			tflog.Debug(ctx, "Request queued, too many concurrent requests", map[string]interface{}{
+				"method":                  method,
+				"url":                     url,
+				"max_concurrent_requests": cap(l.slots),
+			})
+			select {
+			case l.slots <- struct{}{}:
+			case <-ctx.Done():
+				return release, ctx.Err()
+			}
+		}
+		release = func() { <-l.slots }
+	}
+
+	if l.rate != nil {
+		if !l.rate.Allow() {
+			tflog.Debug(ctx, "Request queued, requests per second limit reached", map[string]interface{}{
+				"method":                  method,
+				"url":                     url,
+				"max_requests_per_second": l.rate.Burst(),
+			})
+			if err := l.rate.Wait(ctx); err != nil {
+				release()
+				return func() {}, err
+			}
+		}
+	}
+
+	return release, nil
+}
+
+// limitTransport is a http.RoundTripper that waits for the request limiter before sending a request.
+// The concurrency slot is released when the response headers are received.
+type limitTransport struct {
+	base    http.RoundTripper
+	limiter *RequestLimiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.Acquire(req.Context(), req.Method, req.URL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return t.base.RoundTrip(req)
+}
@@ -58,7 +58,12 @@ func (o *Org) DeleteNetworkDHCP(orgNetworkID string) error {
 
 // GetOrg return the org using the name provided in the provider.
 func (c *CloudAvenue) GetOrg() (*Org, error) {
-	x, err := c.Vmware.GetOrgByName(c.Org)
+	vmware, err := c.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
+	x, err := vmware.GetOrgByName(c.Org)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrg, err)
 	}
@@ -0,0 +1,247 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of retries for transient API failures.
+	DefaultMaxRetries = 5
+	// DefaultRetryMaxWait is the default maximum time to wait between two retries.
+	DefaultRetryMaxWait = 30 * time.Second
+	// DefaultRetryMinWait is the default time to wait before the first retry.
+	DefaultRetryMinWait = 1 * time.Second
+	// retryMaxBodySize is the maximum size of the body read to classify an error.
+	retryMaxBodySize = 64 * 1024
+)
+
+// RetryPolicy defines how transient API failures are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries, 0 disables retries.
+	MaxRetries int
+	// MinWait is the time to wait before the first retry.
+	MinWait time.Duration
+	// MaxWait is the maximum time to wait between two retries.
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy returns the default retry policy.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		MinWait:    DefaultRetryMinWait,
+		MaxWait:    DefaultRetryMaxWait,
+	}
+}
+
+// Backoff returns the time to wait before the retry number attempt (starting at 0).
+// The wait grows exponentially with a random jitter and is capped at MaxWait.
+// If the API returned a Retry-After value, it is used instead.
+func (p RetryPolicy) Backoff(attempt int, retryAfter time.Duration) time.Duration {
+	minWait, maxWait := p.MinWait, p.MaxWait
+	if minWait <= 0 {
+		minWait = DefaultRetryMinWait
+	}
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	if retryAfter > 0 {
+		if retryAfter > maxWait {
+			return maxWait
+		}
+		return retryAfter
+	}
+
+	wait := time.Duration(math.Min(float64(minWait)*math.Pow(2, float64(attempt)), float64(maxWait)))
+
+	// Jitter between wait/2 and wait to spread concurrent retries.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1)) //nolint:gosec // jitter does not need a secure random
+}
+
+// Do calls fn until it succeeds, returns a permanent error or the retries are exhausted.
+// isRetryable classifies the errors returned by fn.
+func (p RetryPolicy) Do(ctx context.Context, fn func() error, isRetryable func(error) bool) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = fn(); err == nil || !isRetryable(err) || attempt >= p.MaxRetries {
+			return err
+		}
+
+		if errWait := sleepContext(ctx, p.Backoff(attempt, 0)); errWait != nil {
+			return err
+		}
+	}
+}
+
+// retryTransport is a http.RoundTripper that retries requests on transient API failures.
+type retryTransport struct {
+	base   http.RoundTripper
+	policy RetryPolicy
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.policy.MaxRetries || !isRetryableResponse(req, resp, err) {
+			return resp, err
+		}
+
+		// The body has been consumed and cannot be sent again.
+		if req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		retryAfter := time.Duration(0)
+		if resp != nil {
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+			resp.Body.Close()
+		}
+
+		if errWait := sleepContext(req.Context(), t.policy.Backoff(attempt, retryAfter)); errWait != nil {
+			return nil, errWait
+		}
+
+		if req.GetBody != nil {
+			body, errBody := req.GetBody()
+			if errBody != nil {
+				return nil, errBody
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// isRetryableResponse returns true if the request failed with a transient error.
+// A request that is not idempotent (e.g. POST) may have been processed by the API
+// before the failure, so it is only retried when the API has not processed it.
+func isRetryableResponse(req *http.Request, resp *http.Response, err error) bool {
+	idempotent := isIdempotent(req.Method)
+
+	if err != nil {
+		if idempotent {
+			return IsTransientError(err)
+		}
+		// The request has not been sent if the connection was refused.
+		return isConnectionRefused(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusServiceUnavailable:
+		// The API asks to retry later, the request has not been processed.
+		return idempotent || resp.Header.Get("Retry-After") != ""
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	case http.StatusBadRequest, http.StatusConflict, http.StatusInternalServerError:
+		// VCD returns BUSY_ENTITY when another task is running on the object,
+		// the request is rejected without being processed.
+		return IsBusyEntity(peekBody(resp))
+	default:
+		return false
+	}
+}
+
+// isIdempotent returns true if sending the request several times has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isConnectionRefused returns true if the connection to the API was refused.
+func isConnectionRefused(err error) bool {
+	return errors.Is(err, syscall.ECONNREFUSED) || strings.Contains(strings.ToLower(err.Error()), "connection refused")
+}
+
+// IsTransientError returns true if the error is a network error that is worth retrying.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"timeout", "connection reset", "connection refused", "eof", "tls handshake"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusyEntity returns true if the message is a VCD busy entity error.
+func IsBusyEntity(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "busy_entity") || strings.Contains(msg, "busy completing an operation")
+}
+
+// peekBody reads the beginning of the response body and restores it.
+func peekBody(resp *http.Response) string {
+	if resp.Body == nil {
+		return ""
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, retryMaxBodySize))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// parseRetryAfter parses the Retry-After header (seconds or HTTP date).
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+// sleepContext waits for d or until the context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,299 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Backoff", func(t *testing.T) {
+		t.Parallel()
+
+		p := RetryPolicy{MaxRetries: 5, MinWait: time.Second, MaxWait: 10 * time.Second}
+
+		for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+			wait := p.Backoff(attempt, 0)
+			if wait < want/2 || wait > want {
+				t.Fatalf("attempt %d: expected wait between %s and %s, got %s", attempt, want/2, want, wait)
+			}
+		}
+	})
+
+	t.Run("BackoffRetryAfter", func(t *testing.T) {
+		t.Parallel()
+
+		p := RetryPolicy{MaxRetries: 5, MaxWait: 10 * time.Second}
+
+		if wait := p.Backoff(0, 3*time.Second); wait != 3*time.Second {
+			t.Fatalf("expected wait to be %s, got %s", 3*time.Second, wait)
+		}
+
+		if wait := p.Backoff(0, time.Minute); wait != 10*time.Second {
+			t.Fatalf("expected wait to be capped at %s, got %s", 10*time.Second, wait)
+		}
+	})
+
+	t.Run("Do", func(t *testing.T) {
+		t.Parallel()
+
+		p := RetryPolicy{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+		errTransient := errors.New("transient")
+		errPermanent := errors.New("permanent")
+		isRetryable := func(err error) bool { return errors.Is(err, errTransient) }
+
+		calls := 0
+		err := p.Do(context.Background(), func() error {
+			calls++
+			if calls < 3 {
+				return errTransient
+			}
+			return nil
+		}, isRetryable)
+		if err != nil || calls != 3 {
+			t.Fatalf("expected success after 3 calls, got %v after %d calls", err, calls)
+		}
+
+		calls = 0
+		err = p.Do(context.Background(), func() error {
+			calls++
+			return errPermanent
+		}, isRetryable)
+		if !errors.Is(err, errPermanent) || calls != 1 {
+			t.Fatalf("expected permanent error after 1 call, got %v after %d calls", err, calls)
+		}
+
+		calls = 0
+		err = p.Do(context.Background(), func() error {
+			calls++
+			return errTransient
+		}, isRetryable)
+		if !errors.Is(err, errTransient) || calls != 4 {
+			t.Fatalf("expected transient error after 4 calls, got %v after %d calls", err, calls)
+		}
+	})
+
+	t.Run("ParseRetryAfter", func(t *testing.T) {
+		t.Parallel()
+
+		if d := parseRetryAfter("5"); d != 5*time.Second {
+			t.Fatalf("expected %s, got %s", 5*time.Second, d)
+		}
+
+		if d := parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)); d <= 0 {
+			t.Fatalf("expected a positive duration, got %s", d)
+		}
+
+		if d := parseRetryAfter("invalid"); d != 0 {
+			t.Fatalf("expected 0, got %s", d)
+		}
+	})
+}
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(maxRetries int) *http.Client {
+		return &http.Client{
+			Transport: &retryTransport{
+				base:   http.DefaultTransport,
+				policy: RetryPolicy{MaxRetries: maxRetries, MinWait: time.Millisecond, MaxWait: time.Millisecond},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		responses  []int
+		body       string
+		retryAfter string
+		maxRetries int
+		wantCalls  int32
+		wantStatus int
+	}{
+		{
+			name:       "ServiceUnavailable",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			retryAfter: "0",
+			maxRetries: 5,
+			wantCalls:  3,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "TooManyRequests",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries: 5,
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "BusyEntity",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusBadRequest, http.StatusOK},
+			body:       `{"minorErrorCode":"BUSY_ENTITY","message":"The entity is busy completing an operation."}`,
+			maxRetries: 5,
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "PermanentError",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusBadRequest, http.StatusOK},
+			body:       `{"minorErrorCode":"BAD_REQUEST"}`,
+			maxRetries: 5,
+			wantCalls:  1,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "NotFound",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusNotFound, http.StatusOK},
+			maxRetries: 5,
+			wantCalls:  1,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "ServiceUnavailableWithoutRetryAfter",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 5,
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "ServiceUnavailableIdempotent",
+			method:     http.MethodPut,
+			responses:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 5,
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "BadGateway",
+			method:     http.MethodPost,
+			responses:  []int{http.StatusBadGateway, http.StatusOK},
+			maxRetries: 5,
+			wantCalls:  1,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "GatewayTimeoutIdempotent",
+			method:     http.MethodDelete,
+			responses:  []int{http.StatusGatewayTimeout, http.StatusOK},
+			maxRetries: 5,
+			wantCalls:  2,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "RetriesExhausted",
+			method:     http.MethodPut,
+			responses:  []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries: 2,
+			wantCalls:  3,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "RetriesDisabled",
+			method:     http.MethodPut,
+			responses:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 0,
+			wantCalls:  1,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// The body must be sent again on each retry.
+				if body, _ := io.ReadAll(r.Body); string(body) != "payload" {
+					t.Errorf("expected body %q, got %q", "payload", string(body))
+				}
+
+				status := tt.responses[calls.Add(1)-1]
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(status)
+				if status != http.StatusOK {
+					_, _ = w.Write([]byte(tt.body))
+				}
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			resp, err := newClient(tt.maxRetries).Do(req)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("expected status code %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+
+			if calls.Load() != tt.wantCalls {
+				t.Fatalf("expected %d calls, got %d", tt.wantCalls, calls.Load())
+			}
+
+			// The body of the response must still be readable after the classification.
+			if body, _ := io.ReadAll(resp.Body); resp.StatusCode != http.StatusOK && string(body) != tt.body {
+				t.Fatalf("expected body %q, got %q", tt.body, string(body))
+			}
+		})
+	}
+}
+
+func TestIsRetryableResponse(t *testing.T) {
+	t.Parallel()
+
+	errRefused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{name: "GetEOF", method: http.MethodGet, err: io.EOF, want: true},
+		{name: "PostEOF", method: http.MethodPost, err: io.EOF, want: false},
+		{name: "PostConnectionReset", method: http.MethodPost, err: errors.New("read: connection reset by peer"), want: false},
+		{name: "PostConnectionRefused", method: http.MethodPost, err: errRefused, want: true},
+		{name: "DeleteConnectionRefused", method: http.MethodDelete, err: errRefused, want: true},
+		{name: "GetCanceled", method: http.MethodGet, err: context.Canceled, want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "https://example.com", nil)
+			if got := isRetryableResponse(req, nil, tt.err); got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultTokenLifetime is the lifetime of an access token when the API does not return one.
+	// It matches the default session idle timeout of VCD.
+	defaultTokenLifetime = 30 * time.Minute
+	// tokenRefreshMargin is the margin before the expiration of the access token to refresh it.
+	tokenRefreshMargin = 1 * time.Minute
+
+	// authorizationHeader is the standard HTTP authorization header.
+	authorizationHeader = "Authorization"
+	// bearerPrefix is the prefix of a bearer token in the authorization header.
+	bearerPrefix = "Bearer "
+	// jwtBearerGrantType is the OAuth grant used by govcd to create an API token with the access token.
+	jwtBearerGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+// accessToken is an access token shared by all the clients.
+// The token is refreshed when it is about to expire or when the API rejects it.
+type accessToken struct {
+	mu        sync.RWMutex
+	value     string
+	expiresAt time.Time
+
+	// lifetime is the lifetime of the token when refresh does not return one.
+	lifetime time.Duration
+	// refresh retrieves a new access token and its lifetime (0 if unknown).
+	refresh func() (token string, lifetime time.Duration, err error)
+}
+
+// newAccessToken creates a new access token with the refresh function.
+func newAccessToken(refresh func() (string, time.Duration, error)) *accessToken {
+	return &accessToken{
+		lifetime: defaultTokenLifetime,
+		refresh:  refresh,
+	}
+}
+
+// isValid returns true if the token is set and not about to expire.
+// The caller must hold the lock.
+func (t *accessToken) isValid() bool {
+	return t.value != "" && time.Now().Add(tokenRefreshMargin).Before(t.expiresAt)
+}
+
+// Get returns a valid access token, refreshing it if needed.
+// Concurrent callers wait for a single refresh and share the new token.
+func (t *accessToken) Get() (string, error) {
+	t.mu.RLock()
+	if t.isValid() {
+		defer t.mu.RUnlock()
+		return t.value, nil
+	}
+	t.mu.RUnlock()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Another goroutine may have refreshed the token while waiting for the lock.
+	if t.isValid() {
+		return t.value, nil
+	}
+
+	token, lifetime, err := t.refresh()
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		return "", ErrTokenEmpty
+	}
+
+	if lifetime <= 0 {
+		lifetime = t.lifetime
+	}
+
+	t.value = token
+	t.expiresAt = time.Now().Add(lifetime)
+
+	return t.value, nil
+}
+
+// SetLifetime sets the lifetime used when the refresh does not return one.
+func (t *accessToken) SetLifetime(lifetime time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if lifetime <= 0 {
+		return
+	}
+
+	// Shorten the current token if needed.
+	if expiresAt := time.Now().Add(lifetime); expiresAt.Before(t.expiresAt) {
+		t.expiresAt = expiresAt
+	}
+	t.lifetime = lifetime
+}
+
+// Lifetime returns the lifetime used when the refresh does not return one.
+func (t *accessToken) Lifetime() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.lifetime
+}
+
+// Invalidate forces the refresh of the token on the next call to Get.
+// Nothing is done if the token has already been refreshed by another goroutine.
+func (t *accessToken) Invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.value == token {
+		t.expiresAt = time.Time{}
+	}
+}
+
+// tokenTransport is a http.RoundTripper that sets the current access token on the requests
+// and retries once with a new token when the API returns 401 Unauthorized.
+type tokenTransport struct {
+	base  http.RoundTripper
+	token *accessToken
+
+	// header is the name of the header that contains the token.
+	header string
+	// prefix is prepended to the token in the header (e.g. "Bearer ").
+	prefix string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// govcd sends the token stored in the client as the assertion of the JWT bearer grant
+	// (creation of an API token), this token is not updated after a refresh.
+	if isOAuthTokenRequest(req) {
+		return t.roundTripOAuthToken(req)
+	}
+
+	// Authentication requests (basic auth, API token exchange) are sent as is.
+	if v := req.Header.Get(t.header); v == "" || !strings.HasPrefix(v, t.prefix) || isAuthRequest(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	token, err := t.token.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(t.withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The body has been consumed and cannot be sent again.
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	// The token has been revoked or has expired before the expected time.
+	t.token.Invalidate(token)
+	newToken, err := t.token.Get()
+	if err != nil {
+		return resp, nil //nolint:nilerr // return the original response
+	}
+
+	retry := t.withToken(req, newToken)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return resp, nil //nolint:nilerr // return the original response
+		}
+	}
+
+	resp.Body.Close()
+	return t.base.RoundTrip(retry)
+}
+
+// roundTripOAuthToken sends the OAuth token request with the current access token as the assertion
+// of a JWT bearer grant. The other grants (e.g. the exchange of an API token) are sent as is.
+func (t *tokenTransport) roundTripOAuthToken(req *http.Request) (*http.Response, error) {
+	if req.Body == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	form, err := url.ParseQuery(string(body))
+	if err == nil && form.Get("grant_type") == jwtBearerGrantType {
+		token, err := t.token.Get()
+		if err != nil {
+			return nil, err
+		}
+		form.Set("assertion", token)
+		body = []byte(form.Encode())
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return t.base.RoundTrip(r)
+}
+
+// withToken returns a copy of the request with the token set in the header.
+// govcd also sends the token in the Authorization header ("bearer <token>"),
+// it is replaced as well so that both headers carry the same token.
+func (t *tokenTransport) withToken(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set(t.header, t.prefix+token)
+	if t.header != authorizationHeader {
+		if v := r.Header.Get(authorizationHeader); len(v) >= len(bearerPrefix) && strings.EqualFold(v[:len(bearerPrefix)], bearerPrefix) {
+			r.Header.Set(authorizationHeader, v[:len(bearerPrefix)]+token)
+		}
+	}
+	return r
+}
+
+// isOAuthTokenRequest returns true if the request is sent to the OAuth token endpoint of VCD.
+func isOAuthTokenRequest(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.Contains(req.URL.Path, "/oauth/") && strings.HasSuffix(req.URL.Path, "/token")
+}
+
+// isAuthRequest returns true if the request retrieves a new access token.
+func isAuthRequest(req *http.Request) bool {
+	return strings.Contains(req.URL.Path, "/oauth/") || strings.HasSuffix(req.URL.Path, "/sessions")
+}
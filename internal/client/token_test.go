@@ -0,0 +1,317 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAccessToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+
+		var refreshCount atomic.Int32
+		token := newAccessToken(func() (string, time.Duration, error) {
+			return fmt.Sprintf("t0k3n-%d", refreshCount.Add(1)), 0, nil
+		})
+
+		for i := 0; i < 3; i++ {
+			v, err := token.Get()
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if v != "t0k3n-1" {
+				t.Fatalf("expected token to be %q, got %q", "t0k3n-1", v)
+			}
+		}
+	})
+
+	t.Run("RefreshWhenExpired", func(t *testing.T) {
+		t.Parallel()
+
+		var refreshCount atomic.Int32
+		token := newAccessToken(func() (string, time.Duration, error) {
+			// The token expires within the refresh margin.
+			return fmt.Sprintf("t0k3n-%d", refreshCount.Add(1)), tokenRefreshMargin / 2, nil
+		})
+
+		if v, _ := token.Get(); v != "t0k3n-1" {
+			t.Fatalf("expected token to be %q, got %q", "t0k3n-1", v)
+		}
+		if v, _ := token.Get(); v != "t0k3n-2" {
+			t.Fatalf("expected token to be %q, got %q", "t0k3n-2", v)
+		}
+	})
+
+	t.Run("Invalidate", func(t *testing.T) {
+		t.Parallel()
+
+		var refreshCount atomic.Int32
+		token := newAccessToken(func() (string, time.Duration, error) {
+			return fmt.Sprintf("t0k3n-%d", refreshCount.Add(1)), 0, nil
+		})
+
+		v, _ := token.Get()
+		token.Invalidate("another-token")
+		if v2, _ := token.Get(); v2 != v {
+			t.Fatalf("expected token to be %q, got %q", v, v2)
+		}
+
+		token.Invalidate(v)
+		if v2, _ := token.Get(); v2 != "t0k3n-2" {
+			t.Fatalf("expected token to be %q, got %q", "t0k3n-2", v2)
+		}
+	})
+
+	t.Run("ConcurrentRefresh", func(t *testing.T) {
+		t.Parallel()
+
+		var refreshCount atomic.Int32
+		token := newAccessToken(func() (string, time.Duration, error) {
+			time.Sleep(10 * time.Millisecond)
+			return fmt.Sprintf("t0k3n-%d", refreshCount.Add(1)), 0, nil
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if v, err := token.Get(); err != nil || v != "t0k3n-1" {
+					t.Errorf("expected token to be %q, got %q (%v)", "t0k3n-1", v, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if refreshCount.Load() != 1 {
+			t.Fatalf("expected 1 refresh, got %d", refreshCount.Load())
+		}
+	})
+
+	t.Run("RefreshError", func(t *testing.T) {
+		t.Parallel()
+
+		token := newAccessToken(func() (string, time.Duration, error) {
+			return "", 0, ErrAuthFailed
+		})
+
+		if _, err := token.Get(); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+	})
+}
+
+func TestTokenTransport(t *testing.T) {
+	t.Parallel()
+
+	// The server accepts only the current token.
+	var (
+		mu           sync.Mutex
+		currentToken = "t0k3n-1"
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if strings.HasPrefix(r.Header.Get("Authorization"), "Basic ") {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+currentToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var refreshCount atomic.Int32
+	token := newAccessToken(func() (string, time.Duration, error) {
+		return fmt.Sprintf("t0k3n-%d", refreshCount.Add(1)), 0, nil
+	})
+
+	client := &http.Client{
+		Transport: &tokenTransport{
+			base:   http.DefaultTransport,
+			token:  token,
+			header: "Authorization",
+			prefix: "Bearer ",
+		},
+	}
+
+	do := func(t *testing.T, authorization string) int {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("body"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		req.Header.Set("Authorization", authorization)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// A stale token in the request is replaced by the current one.
+	if code := do(t, "Bearer stale"); code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, code)
+	}
+
+	// The token is revoked on the server side, the transport refreshes it and retries.
+	mu.Lock()
+	currentToken = "t0k3n-2"
+	mu.Unlock()
+
+	if code := do(t, "Bearer t0k3n-1"); code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, code)
+	}
+	if refreshCount.Load() != 2 {
+		t.Fatalf("expected 2 refreshes, got %d", refreshCount.Load())
+	}
+
+	// Authentication requests are sent as is.
+	if code := do(t, "Basic ZGFzaWx2YTpkYXNpbHZh"); code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, code)
+	}
+	if refreshCount.Load() != 2 {
+		t.Fatalf("expected 2 refreshes, got %d", refreshCount.Load())
+	}
+}
+
+func TestTokenTransportVmware(t *testing.T) {
+	t.Parallel()
+
+	// govcd sends the token in its own header and in the Authorization header,
+	// the server accepts only the current token in both headers.
+	var (
+		mu           sync.Mutex
+		currentToken = "t0k3n-1"
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Header.Get("X-Vmware-Vcloud-Access-Token") != currentToken || r.Header.Get("Authorization") != "bearer "+currentToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var refreshCount atomic.Int32
+	token := newAccessToken(func() (string, time.Duration, error) {
+		return fmt.Sprintf("t0k3n-%d", refreshCount.Add(1)), 0, nil
+	})
+
+	client := &http.Client{
+		Transport: &tokenTransport{
+			base:   http.DefaultTransport,
+			token:  token,
+			header: "X-Vmware-Vcloud-Access-Token",
+		},
+	}
+
+	do := func(t *testing.T, stale string) int {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		req.Header.Set("X-Vmware-Vcloud-Access-Token", stale)
+		req.Header.Set("Authorization", "bearer "+stale)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// Both headers are replaced by the current token.
+	if code := do(t, "stale"); code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, code)
+	}
+
+	// The token is revoked on the server side, both headers are set with the new token on retry.
+	mu.Lock()
+	currentToken = "t0k3n-2"
+	mu.Unlock()
+
+	if code := do(t, "t0k3n-1"); code != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, code)
+	}
+}
+
+func TestTokenTransportOAuthToken(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(r.PostForm.Get("grant_type") + " " + r.PostForm.Get("assertion") + r.PostForm.Get("refresh_token")))
+	}))
+	t.Cleanup(server.Close)
+
+	token := newAccessToken(func() (string, time.Duration, error) {
+		return "t0k3n-2", 0, nil
+	})
+
+	client := &http.Client{
+		Transport: &tokenTransport{
+			base:   http.DefaultTransport,
+			token:  token,
+			header: "X-Vmware-Vcloud-Access-Token",
+		},
+	}
+
+	tests := []struct {
+		name string
+		form url.Values
+		want string
+	}{
+		{
+			// govcd sends the token stored in the client, the current token is sent instead.
+			name: "JWTBearer",
+			form: url.Values{"grant_type": {jwtBearerGrantType}, "assertion": {"t0k3n-1"}, "client_id": {"1234"}},
+			want: jwtBearerGrantType + " t0k3n-2",
+		},
+		{
+			name: "RefreshToken",
+			form: url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"api-t0k3n"}},
+			want: "refresh_token api-t0k3n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := client.PostForm(server.URL+"/oauth/tenant/acme/token", tt.form)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != tt.want {
+				t.Fatalf("expected body %q, got %q", tt.want, string(body))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// TransportOpts defines the TLS and proxy settings of the HTTP clients.
+type TransportOpts struct {
+	// CAFile is the path of a PEM bundle of CA certificates trusted in addition to the system ones.
+	CAFile string
+	// Insecure disables the verification of the server certificates.
+	Insecure bool
+	// ProxyURL is the URL of the HTTP proxy, the proxy environment variables are used if empty.
+	ProxyURL string
+}
+
+// createHTTPTransport creates the base transport shared by the clients.
+// The clients of the SDK use it through the SDK forwarder.
+func (o TransportOpts) createHTTPTransport() (*http.Transport, error) {
+	t, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		t = &http.Transport{}
+	}
+	t = t.Clone()
+	t.TLSHandshakeTimeout = 120 * time.Second
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402 -- InsecureSkipVerify is explicitly requested by the user
+		InsecureSkipVerify: o.Insecure, //nolint:gosec
+	}
+
+	if o.CAFile != "" {
+		pem, err := os.ReadFile(o.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the CA file %s: %w", o.CAFile, err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid PEM certificate found in the CA file %s", o.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	t.TLSClientConfig = tlsConfig
+
+	if o.ProxyURL != "" {
+		proxyURL, err := url.Parse(o.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %s: %w", o.ProxyURL, err)
+		}
+		t.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return t, nil
+}
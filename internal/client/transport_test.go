@@ -0,0 +1,199 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCreateHTTPTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	invalidCAFile := filepath.Join(t.TempDir(), "invalid.pem")
+	if err := os.WriteFile(invalidCAFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		opts      TransportOpts
+		expectErr bool
+		expectOK  bool
+	}{
+		{
+			name:     "Default",
+			opts:     TransportOpts{},
+			expectOK: false,
+		},
+		{
+			name:     "CAFile",
+			opts:     TransportOpts{CAFile: caFile},
+			expectOK: true,
+		},
+		{
+			name:     "Insecure",
+			opts:     TransportOpts{Insecure: true},
+			expectOK: true,
+		},
+		{
+			name:      "CAFileNotFound",
+			opts:      TransportOpts{CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+			expectErr: true,
+		},
+		{
+			name:      "CAFileInvalid",
+			opts:      TransportOpts{CAFile: invalidCAFile},
+			expectErr: true,
+		},
+		{
+			name:      "ProxyURLInvalid",
+			opts:      TransportOpts{ProxyURL: "http://proxy:port"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport, err := tt.opts.createHTTPTransport()
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if tt.expectOK {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				resp.Body.Close()
+			} else if err == nil {
+				resp.Body.Close()
+				t.Fatalf("expected certificate error, got nil")
+			}
+		})
+	}
+
+	t.Run("ProxyURL", func(t *testing.T) {
+		t.Parallel()
+
+		transport, err := TransportOpts{ProxyURL: "http://proxy.example.com:3128"}.createHTTPTransport()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, "https://console1.cloudavenue.orange-business.com", nil)
+		proxyURL, err := transport.Proxy(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if proxyURL == nil || proxyURL.Host != "proxy.example.com:3128" {
+			t.Fatalf("expected proxy to be %q, got %v", "proxy.example.com:3128", proxyURL)
+		}
+	})
+}
+
+func TestSDKEndpointTransport(t *testing.T) {
+	t.Parallel()
+
+	// The clients of the SDK reach the APIs with the TLS settings of the provider.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		opts       TransportOpts
+		wantStatus int
+	}{
+		{
+			name:       "Default",
+			opts:       TransportOpts{},
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			name:       "CAFile",
+			opts:       TransportOpts{CAFile: caFile},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport, err := tt.opts.createHTTPTransport()
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			c := &CloudAvenue{TransportOpts: tt.opts, httpTransport: transport, sdkRoutes: newSDKRoutes()}
+
+			endpoint, err := c.forwardSDKEndpoint(server.URL, c.sdkTransport())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			resp, err := http.Get(endpoint + "/auth/token")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("expected status code %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestS3EndpointEnv(t *testing.T) {
+	// The endpoint of the S3 credentials API given by the environment is forwarded instead of the one of the console.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(s3EndpointEnv, server.URL)
+
+	c := &CloudAvenue{Org: "cav01ev01ocb0001234", httpTransport: &http.Transport{}, s3Config: &s3Config{}, sdkRoutes: newSDKRoutes()}
+	if err := c.initS3(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	resp, err := http.Get(c.s3Config.oseEndpoint + "/api/v1/core/associated-tenants")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTeapot {
+		t.Fatalf("expected status code %d, got %d", http.StatusTeapot, resp.StatusCode)
+	}
+}
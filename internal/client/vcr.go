@@ -0,0 +1,446 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// VCRMode is the mode of the record/replay transport.
+type VCRMode string
+
+const (
+	// VCRModeRecord sends the requests to the APIs and saves the interactions in the cassette.
+	VCRModeRecord VCRMode = "record"
+	// VCRModeReplay serves the interactions of the cassette without contacting the APIs.
+	VCRModeReplay VCRMode = "replay"
+
+	// vcrRedacted replaces the secrets in the cassettes.
+	vcrRedacted = "REDACTED"
+	// vcrUserPlaceholder replaces the name of the user in the cassettes.
+	vcrUserPlaceholder = "REDACTED_USER"
+)
+
+var (
+	// ErrConfigureVCR is returned when the record/replay transport cannot be configured.
+	ErrConfigureVCR = errors.New("error configuring record/replay transport")
+	// ErrVCRNoInteraction is returned in replay mode when no interaction of the cassette matches the request.
+	ErrVCRNoInteraction = errors.New("no recorded interaction matches the request")
+
+	// vcrRedactedHeaders are the headers which carry credentials or session tokens.
+	vcrRedactedHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Vcloud-Authorization",
+		"X-Vmware-Vcloud-Access-Token",
+	}
+
+	// vcrRedactedBodies match the secrets in the JSON and XML bodies.
+	vcrRedactedBodies = []struct {
+		re   *regexp.Regexp
+		repl string
+	}{
+		{
+			re:   regexp.MustCompile(`("(?i:access_token|refresh_token|id_token|password|secret|secret_key|secretKey|token)"\s*:\s*)"[^"]*"`),
+			repl: `${1}"` + vcrRedacted + `"`,
+		},
+		{
+			re:   regexp.MustCompile(`(<(?:\w+:)?Password>)[^<]*(</)`),
+			repl: `${1}` + vcrRedacted + `${2}`,
+		},
+		{
+			// Form encoded bodies (e.g. the NetBackup token request).
+			re:   regexp.MustCompile(`((?:^|&)(?i:password|client_secret)=)[^&]*`),
+			repl: `${1}` + vcrRedacted,
+		},
+	}
+
+	// cassettes are shared by the clients of the process, the provider is
+	// configured again for each Terraform command of a test.
+	cassettesMu sync.Mutex
+	cassettes   = map[string]*cassette{}
+)
+
+// VCROpts defines the record/replay transport used by the acceptance tests.
+type VCROpts struct {
+	// Mode is the mode of the transport, the transport is disabled if empty.
+	Mode VCRMode
+	// Cassette is the path of the file containing the interactions.
+	Cassette string
+}
+
+// Enabled returns true if the record/replay transport is enabled.
+func (o VCROpts) Enabled() bool {
+	return o.Mode != ""
+}
+
+// cassette is the list of the HTTP interactions recorded for a test.
+type cassette struct {
+	mu   sync.Mutex
+	path string
+
+	Interactions []*vcrInteraction `yaml:"interactions"`
+}
+
+type vcrInteraction struct {
+	Request  vcrRequest  `yaml:"request"`
+	Response vcrResponse `yaml:"response"`
+
+	// replayed is true when the interaction has been served in replay mode.
+	replayed bool
+}
+
+type vcrRequest struct {
+	Method  string      `yaml:"method"`
+	URL     string      `yaml:"url"`
+	Headers http.Header `yaml:"headers,omitempty"`
+	Body    string      `yaml:"body,omitempty"`
+}
+
+type vcrResponse struct {
+	StatusCode int         `yaml:"status_code"`
+	Headers    http.Header `yaml:"headers,omitempty"`
+	Body       string      `yaml:"body,omitempty"`
+}
+
+// loadCassette returns the cassette of the path.
+// In record mode, the cassette starts empty the first time it is used by the process.
+func loadCassette(opts VCROpts) (*cassette, error) {
+	if opts.Cassette == "" {
+		return nil, errors.New("the path of the cassette is empty")
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	if c, ok := cassettes[opts.Cassette]; ok {
+		return c, nil
+	}
+
+	c := &cassette{path: opts.Cassette}
+	switch opts.Mode {
+	case VCRModeRecord:
+	case VCRModeReplay:
+		data, err := os.ReadFile(opts.Cassette)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the cassette: %w", err)
+		}
+		if err := yaml.Unmarshal(data, c); err != nil {
+			return nil, fmt.Errorf("unable to parse the cassette %s: %w", opts.Cassette, err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid mode %q, must be %q or %q", opts.Mode, VCRModeRecord, VCRModeReplay)
+	}
+
+	cassettes[opts.Cassette] = c
+	return c, nil
+}
+
+// add appends the interaction and saves the cassette, so a failed test keeps its interactions.
+func (c *cassette) add(i *vcrInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, i)
+	return c.save()
+}
+
+// redact applies the redactor again to the recorded interactions and saves the cassette.
+// It is used when a value to redact is known after the first interactions.
+func (c *cassette) redact(r *vcrRedactor) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, i := range c.Interactions {
+		i.Request.URL = r.redactString(i.Request.URL)
+		i.Request.Headers = r.redactHeaders(i.Request.Headers)
+		i.Request.Body = r.redactString(i.Request.Body)
+		i.Response.Headers = r.redactHeaders(i.Response.Headers)
+		i.Response.Body = r.redactString(i.Response.Body)
+	}
+	return c.save()
+}
+
+// save writes the cassette in its file. The caller must hold the lock.
+func (c *cassette) save() error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0o600)
+}
+
+// next returns the first interaction not yet replayed matching the method and the URL.
+// When all of them have been replayed, the last one is served again
+// so a polling loop does not depend on the number of recorded calls.
+func (c *cassette) next(method, url string) *vcrInteraction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var last *vcrInteraction
+	for _, i := range c.Interactions {
+		if i.Request.Method != method || i.Request.URL != url {
+			continue
+		}
+		if !i.replayed {
+			i.replayed = true
+			return i
+		}
+		last = i
+	}
+	return last
+}
+
+// vcrRedactor replaces the secrets and the names of the organization by placeholders.
+// The placeholders are replaced back by the values of the current configuration in replay mode,
+// so a cassette recorded with an organization can be replayed with any other one.
+type vcrRedactor struct {
+	mu      sync.RWMutex
+	values  map[string]string
+	redact  *strings.Replacer
+	restore *strings.Replacer
+}
+
+// newVCRRedactor creates a redactor from the placeholders and their values.
+func newVCRRedactor(values map[string]string) *vcrRedactor {
+	r := &vcrRedactor{
+		values: make(map[string]string, len(values)),
+	}
+	for placeholder, value := range values {
+		r.values[placeholder] = value
+	}
+	r.build()
+
+	return r
+}
+
+// add adds a value known after the creation of the redactor (e.g. the user of an API token).
+func (r *vcrRedactor) add(placeholder, value string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.values[placeholder] = value
+	r.build()
+}
+
+// build creates the replacers from the values. The caller must hold the lock.
+func (r *vcrRedactor) build() {
+	type pair struct{ placeholder, value string }
+	pairs := make([]pair, 0, len(r.values))
+	for placeholder, value := range r.values {
+		if value == "" || value == placeholder {
+			continue
+		}
+		pairs = append(pairs, pair{placeholder, value})
+		if lower := strings.ToLower(value); lower != value {
+			pairs = append(pairs, pair{strings.ToLower(placeholder), lower})
+		}
+	}
+
+	// Longest values first so a value containing another one is fully replaced.
+	sort.Slice(pairs, func(i, j int) bool {
+		if len(pairs[i].value) != len(pairs[j].value) {
+			return len(pairs[i].value) > len(pairs[j].value)
+		}
+		return pairs[i].value < pairs[j].value
+	})
+
+	redact := make([]string, 0, 2*len(pairs))
+	restore := make([]string, 0, 2*len(pairs))
+	for _, p := range pairs {
+		redact = append(redact, p.value, p.placeholder)
+		restore = append(restore, p.placeholder, p.value)
+	}
+
+	r.redact = strings.NewReplacer(redact...)
+	r.restore = strings.NewReplacer(restore...)
+}
+
+// redactString replaces the values by the placeholders.
+func (r *vcrRedactor) redactString(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.redact.Replace(s)
+}
+
+// redactBody replaces the values by the placeholders and the secrets by REDACTED.
+func (r *vcrRedactor) redactBody(s string) string {
+	for _, b := range vcrRedactedBodies {
+		s = b.re.ReplaceAllString(s, b.repl)
+	}
+	return r.redactString(s)
+}
+
+// redactHeaders returns a copy of the headers without the credentials.
+func (r *vcrRedactor) redactHeaders(h http.Header) http.Header {
+	redacted := make(http.Header, len(h))
+	for k, values := range h {
+		for _, v := range values {
+			redacted.Add(k, r.redactString(v))
+		}
+	}
+	for _, k := range vcrRedactedHeaders {
+		if redacted.Get(k) != "" {
+			redacted.Set(k, vcrRedacted)
+		}
+	}
+	return redacted
+}
+
+// restoreString replaces the placeholders by the values.
+func (r *vcrRedactor) restoreString(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.restore.Replace(s)
+}
+
+// vcrTransport is a http.RoundTripper that records the interactions with the APIs
+// in a cassette or serves them from it.
+type vcrTransport struct {
+	base     http.RoundTripper
+	mode     VCRMode
+	cassette *cassette
+	redactor *vcrRedactor
+}
+
+// newVCRTransport creates a record/replay transport on top of base.
+// values are the placeholders and the values to redact from the cassette.
+func newVCRTransport(opts VCROpts, base http.RoundTripper, values map[string]string) (*vcrTransport, error) {
+	c, err := loadCassette(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vcrTransport{
+		base:     base,
+		mode:     opts.Mode,
+		cassette: c,
+		redactor: newVCRRedactor(values),
+	}, nil
+}
+
+// addRedaction redacts a value known after the creation of the transport.
+// In record mode, the value is also redacted from the interactions already recorded.
+func (t *vcrTransport) addRedaction(placeholder, value string) error {
+	t.redactor.add(placeholder, value)
+	if t.mode != VCRModeRecord {
+		return nil
+	}
+	return t.cassette.redact(t.redactor)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == VCRModeReplay {
+		return t.replay(req)
+	}
+	return t.record(req)
+}
+
+// record sends the request and saves the interaction.
+func (t *vcrTransport) record(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.cassette.add(&vcrInteraction{
+		Request: vcrRequest{
+			Method:  req.Method,
+			URL:     t.requestURL(req),
+			Headers: t.redactor.redactHeaders(req.Header),
+			Body:    t.redactor.redactBody(reqBody),
+		},
+		Response: vcrResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    t.redactor.redactHeaders(resp.Header),
+			Body:       t.redactor.redactBody(respBody),
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("unable to save the cassette %s: %w", t.cassette.path, err)
+	}
+
+	return resp, nil
+}
+
+// replay serves the recorded response of the request.
+func (t *vcrTransport) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	url := t.requestURL(req)
+	i := t.cassette.next(req.Method, url)
+	if i == nil {
+		return nil, fmt.Errorf("%w : %s %s in the cassette %s", ErrVCRNoInteraction, req.Method, url, t.cassette.path)
+	}
+
+	header := make(http.Header, len(i.Response.Headers))
+	for k, values := range i.Response.Headers {
+		for _, v := range values {
+			header.Add(k, t.redactor.restoreString(v))
+		}
+	}
+	body := t.redactor.restoreString(i.Response.Body)
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+		StatusCode:    i.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// requestURL returns the redacted path and query of the request.
+// The host is not recorded so a cassette can be replayed against any endpoint.
+func (t *vcrTransport) requestURL(req *http.Request) string {
+	return t.redactor.redactString(req.URL.RequestURI())
+}
+
+// readBody reads the body and replaces it with a copy.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return string(data), nil
+}
@@ -0,0 +1,220 @@
+// Package client is the main client for the CloudAvenue provider.
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVCRTransport(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-Vmware-Vcloud-Access-Token", "s3cr3t-access-token")
+		switch r.URL.Path {
+		case "/api/org/cav01ev01ocb0001234":
+			_, _ = io.WriteString(w, `<Org name="cav01ev01ocb0001234"><Password>p@ssw0rd</Password></Org>`)
+		case "/oauth/tenant/cav01ev01ocb0001234/token":
+			_, _ = io.WriteString(w, `{"access_token": "s3cr3t-access-token", "expires_in": 3600}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cassette := filepath.Join(t.TempDir(), "cassettes", "TestVCRTransport.yaml")
+	values := map[string]string{
+		"REDACTED_PASSWORD":   "p@ssw0rd",
+		"cav00ev00ocb0000000": "cav01ev01ocb0001234",
+	}
+
+	// * Record
+	recorder, err := newVCRTransport(VCROpts{Mode: VCRModeRecord, Cassette: cassette}, http.DefaultTransport, values)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, path := range []string{"/api/org/cav01ev01ocb0001234", "/oauth/tenant/cav01ev01ocb0001234/token"} {
+		req, _ := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		req.SetBasicAuth("user@cav01ev01ocb0001234", "p@ssw0rd")
+		resp, err := recorder.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, secret := range []string{"p@ssw0rd", "s3cr3t-access-token", "cav01ev01ocb0001234", "Basic "} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("expected %q to be redacted from the cassette, got:\n%s", secret, data)
+		}
+	}
+
+	// * Replay with another organization and without the API
+	server.Close()
+	values["cav00ev00ocb0000000"] = "cav02ev02ocb0005678"
+
+	// Cassettes are shared by the process, drop the recorded one to read it from the file.
+	cassettesMu.Lock()
+	delete(cassettes, cassette)
+	cassettesMu.Unlock()
+
+	player, err := newVCRTransport(VCROpts{Mode: VCRModeReplay, Cassette: cassette}, http.DefaultTransport, values)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	t.Run("Replay", func(t *testing.T) {
+		// The last interaction is served again once all of them have been replayed.
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequest(http.MethodGet, "https://console.cloudavenue.invalid/api/org/cav02ev02ocb0005678", nil)
+			resp, err := player.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			expected := `<Org name="cav02ev02ocb0005678"><Password>REDACTED</Password></Org>`
+			if string(body) != expected {
+				t.Fatalf("expected body %q, got %q", expected, body)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+			if v := resp.Header.Get("X-Vmware-Vcloud-Access-Token"); v != vcrRedacted {
+				t.Fatalf("expected access token %q, got %q", vcrRedacted, v)
+			}
+		}
+	})
+
+	t.Run("ReplayJSON", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "https://console.cloudavenue.invalid/oauth/tenant/cav02ev02ocb0005678/token", nil)
+		resp, err := player.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		expected := `{"access_token": "REDACTED", "expires_in": 3600}`
+		if string(body) != expected {
+			t.Fatalf("expected body %q, got %q", expected, body)
+		}
+	})
+
+	t.Run("NoInteraction", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "https://console.cloudavenue.invalid/api/vdc", nil)
+		if _, err := player.RoundTrip(req); !errors.Is(err, ErrVCRNoInteraction) {
+			t.Fatalf("expected error %v, got %v", ErrVCRNoInteraction, err)
+		}
+	})
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls to the API, got %d", calls)
+	}
+}
+
+func TestVCRTransportAddRedaction(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/session":
+			_, _ = io.WriteString(w, `{"user":{"name":"dasilva"}}`)
+		case "/auth/token":
+			_, _ = io.WriteString(w, `{"access_token": "s3cr3t-access-token"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cassette := filepath.Join(t.TempDir(), "TestVCRTransportAddRedaction.yaml")
+	recorder, err := newVCRTransport(VCROpts{Mode: VCRModeRecord, Cassette: cassette}, http.DefaultTransport, map[string]string{
+		"REDACTED_NETBACKUP_USER": "netbackup-user",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The user of an API token is only known from the session.
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/session", nil)
+	resp, err := recorder.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	if err := recorder.addRedaction(vcrUserPlaceholder, "dasilva"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The NetBackup credentials are sent in a form encoded body.
+	req, _ = http.NewRequest(http.MethodPost, server.URL+"/auth/token", strings.NewReader("grant_type=password&username=netbackup-user&password=n3tb4ckup"))
+	resp, err = recorder.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	resp.Body.Close()
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, secret := range []string{"dasilva", "netbackup-user", "n3tb4ckup", "s3cr3t-access-token"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("expected %q to be redacted from the cassette, got:\n%s", secret, data)
+		}
+	}
+	for _, placeholder := range []string{vcrUserPlaceholder, "REDACTED_NETBACKUP_USER"} {
+		if !strings.Contains(string(data), placeholder) {
+			t.Fatalf("expected %q in the cassette, got:\n%s", placeholder, data)
+		}
+	}
+}
+
+func TestLoadCassette(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts VCROpts
+	}{
+		{
+			name: "EmptyPath",
+			opts: VCROpts{Mode: VCRModeRecord},
+		},
+		{
+			name: "InvalidMode",
+			opts: VCROpts{Mode: "rewind", Cassette: filepath.Join(t.TempDir(), "invalid.yaml")},
+		},
+		{
+			name: "ReplayMissingCassette",
+			opts: VCROpts{Mode: VCRModeReplay, Cassette: filepath.Join(t.TempDir(), "missing.yaml")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := loadCassette(tt.opts); err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+		})
+	}
+}
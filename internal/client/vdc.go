@@ -197,7 +197,7 @@ func WithVDCName(name string) GetVDCOpts {
 }
 
 // GetVDC
-// return the vdc using the name provided in the argument.
+// return the vdc using the name or the URN provided in the argument.
 // If the name is empty, it will try to use the default vdc provided in the provider.
 func (c *CloudAvenue) GetVDC(opts ...GetVDCOpts) (*VDC, error) {
 	v := &VDC{}
@@ -219,7 +219,12 @@ func (c *CloudAvenue) GetVDC(opts ...GetVDCOpts) (*VDC, error) {
 		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrg, err)
 	}
 
-	x, err := org.GetVDCByName(v.name, false)
+	var x *govcd.Vdc
+	if uuid.IsVDC(v.name) {
+		x, err = org.GetVDCById(v.name, false)
+	} else {
+		x, err = org.GetVDCByName(v.name, false)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s %w", ErrRetrievingVDC, v.name, err)
 	}
@@ -229,7 +234,7 @@ func (c *CloudAvenue) GetVDC(opts ...GetVDCOpts) (*VDC, error) {
 	return v, nil
 }
 
-// GetVDCGroup return the vdc group using the name provided in the argument.
+// GetVDCGroup return the vdc group using the name or the URN provided in the argument.
 func (c *CloudAvenue) GetVDCGroup(vdcGroupName string) (*VDCGroup, error) {
 	if vdcGroupName == "" {
 		return nil, fmt.Errorf("%w", ErrEmptyVDCNameProvided)
@@ -240,12 +245,17 @@ func (c *CloudAvenue) GetVDCGroup(vdcGroupName string) (*VDCGroup, error) {
 		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrg, err)
 	}
 
-	adminOrg, err := c.Vmware.GetAdminOrgByName(org.GetName())
+	adminOrg, err := c.vmware.GetAdminOrgByName(org.GetName())
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrRetrievingOrgAdmin, err)
 	}
 
-	x, err := adminOrg.GetVdcGroupByName(vdcGroupName)
+	var x *govcd.VdcGroup
+	if uuid.IsVDCGroup(vdcGroupName) {
+		x, err = adminOrg.GetVdcGroupById(vdcGroupName)
+	} else {
+		x, err = adminOrg.GetVdcGroupByName(vdcGroupName)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s %w", ErrRetrievingVDCGroup, vdcGroupName, err)
 	}
@@ -253,7 +263,7 @@ func (c *CloudAvenue) GetVDCGroup(vdcGroupName string) (*VDCGroup, error) {
 	return &VDCGroup{x}, nil
 }
 
-// GetVDCOrVDCGroup return the vdc or vdc group using the name provided in the argument.
+// GetVDCOrVDCGroup return the vdc or vdc group using the name or the URN provided in the argument.
 func (c *CloudAvenue) GetVDCOrVDCGroup(vdcOrVDCGroupName string) (VDCOrVDCGroupHandler, error) {
 	x, err := c.GetVDC(
 		WithVDCName(vdcOrVDCGroupName),
@@ -22,29 +22,33 @@ var (
 )
 
 func (c *CloudAvenue) getTemplate(iD string) (vAppTemplate *govcd.VAppTemplate, err error) {
-	return c.Vmware.GetVAppTemplateById(iD)
+	vmware, err := c.Vmware()
+	if err != nil {
+		return nil, err
+	}
+	return vmware.GetVAppTemplateById(iD)
 }
 
 // GetTemplate retrieves a vApp template by name or ID.
 func (c *CloudAvenue) GetTemplate(iD string) (vAppTemplate *govcd.VAppTemplate, err error) {
-	vAppTemplate = govcd.NewVAppTemplate(&c.Vmware.Client)
 	template, err := c.getTemplate(iD)
 	if err != nil || len(template.VAppTemplate.Children.VM) == 0 {
 		return nil, fmt.Errorf("error retrieving vApp template %s: %w", iD, err)
 	}
 
+	vAppTemplate = govcd.NewVAppTemplate(&c.vmware.Client)
 	vAppTemplate.VAppTemplate = template.VAppTemplate.Children.VM[0]
 	return
 }
 
 // GetTemplateWithVMName retrieves a vApp template with a VM name.
 func (c *CloudAvenue) GetTemplateWithVMName(iD, vmName string) (vAppTemplate *govcd.VAppTemplate, err error) {
-	vAppTemplate = govcd.NewVAppTemplate(&c.Vmware.Client)
 	template, err := c.getTemplate(iD)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving vApp template %s: %w", iD, err)
 	}
 
+	vAppTemplate = govcd.NewVAppTemplate(&c.vmware.Client)
 	for i, vm := range template.VAppTemplate.Children.VM {
 		if vm.Name == vmName {
 			vAppTemplate.VAppTemplate = template.VAppTemplate.Children.VM[i]
@@ -57,12 +61,21 @@ func (c *CloudAvenue) GetTemplateWithVMName(iD, vmName string) (vAppTemplate *go
 
 // getAffinityRule retrieves an affinity rule by name.
 func (c *CloudAvenue) GetAffinityRule(affinityRuleID string) (affinityRule *govcd.VdcComputePolicyV2, err error) {
-	return c.Vmware.GetVdcComputePolicyV2ById(affinityRuleID)
+	vmware, err := c.Vmware()
+	if err != nil {
+		return nil, err
+	}
+	return vmware.GetVdcComputePolicyV2ById(affinityRuleID)
 }
 
 // GetBootImage retrieves a boot image by ID.
 func (c *CloudAvenue) GetBootImage(bootImageID string) (bootImage *govcdtypes.Media, err error) {
-	bi, err := c.Vmware.QueryMediaById(bootImageID)
+	vmware, err := c.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
+	bi, err := vmware.QueryMediaById(bootImageID)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving boot image %s: %w", bootImageID, err)
 	}
@@ -88,7 +101,12 @@ func (c *CloudAvenue) GetOrgAndVDC(orgName, vdcName string) (org *govcd.Org, vdc
 		return nil, nil, fmt.Errorf("%w", ErrEmptyVDCNameProvided)
 	}
 
-	org, err = c.Vmware.GetOrgByName(orgName)
+	vmware, err := c.Vmware()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	org, err = vmware.GetOrgByName(orgName)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w: %w", ErrRetrievingOrg, err)
 	}
@@ -104,7 +122,7 @@ func (c *CloudAvenue) GetOrgAndVDC(orgName, vdcName string) (org *govcd.Org, vdc
 	} else if err != nil && govcd.ContainsNotFound(err) {
 		// VDC Group
 		var adminOrg *govcd.AdminOrg
-		adminOrg, err = c.Vmware.GetAdminOrgByName(orgName)
+		adminOrg, err = vmware.GetAdminOrgByName(orgName)
 		if err != nil {
 			return nil, nil, fmt.Errorf("%w: %s %w", ErrRetrievingOrgAdmin, vdcName, err)
 		}
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	cloudavenue "github.com/orange-cloudavenue/infrapi-sdk-go"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 )
 
 // APIError is an error returned by the CloudAvenue API.
@@ -68,6 +70,20 @@ func (e *APIError) IsNotFound() bool {
 	return e.GetStatusCode() == http.StatusNotFound
 }
 
+// IsRetryable returns true if the error is a transient error that is worth retrying.
+// Throttling, gateway errors and VCD busy entity errors are retryable, others are permanent.
+func (e *APIError) IsRetryable() bool {
+	switch e.GetStatusCode() {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case 0:
+		// No HTTP response, the request failed before reaching the API.
+		return client.IsTransientError(e.lastError)
+	}
+
+	return client.IsBusyEntity(e.Reason) || client.IsBusyEntity(e.Message) || client.IsBusyEntity(e.lastError.Error())
+}
+
 // CheckAPIError checks the HTTP response for errors and returns an APIError with
 // statusCode and model is the error is a cloudavenue.GenericSwaggerError type
 // or only the error if not.
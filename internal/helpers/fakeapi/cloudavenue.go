@@ -0,0 +1,423 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// PublicIP is a public IP of the organization.
+	PublicIP struct {
+		UplinkIP        string `json:"uplinkIp"`
+		TranslatedIP    string `json:"translatedIp"`
+		EdgeGatewayName string `json:"edgeGatewayName"`
+	}
+
+	// EdgeGateway is an NSX-T edge gateway of the organization.
+	EdgeGateway struct {
+		ID           string `json:"edgeId"`
+		Name         string `json:"edgeName"`
+		Description  string `json:"description"`
+		OwnerType    string `json:"ownerType"`
+		OwnerName    string `json:"ownerName"`
+		Tier0VrfName string `json:"tier0VrfId"`
+		Bandwidth    int    `json:"rateLimit"`
+	}
+
+	// VDC is a virtual data center of the organization.
+	VDC struct {
+		ID                  string              `json:"-"`
+		VdcGroup            string              `json:"-"`
+		Name                string              `json:"name"`
+		Description         string              `json:"description"`
+		ServiceClass        string              `json:"vdcServiceClass"`
+		DisponibilityClass  string              `json:"vdcDisponibilityClass"`
+		BillingModel        string              `json:"vdcBillingModel"`
+		VcpuInMhz2          float64             `json:"vcpuInMhz2"`
+		CPUAllocated        float64             `json:"cpuAllocated"`
+		MemoryAllocated     float64             `json:"memoryAllocated"`
+		StorageBillingModel string              `json:"vdcStorageBillingModel"`
+		StorageProfiles     []VDCStorageProfile `json:"vdcStorageProfiles"`
+	}
+
+	// VDCStorageProfile is a storage profile of a VDC.
+	VDCStorageProfile struct {
+		Class   string `json:"class"`
+		Limit   int    `json:"limit"`
+		Default bool   `json:"default"`
+	}
+
+	// Tier0VRF is a Tier-0 VRF available to the organization.
+	Tier0VRF struct {
+		Name          string          `json:"tier0_vrf"`
+		Tier0Provider string          `json:"tier0_provider"`
+		ClassService  string          `json:"class_service"`
+		Services      []Tier0Services `json:"services"`
+	}
+
+	// Tier0Services is a service of a Tier-0 VRF.
+	Tier0Services struct {
+		Service string `json:"service"`
+		VlanID  string `json:"vlanId"`
+	}
+
+	vdcBody struct {
+		VdcGroup string `json:"vdcGroup,omitempty"`
+		Vdc      *VDC   `json:"vdc"`
+	}
+)
+
+// AddPublicIP adds a public IP. The translated IP is set to the uplink IP if empty.
+func (s *Server) AddPublicIP(ip PublicIP) PublicIP {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ip.TranslatedIP == "" {
+		ip.TranslatedIP = ip.UplinkIP
+	}
+	s.publicIPs = append(s.publicIPs, &ip)
+	return ip
+}
+
+// PublicIPs returns the public IPs of the organization.
+func (s *Server) PublicIPs() []PublicIP {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ips := make([]PublicIP, 0, len(s.publicIPs))
+	for _, ip := range s.publicIPs {
+		ips = append(ips, *ip)
+	}
+	return ips
+}
+
+// AddEdgeGateway adds an edge gateway. The ID is generated if empty and the owner type defaults to "vdc".
+func (s *Server) AddEdgeGateway(edge EdgeGateway) EdgeGateway {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addEdgeGateway(edge)
+}
+
+func (s *Server) addEdgeGateway(edge EdgeGateway) *EdgeGateway {
+	if edge.ID == "" {
+		edge.ID = "urn:vcloud:gateway:" + uuid.NewString()
+	}
+	if edge.OwnerType == "" {
+		edge.OwnerType = "vdc"
+	}
+	if edge.Bandwidth == 0 {
+		edge.Bandwidth = 5
+	}
+	s.edgeGateways = append(s.edgeGateways, &edge)
+	return &edge
+}
+
+// EdgeGateways returns the edge gateways of the organization.
+func (s *Server) EdgeGateways() []EdgeGateway {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	edges := make([]EdgeGateway, 0, len(s.edgeGateways))
+	for _, edge := range s.edgeGateways {
+		edges = append(edges, *edge)
+	}
+	return edges
+}
+
+// AddVDC adds a VDC. The ID is generated if empty.
+func (s *Server) AddVDC(vdc VDC) VDC {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return *s.addVDC(vdc)
+}
+
+func (s *Server) addVDC(vdc VDC) *VDC {
+	if vdc.ID == "" {
+		vdc.ID = "urn:vcloud:vdc:" + uuid.NewString()
+	}
+	s.vdcs = append(s.vdcs, &vdc)
+	return &vdc
+}
+
+// VDCs returns the VDCs of the organization.
+func (s *Server) VDCs() []VDC {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vdcs := make([]VDC, 0, len(s.vdcs))
+	for _, vdc := range s.vdcs {
+		vdcs = append(vdcs, *vdc)
+	}
+	return vdcs
+}
+
+// AddTier0VRF adds a Tier-0 VRF.
+func (s *Server) AddTier0VRF(t0 Tier0VRF) Tier0VRF {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tier0VRFs = append(s.tier0VRFs, &t0)
+	return t0
+}
+
+func (s *Server) findPublicIP(ip string) int {
+	for i, publicIP := range s.publicIPs {
+		if publicIP.UplinkIP == ip {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) findVDC(name string) (int, *VDC) {
+	for i, vdc := range s.vdcs {
+		if vdc.Name == name {
+			return i, vdc
+		}
+	}
+	return -1, nil
+}
+
+func (s *Server) findEdgeGateway(id string) (int, *EdgeGateway) {
+	for i, edge := range s.edgeGateways {
+		if edge.ID == id || edge.Name == id {
+			return i, edge
+		}
+	}
+	return -1, nil
+}
+
+// registerCloudAvenueRoutes registers the endpoints of the Cloud Avenue API.
+func (s *Server) registerCloudAvenueRoutes() {
+	// * Jobs
+	s.handle(http.MethodGet, "/api/customers/v1.0/jobs/{jobId}", s.getJob)
+
+	// * Public IPs
+	s.handle(http.MethodGet, "/api/customers/v2.0/ip", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"internalIp":    "",
+			"networkConfig": append([]*PublicIP{}, s.publicIPs...),
+		})
+	})
+	s.handle(http.MethodPost, "/api/customers/v1.0/ip", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		edgeName := r.Header.Get("X-VDC-Edge-Name")
+		if _, edge := s.findEdgeGateway(edgeName); edge == nil {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("edge gateway %q not found", edgeName))
+			return
+		}
+
+		ip := fmt.Sprintf("192.0.2.%d", len(s.publicIPs)+1)
+		writeJobCreated(w, s.newJob("create_public_ip", func() {
+			s.publicIPs = append(s.publicIPs, &PublicIP{UplinkIP: ip, TranslatedIP: ip, EdgeGatewayName: edgeName})
+		}))
+	})
+	s.handle(http.MethodDelete, "/api/customers/v1.0/ip/{ip}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		ip := PathParam(r, "ip")
+		if s.findPublicIP(ip) < 0 {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("public IP %q not found", ip))
+			return
+		}
+		writeJobCreated(w, s.newJob("delete_public_ip", func() {
+			if i := s.findPublicIP(ip); i >= 0 {
+				s.publicIPs = append(s.publicIPs[:i], s.publicIPs[i+1:]...)
+			}
+		}))
+	})
+
+	// * VDCs
+	s.handle(http.MethodGet, "/api/customers/v2.0/vdcs", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		list := make([]map[string]string, 0, len(s.vdcs))
+		for _, vdc := range s.vdcs {
+			list = append(list, map[string]string{"vdc_name": vdc.Name, "vdc_uuid": vdc.ID})
+		}
+		writeJSON(w, http.StatusOK, list)
+	})
+	s.handle(http.MethodGet, "/api/customers/v2.0/vdcs/{vdcName}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, vdc := s.findVDC(PathParam(r, "vdcName"))
+		if vdc == nil {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("VDC %q not found", PathParam(r, "vdcName")))
+			return
+		}
+		writeJSON(w, http.StatusOK, vdcBody{VdcGroup: vdc.VdcGroup, Vdc: vdc})
+	})
+	s.handle(http.MethodPost, "/api/customers/v2.0/vdcs", func(w http.ResponseWriter, r *http.Request) {
+		body := vdcBody{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Vdc == nil || body.Vdc.Name == "" {
+			writeError(w, r, http.StatusBadRequest, "invalid VDC body")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, vdc := s.findVDC(body.Vdc.Name); vdc != nil {
+			writeError(w, r, http.StatusConflict, fmt.Sprintf("VDC %q already exists", body.Vdc.Name))
+			return
+		}
+		writeJobCreated(w, s.newJob("create_vdc", func() {
+			s.addVDC(*body.Vdc)
+		}))
+	})
+	s.handle(http.MethodPut, "/api/customers/v2.0/vdcs/{vdcName}", func(w http.ResponseWriter, r *http.Request) {
+		body := vdcBody{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Vdc == nil {
+			writeError(w, r, http.StatusBadRequest, "invalid VDC body")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, vdc := s.findVDC(PathParam(r, "vdcName"))
+		if vdc == nil {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("VDC %q not found", PathParam(r, "vdcName")))
+			return
+		}
+		writeJobCreated(w, s.newJob("update_vdc", func() {
+			id := vdc.ID
+			*vdc = *body.Vdc
+			vdc.ID = id
+			vdc.VdcGroup = body.VdcGroup
+		}))
+	})
+	s.handle(http.MethodDelete, "/api/customers/v2.0/vdcs/{vdcName}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, vdc := s.findVDC(PathParam(r, "vdcName"))
+		if vdc == nil {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("VDC %q not found", PathParam(r, "vdcName")))
+			return
+		}
+		writeJobCreated(w, s.newJob("delete_vdc", func() {
+			if i, _ := s.findVDC(vdc.Name); i >= 0 {
+				s.vdcs = append(s.vdcs[:i], s.vdcs[i+1:]...)
+			}
+		}))
+	})
+
+	// * Edge gateways
+	s.handle(http.MethodGet, "/api/customers/v2.0/edges", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		writeJSON(w, http.StatusOK, s.edgeGateways)
+	})
+	s.handle(http.MethodGet, "/api/customers/v2.0/edges/{edgeId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, edge := s.findEdgeGateway(PathParam(r, "edgeId"))
+		if edge == nil {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("edge gateway %q not found", PathParam(r, "edgeId")))
+			return
+		}
+		writeJSON(w, http.StatusOK, edge)
+	})
+	createEdgeGateway := func(ownerType, ownerParam string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			body := struct {
+				Tier0VrfName string `json:"tier0VrfId"`
+			}{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Tier0VrfName == "" {
+				writeError(w, r, http.StatusBadRequest, "invalid edge gateway body")
+				return
+			}
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			owner := PathParam(r, ownerParam)
+			writeJobCreated(w, s.newJob("create_edge_gateway", func() {
+				s.addEdgeGateway(EdgeGateway{
+					Name:         fmt.Sprintf("tn01e02ocb0001234spt%03d", len(s.edgeGateways)+101),
+					OwnerType:    ownerType,
+					OwnerName:    owner,
+					Tier0VrfName: body.Tier0VrfName,
+				})
+			}))
+		}
+	}
+	s.handle(http.MethodPost, "/api/customers/v2.0/vdcs/{vdcName}/edges", createEdgeGateway("vdc", "vdcName"))
+	s.handle(http.MethodPost, "/api/customers/v2.0/vdc-groups/{vdcGroupName}/edges", createEdgeGateway("vdc-group", "vdcGroupName"))
+	s.handle(http.MethodPut, "/api/customers/v2.0/edges/{edgeId}", func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Bandwidth int `json:"rateLimit"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, r, http.StatusBadRequest, "invalid edge gateway body")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, edge := s.findEdgeGateway(PathParam(r, "edgeId"))
+		if edge == nil {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("edge gateway %q not found", PathParam(r, "edgeId")))
+			return
+		}
+		writeJobCreated(w, s.newJob("update_edge_gateway", func() {
+			edge.Bandwidth = body.Bandwidth
+		}))
+	})
+	s.handle(http.MethodDelete, "/api/customers/v2.0/edges/{edgeId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, edge := s.findEdgeGateway(PathParam(r, "edgeId"))
+		if edge == nil {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("edge gateway %q not found", PathParam(r, "edgeId")))
+			return
+		}
+		writeJobCreated(w, s.newJob("delete_edge_gateway", func() {
+			if i, _ := s.findEdgeGateway(edge.ID); i >= 0 {
+				s.edgeGateways = append(s.edgeGateways[:i], s.edgeGateways[i+1:]...)
+			}
+		}))
+	})
+
+	// * Tier-0 VRFs
+	s.handle(http.MethodGet, "/api/customers/v2.0/tier-0-vrfs", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		names := make([]string, 0, len(s.tier0VRFs))
+		for _, t0 := range s.tier0VRFs {
+			names = append(names, t0.Name)
+		}
+		writeJSON(w, http.StatusOK, names)
+	})
+	s.handle(http.MethodGet, "/api/customers/v2.0/tier-0-vrfs/{t0Name}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for _, t0 := range s.tier0VRFs {
+			if t0.Name == PathParam(r, "t0Name") {
+				writeJSON(w, http.StatusOK, t0)
+				return
+			}
+		}
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf("Tier-0 VRF %q not found", PathParam(r, "t0Name")))
+	})
+}
@@ -0,0 +1,359 @@
+// Package fakeapi provides an in-process fake of the Cloud Avenue and VMware VCD APIs for unit tests.
+//
+// The server implements the endpoints used to authenticate (govcd and Cloud Avenue API),
+// the Cloud Avenue jobs, public IPs, VDCs, edge gateways and Tier-0 VRFs, and the VCD
+// organization, VDC, VDC group and NSX-T edge gateway lookups, the API tokens of the user and the S3 credentials
+// API (reached by the SDK at the S3_ENDPOINT environment variable). Asynchronous operations return a job
+// which goes through the states set with SetJobStates before the change is applied.
+// Any endpoint can be added or overridden with Handle and errors can be injected with InjectError.
+//
+// Example:
+//
+//	server := fakeapi.New(t)
+//	server.AddEdgeGateway(fakeapi.EdgeGateway{Name: "edge01", OwnerName: "vdc01"})
+//
+//	resource.UnitTest(t, resource.TestCase{
+//		ProtoV6ProviderFactories: ...,
+//		Steps: []resource.TestStep{{
+//			Config: server.ProviderConfig() + config,
+//		}},
+//	})
+package fakeapi
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultOrg is the name of the organization of the server.
+	DefaultOrg = "cav01ev01ocb0001234"
+	// DefaultUser is the name of the user of the sessions.
+	DefaultUser = "fakeuser"
+	// DefaultPassword is the password of the user.
+	DefaultPassword = "fake-password" //nolint:gosec // not a credential
+	// DefaultAPIToken is the API token accepted by the server.
+	DefaultAPIToken = "fake-api-token" //nolint:gosec // not a credential
+	// DefaultAccessToken is the access token returned by the server.
+	// Like the bearer tokens of VCD, it is longer than 32 characters so govcd also sends it
+	// in the Authorization header.
+	DefaultAccessToken = "fake-access-token-0123456789abcdef" //nolint:gosec // not a credential
+
+	// APIVersion is the VCD API version announced by the server.
+	APIVersion = "37.2"
+)
+
+// Server is a fake Cloud Avenue and VCD API server.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	org    org
+	routes []route
+	errors []*injectedError
+
+	jobs         map[string]*job
+	jobStates    []string
+	jobFailures  []string
+	vdcs         []*VDC
+	edgeGateways []*EdgeGateway
+	publicIPs    []*PublicIP
+	tier0VRFs    []*Tier0VRF
+	tokens       []*Token
+	s3Users      []*s3User
+
+	requests []string
+}
+
+type org struct {
+	id   string
+	name string
+}
+
+type route struct {
+	method  string
+	pattern []string
+	handler http.HandlerFunc
+	public  bool
+}
+
+type injectedError struct {
+	method  string
+	pattern []string
+	status  int
+	message string
+	times   int
+}
+
+type pathParamsKey struct{}
+
+// New starts a new fake server. The server is closed at the end of the test.
+func New(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		org: org{
+			// The ID is derived from the name: the S3 client of the SDK keeps the ID of the first organization it reads.
+			id:   uuid.NewSHA1(uuid.NameSpaceOID, []byte(DefaultOrg)).String(),
+			name: DefaultOrg,
+		},
+		jobs:      map[string]*job{},
+		jobStates: []string{"CREATED", "IN_PROGRESS", "DONE"},
+	}
+
+	s.addS3User(DefaultUser)
+
+	s.registerVCDRoutes()
+	s.registerCloudAvenueRoutes()
+	s.registerS3Routes()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Org returns the name of the organization.
+func (s *Server) Org() string {
+	return s.org.name
+}
+
+// OrgID returns the URN of the organization.
+func (s *Server) OrgID() string {
+	return "urn:vcloud:org:" + s.org.id
+}
+
+// ProviderConfig returns the provider block authenticating to the server with an API token.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "cloudavenue" {
+  url   = %q
+  org   = %q
+  token = %q
+  max_retries = 0
+
+  telemetry {
+    mode = "off"
+  }
+}
+`, s.URL, s.org.name, DefaultAPIToken)
+}
+
+// ProviderConfigWithCredentials returns the provider block authenticating to the server with the
+// user and the password. The Cloud Avenue SDK (edge gateways...) then opens its own session.
+func (s *Server) ProviderConfigWithCredentials() string {
+	return fmt.Sprintf(`
+provider "cloudavenue" {
+  url      = %q
+  org      = %q
+  user     = %q
+  password = %q
+  max_retries = 0
+
+  telemetry {
+    mode = "off"
+  }
+}
+`, s.URL, s.org.name, DefaultUser, DefaultPassword)
+}
+
+// Handle registers the handler for the method and the path pattern.
+// The pattern segments between braces are parameters, available with PathParam.
+// The handlers registered with Handle take precedence over the built-in ones.
+func (s *Server) Handle(method, pattern string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = append([]route{{method: method, pattern: splitPath(pattern), handler: handler}}, s.routes...)
+}
+
+// InjectError makes the next times requests matching the method and the path pattern fail
+// with the status code. The error body uses the format of the API of the path.
+func (s *Server) InjectError(method, pattern string, status, times int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.errors = append(s.errors, &injectedError{
+		method:  method,
+		pattern: splitPath(pattern),
+		status:  status,
+		message: message,
+		times:   times,
+	})
+}
+
+// Requests returns the requests received by the server as "METHOD /path".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+// PathParam returns the value of a parameter of the path pattern.
+func PathParam(r *http.Request, name string) string {
+	params, _ := r.Context().Value(pathParamsKey{}).(map[string]string)
+	return params[name]
+}
+
+func (s *Server) handle(method, pattern string, handler http.HandlerFunc) {
+	s.routes = append(s.routes, route{method: method, pattern: splitPath(pattern), handler: handler})
+}
+
+// handlePublic registers a handler which does not require an access token.
+func (s *Server) handlePublic(method, pattern string, handler http.HandlerFunc) {
+	s.routes = append(s.routes, route{method: method, pattern: splitPath(pattern), handler: handler, public: true})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+	for _, e := range s.errors {
+		if e.times == 0 || e.method != r.Method {
+			continue
+		}
+		if _, ok := matchPath(e.pattern, r.URL.Path); ok {
+			e.times--
+			s.mu.Unlock()
+			writeError(w, r, e.status, e.message)
+			return
+		}
+	}
+
+	var (
+		matched *route
+		params  map[string]string
+	)
+	for i := range s.routes {
+		if s.routes[i].method != r.Method {
+			continue
+		}
+		if p, ok := matchPath(s.routes[i].pattern, r.URL.Path); ok {
+			matched, params = &s.routes[i], p
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if matched == nil {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf("fakeapi: no handler for %s %s, register one with Server.Handle", r.Method, r.URL.Path))
+		return
+	}
+
+	if !matched.public && !authorized(r) {
+		writeError(w, r, http.StatusUnauthorized, "fakeapi: missing or invalid access token")
+		return
+	}
+
+	matched.handler(w, r.WithContext(context.WithValue(r.Context(), pathParamsKey{}, params)))
+}
+
+// authorized returns true if the request carries the access token of the server
+// in every authentication header sent by its client:
+//   - the Cloud Avenue API and the SDK send "Authorization: Bearer <token>",
+//   - govcd also sends the token in its own header (X-Vmware-Vcloud-Access-Token with an API token,
+//     X-Vcloud-Authorization with a user and a password) with "X-Vmware-Vcloud-Token-Type: Bearer".
+func authorized(r *http.Request) bool {
+	authorization := r.Header.Values("Authorization")
+	if len(authorization) != 1 || !strings.EqualFold(authorization[0], "Bearer "+DefaultAccessToken) {
+		return false
+	}
+
+	for _, header := range []string{"X-Vmware-Vcloud-Access-Token", "X-Vcloud-Authorization"} {
+		values := r.Header.Values(header)
+		if len(values) == 0 {
+			continue
+		}
+		if len(values) != 1 || values[0] != DefaultAccessToken || r.Header.Get("X-Vmware-Vcloud-Token-Type") != "Bearer" {
+			return false
+		}
+	}
+	return true
+}
+
+func splitPath(p string) []string {
+	return strings.Split(strings.Trim(p, "/"), "/")
+}
+
+func matchPath(pattern []string, p string) (map[string]string, bool) {
+	segments := splitPath(p)
+	if len(segments) != len(pattern) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, segment := range pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// writeError writes an error in the format of the API of the request path.
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/cloudapi/"), strings.HasPrefix(r.URL.Path, "/oauth/"):
+		writeJSON(w, status, map[string]string{
+			"minorErrorCode": http.StatusText(status),
+			"message":        message,
+			"stackTrace":     "",
+		})
+	case strings.HasPrefix(r.URL.Path, "/api/v1/core/"):
+		writeJSON(w, status, map[string]any{
+			"status":  status,
+			"code":    http.StatusText(status),
+			"message": message,
+		})
+	case strings.HasPrefix(r.URL.Path, "/api/customers/"):
+		writeJSON(w, status, map[string]string{
+			"code":    fmt.Sprint(status),
+			"reason":  http.StatusText(status),
+			"message": message,
+		})
+	default:
+		writeXML(w, status, struct {
+			XMLName        xml.Name `xml:"Error"`
+			Message        string   `xml:"message,attr"`
+			MajorErrorCode int      `xml:"majorErrorCode,attr"`
+			MinorErrorCode string   `xml:"minorErrorCode,attr"`
+		}{
+			Message:        message,
+			MajorErrorCode: status,
+			MinorErrorCode: http.StatusText(status),
+		})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeXML(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/*+xml;version="+APIVersion)
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+// baseURL returns the scheme and the host of the request.
+func baseURL(r *http.Request) string {
+	return "http://" + r.Host
+}
@@ -0,0 +1,658 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	clientca "github.com/orange-cloudavenue/cloudavenue-sdk-go"
+	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+)
+
+// call sends an authenticated request to the Cloud Avenue API of the server and decodes the response.
+func call(t *testing.T, s *Server, method, path, body string, v any) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+DefaultAccessToken)
+	req.Header.Set("X-VDC-Edge-Name", "edge01")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer resp.Body.Close()
+
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("expected no error decoding %s %s, got %v", method, path, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+// waitJob polls the job until it reaches a final state and returns the states seen.
+func waitJob(t *testing.T, s *Server, jobID string) []jobStatus {
+	t.Helper()
+
+	var seen []jobStatus
+	for i := 0; i < 10; i++ {
+		status := []jobStatus{}
+		if code := call(t, s, http.MethodGet, "/api/customers/v1.0/jobs/"+jobID, "", &status); code != http.StatusOK {
+			t.Fatalf("expected status code %d, got %d", http.StatusOK, code)
+		}
+		seen = append(seen, status[0])
+		if status[0].Status == "DONE" || status[0].Status == "FAILED" {
+			return seen
+		}
+	}
+	t.Fatalf("expected job %s to end, got %v", jobID, seen)
+	return nil
+}
+
+func TestJobs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Done", func(t *testing.T) {
+		t.Parallel()
+
+		s := New(t)
+		s.AddEdgeGateway(EdgeGateway{Name: "edge01", OwnerName: "vdc01"})
+
+		created := map[string]string{}
+		if code := call(t, s, http.MethodPost, "/api/customers/v1.0/ip", "", &created); code != http.StatusCreated {
+			t.Fatalf("expected status code %d, got %d", http.StatusCreated, code)
+		}
+
+		// The change is only applied once the job is done.
+		status := []jobStatus{}
+		call(t, s, http.MethodGet, "/api/customers/v1.0/jobs/"+created["jobId"], "", &status)
+		if len(s.PublicIPs()) != 0 {
+			t.Fatalf("expected no public IP before the job is done, got %v", s.PublicIPs())
+		}
+
+		states := []string{status[0].Status}
+		for _, status := range waitJob(t, s, created["jobId"]) {
+			states = append(states, status.Status)
+		}
+		if strings.Join(states, ",") != "CREATED,IN_PROGRESS,DONE" {
+			t.Fatalf("expected states CREATED,IN_PROGRESS,DONE, got %v", states)
+		}
+		if ips := s.PublicIPs(); len(ips) != 1 || ips[0].EdgeGatewayName != "edge01" {
+			t.Fatalf("expected 1 public IP on edge01, got %v", ips)
+		}
+	})
+
+	t.Run("CustomStates", func(t *testing.T) {
+		t.Parallel()
+
+		s := New(t)
+		s.SetJobStates("PENDING", "RUNNING", "RUNNING", "DONE")
+
+		created := map[string]string{}
+		call(t, s, http.MethodPost, "/api/customers/v2.0/vdcs", `{"vdc":{"name":"vdc01"}}`, &created)
+
+		if seen := waitJob(t, s, created["jobId"]); len(seen) != 4 {
+			t.Fatalf("expected 4 states, got %v", seen)
+		}
+		if vdcs := s.VDCs(); len(vdcs) != 1 || vdcs[0].Name != "vdc01" {
+			t.Fatalf("expected VDC vdc01, got %v", vdcs)
+		}
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		t.Parallel()
+
+		s := New(t)
+		s.AddVDC(VDC{Name: "vdc01"})
+		s.FailNextJob("delete_storage")
+
+		created := map[string]string{}
+		call(t, s, http.MethodDelete, "/api/customers/v2.0/vdcs/vdc01", "", &created)
+
+		seen := waitJob(t, s, created["jobId"])
+		last := seen[len(seen)-1]
+		if last.Status != "FAILED" {
+			t.Fatalf("expected state FAILED, got %s", last.Status)
+		}
+		if a := last.Actions[len(last.Actions)-1]; a.Name != "delete_storage" || a.Status != "FAILED" {
+			t.Fatalf("expected failed action delete_storage, got %v", a)
+		}
+		if len(s.VDCs()) != 1 {
+			t.Fatalf("expected the VDC to be kept, got %v", s.VDCs())
+		}
+	})
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	s := New(t)
+	s.InjectError(http.MethodGet, "/api/customers/v2.0/edges/{edgeId}", http.StatusInternalServerError, 1, "boom")
+	edge := s.AddEdgeGateway(EdgeGateway{Name: "edge01", OwnerName: "vdc01"})
+
+	tests := []struct {
+		name       string
+		path       string
+		token      string
+		statusCode int
+	}{
+		{
+			name:       "Injected",
+			path:       "/api/customers/v2.0/edges/" + edge.ID,
+			token:      DefaultAccessToken,
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "InjectedOnce",
+			path:       "/api/customers/v2.0/edges/" + edge.ID,
+			token:      DefaultAccessToken,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "NotFound",
+			path:       "/api/customers/v2.0/edges/edge02",
+			token:      DefaultAccessToken,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "Unauthorized",
+			path:       "/api/customers/v2.0/edges",
+			token:      "invalid",
+			statusCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "NoHandler",
+			path:       "/api/customers/v2.0/unknown",
+			token:      DefaultAccessToken,
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	// The subtests are not parallel, the injected error is consumed by the first one.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, s.URL+tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.statusCode {
+				t.Fatalf("expected status code %d, got %d", tt.statusCode, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestAuthorized(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		authorized bool
+	}{
+		{
+			name:       "CloudAvenue",
+			headers:    map[string]string{"Authorization": "Bearer " + DefaultAccessToken},
+			authorized: true,
+		},
+		{
+			name: "VmwareAPIToken",
+			headers: map[string]string{
+				"Authorization":                "bearer " + DefaultAccessToken,
+				"X-Vmware-Vcloud-Access-Token": DefaultAccessToken,
+				"X-Vmware-Vcloud-Token-Type":   "Bearer",
+			},
+			authorized: true,
+		},
+		{
+			name: "VmwareUser",
+			headers: map[string]string{
+				"Authorization":              "bearer " + DefaultAccessToken,
+				"X-Vcloud-Authorization":     DefaultAccessToken,
+				"X-Vmware-Vcloud-Token-Type": "Bearer",
+			},
+			authorized: true,
+		},
+		{
+			name:    "EmptyToken",
+			headers: map[string]string{"Authorization": "Bearer "},
+		},
+		{
+			name: "StaleAuthorization",
+			headers: map[string]string{
+				"Authorization":                "bearer stale-token",
+				"X-Vmware-Vcloud-Access-Token": DefaultAccessToken,
+				"X-Vmware-Vcloud-Token-Type":   "Bearer",
+			},
+		},
+		{
+			name: "MissingAuthorization",
+			headers: map[string]string{
+				"X-Vmware-Vcloud-Access-Token": DefaultAccessToken,
+				"X-Vmware-Vcloud-Token-Type":   "Bearer",
+			},
+		},
+		{
+			name: "StaleVmwareToken",
+			headers: map[string]string{
+				"Authorization":                "bearer " + DefaultAccessToken,
+				"X-Vmware-Vcloud-Access-Token": "stale-token",
+				"X-Vmware-Vcloud-Token-Type":   "Bearer",
+			},
+		},
+		{
+			name: "MissingTokenType",
+			headers: map[string]string{
+				"Authorization":                "bearer " + DefaultAccessToken,
+				"X-Vmware-Vcloud-Access-Token": DefaultAccessToken,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+			if got := authorized(req); got != tt.authorized {
+				t.Fatalf("expected authorized %v, got %v", tt.authorized, got)
+			}
+		})
+	}
+}
+
+func TestVCD(t *testing.T) {
+	t.Parallel()
+
+	s := New(t)
+	vdc := s.AddVDC(VDC{Name: "vdc01"})
+	edge := s.AddEdgeGateway(EdgeGateway{Name: "edge01", OwnerName: "vdc01"})
+
+	ca, err := (&client.CloudAvenue{
+		URL:        s.URL,
+		Org:        s.Org(),
+		Token:      DefaultAPIToken,
+		VCDVersion: APIVersion,
+	}).New()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	adminOrg, err := ca.GetAdminOrg()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if adminOrg.AdminOrg.AdminOrg.ID != s.OrgID() {
+		t.Fatalf("expected org %s, got %s", s.OrgID(), adminOrg.AdminOrg.AdminOrg.ID)
+	}
+	if ca.GetUserName() != DefaultUser {
+		t.Fatalf("expected user %s, got %s", DefaultUser, ca.GetUserName())
+	}
+
+	egw, err := adminOrg.GetNsxtEdgeGatewayByName("edge01")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if egw.EdgeGateway.ID != edge.ID || egw.EdgeGateway.OwnerRef.ID != vdc.ID {
+		t.Fatalf("expected edge gateway %s owned by %s, got %s owned by %s", edge.ID, vdc.ID, egw.EdgeGateway.ID, egw.EdgeGateway.OwnerRef.ID)
+	}
+
+	if _, err := adminOrg.GetNsxtEdgeGatewayByName("edge02"); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestVCDGroups(t *testing.T) {
+	t.Parallel()
+
+	s := New(t)
+	vdc01 := s.AddVDC(VDC{Name: "vdc01", VdcGroup: "group01"})
+	s.AddVDC(VDC{Name: "vdc02"})
+	s.AddEdgeGateway(EdgeGateway{Name: "edge01", OwnerType: "vdc-group", OwnerName: "group01"})
+
+	ca, err := (&client.CloudAvenue{
+		URL:        s.URL,
+		Org:        s.Org(),
+		Token:      DefaultAPIToken,
+		VCDVersion: APIVersion,
+	}).New()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	adminOrg, err := ca.GetAdminOrg()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	groups, err := adminOrg.GetAllVdcGroups(nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(groups) != 1 || groups[0].VdcGroup.Name != "group01" || len(groups[0].VdcGroup.ParticipatingOrgVdcs) != 1 || groups[0].VdcGroup.ParticipatingOrgVdcs[0].VdcRef.ID != vdc01.ID {
+		t.Fatalf("expected group group01 of vdc01, got %v", groups)
+	}
+
+	egw, err := adminOrg.GetNsxtEdgeGatewayByName("edge01")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if egw.EdgeGateway.OwnerRef.ID != groups[0].VdcGroup.Id {
+		t.Fatalf("expected edge gateway owned by %s, got %s", groups[0].VdcGroup.Id, egw.EdgeGateway.OwnerRef.ID)
+	}
+
+	org, err := ca.GetOrg()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	vdcs, err := org.QueryOrgVdcList()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vdcs) != 2 || vdcs[0].Name != "vdc01" || vdcs[1].Name != "vdc02" {
+		t.Fatalf("expected vdc01 and vdc02, got %v", vdcs)
+	}
+}
+
+func TestVCDUserName(t *testing.T) {
+	t.Parallel()
+
+	s := New(t)
+
+	ca, err := (&client.CloudAvenue{
+		URL:        s.URL,
+		Org:        s.Org(),
+		Token:      DefaultAPIToken,
+		VCDVersion: APIVersion,
+	}).New()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The user name is read while the Vmware client is initialized.
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					_ = ca.GetUserName()
+				}
+			}
+		}()
+	}
+
+	_, err = ca.Vmware()
+	close(done)
+	wg.Wait()
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ca.GetUserName() != DefaultUser {
+		t.Fatalf("expected user %s, got %s", DefaultUser, ca.GetUserName())
+	}
+}
+
+func TestTokens(t *testing.T) {
+	t.Parallel()
+
+	s := New(t)
+
+	ca, err := (&client.CloudAvenue{
+		URL:        s.URL,
+		Org:        s.Org(),
+		Token:      DefaultAPIToken,
+		VCDVersion: APIVersion,
+	}).New()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	vmware, err := ca.Vmware()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	token, err := vmware.CreateToken(s.Org(), "token01")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	refresh, err := token.GetInitialApiToken()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tokens := s.Tokens(); len(tokens) != 1 || tokens[0].ID != token.Token.ID || tokens[0].RefreshToken != refresh.RefreshToken {
+		t.Fatalf("expected token %s with refresh token %s, got %v", token.Token.ID, refresh.RefreshToken, tokens)
+	}
+
+	// The new API token authenticates a client.
+	if _, err := (&client.CloudAvenue{
+		URL:        s.URL,
+		Org:        s.Org(),
+		Token:      refresh.RefreshToken,
+		VCDVersion: APIVersion,
+	}).New(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := token.Delete(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tokens := s.Tokens(); len(tokens) != 0 {
+		t.Fatalf("expected no token, got %v", tokens)
+	}
+	if _, err := vmware.GetTokenById(token.Token.ID); !govcd.ContainsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+// TestS3 is not parallel: the endpoint of the S3 credentials API is set in the environment.
+func TestS3(t *testing.T) {
+	s := New(t)
+	t.Setenv("S3_ENDPOINT", s.URL)
+
+	ca, err := (&client.CloudAvenue{
+		URL:        s.URL,
+		Org:        s.Org(),
+		Token:      DefaultAPIToken,
+		VCDVersion: APIVersion,
+	}).New()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	s3Client, err := ca.S3()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// The client holds the route of the SDK to the fake API.
+	defer runtime.KeepAlive(ca)
+
+	user, oseErr := s3Client.GetUser(DefaultUser)
+	if oseErr != nil {
+		t.Fatalf("expected no error, got %v", oseErr)
+	}
+	if _, oseErr := s3Client.GetUser("unknown"); oseErr == nil || !oseErr.IsNotFountError() {
+		t.Fatalf("expected not found error, got %v", oseErr)
+	}
+
+	cred, err := user.NewCredential()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	creds, err := user.GetCredentials()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(*creds) != 2 || len(s.S3Credentials()) != 2 {
+		t.Fatalf("expected 2 credentials, got %v", *creds)
+	}
+
+	if err := cred.Delete(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, c := range s.S3Credentials() {
+		if c.AccessKey == cred.GetAccessKey() {
+			t.Fatalf("expected credential %s to be deleted", cred.GetAccessKey())
+		}
+	}
+}
+
+func TestCloudAvenueSDK(t *testing.T) {
+	t.Parallel()
+
+	s := New(t)
+	edge := s.AddEdgeGateway(EdgeGateway{Name: "edge01", OwnerName: "vdc01", Tier0VrfName: "prvrf01eocb0001234allsp01"})
+
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		token    string
+	}{
+		{
+			name:     "Credentials",
+			user:     DefaultUser,
+			password: DefaultPassword,
+		},
+		{
+			// The session of the SDK is opened with the access token exchanged for the API token.
+			name:  "APIToken",
+			token: DefaultAPIToken,
+		},
+	}
+
+	// The subtests are not parallel, the clients of the SDK are global.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ca, err := (&client.CloudAvenue{
+				URL:        s.URL,
+				Org:        s.Org(),
+				User:       tt.user,
+				Password:   tt.password,
+				Token:      tt.token,
+				VCDVersion: APIVersion,
+				CAVSDKOpts: &clientca.ClientOpts{
+					CloudAvenue: &clientcloudavenue.Opts{
+						Endpoint:   s.URL,
+						Username:   tt.user,
+						Password:   tt.password,
+						Org:        s.Org(),
+						VCDVersion: APIVersion,
+					},
+				},
+			}).New()
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			cavSDK, err := ca.CAVSDK()
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			egw, err := cavSDK.V1.EdgeGateway.GetByName("edge01")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if egw.GetID() != edge.ID || egw.GetTier0VrfID() != edge.Tier0VrfName {
+				t.Fatalf("expected edge gateway %s on %s, got %s on %s", edge.ID, edge.Tier0VrfName, egw.GetID(), egw.GetTier0VrfID())
+			}
+		})
+	}
+}
+
+// TestCloudAvenueSDKRecordReplay is not parallel: the clients of the SDK are global.
+func TestCloudAvenueSDKRecordReplay(t *testing.T) { //nolint:paralleltest // the clients of the SDK are global
+	s := New(t)
+	edge := s.AddEdgeGateway(EdgeGateway{Name: "edge01", OwnerName: "vdc01", Tier0VrfName: "prvrf01eocb0001234allsp01"})
+
+	dir := t.TempDir()
+	newClient := func(t *testing.T, url string, vcr client.VCROpts) *client.CloudAvenue {
+		t.Helper()
+
+		ca, err := (&client.CloudAvenue{
+			URL:        url,
+			Org:        s.Org(),
+			User:       DefaultUser,
+			Password:   DefaultPassword,
+			VCDVersion: APIVersion,
+			VCR:        vcr,
+			CAVSDKOpts: &clientca.ClientOpts{
+				CloudAvenue: &clientcloudavenue.Opts{
+					Endpoint:   url,
+					Username:   DefaultUser,
+					Password:   DefaultPassword,
+					Org:        s.Org(),
+					VCDVersion: APIVersion,
+				},
+			},
+		}).New()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		return ca
+	}
+
+	getEdgeGateway := func(t *testing.T, ca *client.CloudAvenue) {
+		t.Helper()
+
+		cavSDK, err := ca.CAVSDK()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		egw, err := cavSDK.V1.EdgeGateway.GetByName("edge01")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if egw.GetID() != edge.ID {
+			t.Fatalf("expected edge gateway %s, got %s", edge.ID, egw.GetID())
+		}
+	}
+
+	// * Record the requests of the SDK
+	recorded := filepath.Join(dir, "recorded.yaml")
+	getEdgeGateway(t, newClient(t, s.URL, client.VCROpts{Mode: client.VCRModeRecord, Cassette: recorded}))
+
+	data, err := os.ReadFile(recorded)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, secret := range []string{DefaultUser, DefaultPassword} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("expected %q to be redacted from the cassette, got:\n%s", secret, data)
+		}
+	}
+
+	// * Replay them without the API, the cassette is read from another file.
+	replayed := filepath.Join(dir, "replayed.yaml")
+	if err := os.WriteFile(replayed, data, 0o600); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	s.Close()
+
+	getEdgeGateway(t, newClient(t, "https://console.cloudavenue.invalid", client.VCROpts{Mode: client.VCRModeReplay, Cassette: replayed}))
+}
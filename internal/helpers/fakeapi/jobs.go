@@ -0,0 +1,126 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// job is an asynchronous operation of the Cloud Avenue API.
+// Each poll returns the next state, the change is applied when the job reaches DONE.
+type job struct {
+	id           string
+	name         string
+	states       []string
+	step         int
+	failedAction string
+	apply        func()
+}
+
+type jobAction struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Details string `json:"details"`
+}
+
+type jobStatus struct {
+	JobID       string      `json:"jobId"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Status      string      `json:"status"`
+	Actions     []jobAction `json:"actions"`
+}
+
+// SetJobStates sets the states returned by the successive polls of the next jobs.
+// The last state is returned once reached. The default is CREATED, IN_PROGRESS, DONE.
+func (s *Server) SetJobStates(states ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobStates = states
+}
+
+// FailNextJob makes the next job end with the FAILED state. The action is reported as failed
+// and the change is not applied.
+func (s *Server) FailNextJob(action string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobFailures = append(s.jobFailures, action)
+}
+
+// newJob creates a job. The caller must hold the lock.
+func (s *Server) newJob(name string, apply func()) *job {
+	j := &job{
+		id:     uuid.NewString(),
+		name:   name,
+		states: append([]string(nil), s.jobStates...),
+		apply:  apply,
+	}
+
+	if len(s.jobFailures) > 0 {
+		j.failedAction, s.jobFailures = s.jobFailures[0], s.jobFailures[1:]
+		if len(j.states) > 0 {
+			j.states = j.states[:len(j.states)-1]
+		}
+		j.states = append(j.states, "FAILED")
+	}
+
+	s.jobs[j.id] = j
+	return j
+}
+
+// next returns the status of the job and moves it to the next state.
+func (j *job) next() jobStatus {
+	state := "DONE"
+	if len(j.states) > 0 {
+		state = j.states[j.step]
+		if j.step < len(j.states)-1 {
+			j.step++
+		}
+	}
+
+	status := jobStatus{
+		JobID:       j.id,
+		Name:        j.name,
+		Description: j.name,
+		Status:      state,
+		Actions:     []jobAction{{Name: j.name, Status: state}},
+	}
+
+	switch state {
+	case "DONE":
+		if j.apply != nil {
+			j.apply()
+			j.apply = nil
+		}
+	case "FAILED":
+		status.Actions = []jobAction{
+			{Name: j.name, Status: "DONE"},
+			{Name: j.failedAction, Status: "FAILED", Details: fmt.Sprintf("action %s failed", j.failedAction)},
+		}
+	}
+
+	return status
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.jobs[PathParam(r, "jobId")]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf("job %q not found", PathParam(r, "jobId")))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, []jobStatus{j.next()})
+}
+
+func writeJobCreated(w http.ResponseWriter, j *job) {
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"message": j.name + " job created",
+		"jobId":   j.id,
+	})
+}
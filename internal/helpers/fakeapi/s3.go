@@ -0,0 +1,160 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// S3Credential is an access key of a user to the S3 API.
+type S3Credential struct {
+	Owner     string `json:"owner"`
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+	Active    bool   `json:"active"`
+}
+
+type s3User struct {
+	name        string
+	credentials []*S3Credential
+}
+
+// S3Credentials returns the S3 credentials of the users.
+func (s *Server) S3Credentials() []S3Credential {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credentials := []S3Credential{}
+	for _, user := range s.s3Users {
+		for _, cred := range user.credentials {
+			credentials = append(credentials, *cred)
+		}
+	}
+	return credentials
+}
+
+// addS3User adds a user with a credential, the S3 client of the SDK requires one to start.
+func (s *Server) addS3User(name string) {
+	user := &s3User{name: name}
+	user.credentials = append(user.credentials, newS3Credential(name))
+	s.s3Users = append(s.s3Users, user)
+}
+
+func newS3Credential(owner string) *S3Credential {
+	key := strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", ""))
+	return &S3Credential{
+		Owner:     owner,
+		AccessKey: key[:20],
+		SecretKey: strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Active:    true,
+	}
+}
+
+// findS3User returns the user of the request path or writes a not found error. The caller must hold the lock.
+func (s *Server) findS3User(w http.ResponseWriter, r *http.Request) *s3User {
+	if PathParam(r, "orgId") != s.org.id {
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf("tenant %q not found", PathParam(r, "orgId")))
+		return nil
+	}
+	for _, user := range s.s3Users {
+		if user.name == PathParam(r, "userName") {
+			return user
+		}
+	}
+	writeError(w, r, http.StatusNotFound, fmt.Sprintf("user %q not found", PathParam(r, "userName")))
+	return nil
+}
+
+func (u *s3User) findCredential(accessKey string) int {
+	for i, cred := range u.credentials {
+		if cred.AccessKey == accessKey {
+			return i
+		}
+	}
+	return -1
+}
+
+// registerS3Routes registers the endpoints of the S3 credentials API used by the SDK.
+func (s *Server) registerS3Routes() {
+	s.handle(http.MethodGet, "/api/v1/core/associated-tenants", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"items": []map[string]any{{
+				"name":       s.org.name,
+				"orgId":      s.org.id,
+				"accessible": true,
+				"local":      true,
+			}},
+		})
+	})
+	s.handle(http.MethodGet, "/api/v1/core/tenants/{orgId}/users/{userName}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		user := s.findS3User(w, r)
+		if user == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"name":   user.name,
+			"id":     uuid.NewSHA1(uuid.NameSpaceOID, []byte(user.name)).String(),
+			"active": true,
+		})
+	})
+	s.handle(http.MethodGet, "/api/v1/core/tenants/{orgId}/users/{userName}/credentials", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		user := s.findS3User(w, r)
+		if user == nil {
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"items": append([]*S3Credential{}, user.credentials...),
+		})
+	})
+	s.handle(http.MethodPost, "/api/v1/core/tenants/{orgId}/users/{userName}/credentials", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		user := s.findS3User(w, r)
+		if user == nil {
+			return
+		}
+		cred := newS3Credential(user.name)
+		user.credentials = append(user.credentials, cred)
+		writeJSON(w, http.StatusOK, cred)
+	})
+	s.handle(http.MethodGet, "/api/v1/core/tenants/{orgId}/users/{userName}/credentials/{accessKey}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		user := s.findS3User(w, r)
+		if user == nil {
+			return
+		}
+		i := user.findCredential(PathParam(r, "accessKey"))
+		if i < 0 {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("credential %q not found", PathParam(r, "accessKey")))
+			return
+		}
+		writeJSON(w, http.StatusOK, user.credentials[i])
+	})
+	s.handle(http.MethodDelete, "/api/v1/core/tenants/{orgId}/users/{userName}/credentials/{accessKey}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		user := s.findS3User(w, r)
+		if user == nil {
+			return
+		}
+		i := user.findCredential(PathParam(r, "accessKey"))
+		if i < 0 {
+			writeError(w, r, http.StatusNotFound, fmt.Sprintf("credential %q not found", PathParam(r, "accessKey")))
+			return
+		}
+		user.credentials = append(user.credentials[:i], user.credentials[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
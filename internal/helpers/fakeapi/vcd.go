@@ -0,0 +1,512 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+const (
+	vdcContentType = "application/vnd.vmware.vcloud.vdc+xml"
+	orgContentType = "application/vnd.vmware.vcloud.org+xml"
+)
+
+// registerVCDRoutes registers the endpoints of the VMware VCD API used by govcd.
+func (s *Server) registerVCDRoutes() {
+	// * Authentication
+	s.handlePublic(http.MethodGet, "/api/versions", func(w http.ResponseWriter, r *http.Request) {
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"SupportedVersions"`
+			govcd.SupportedVersions
+		}{
+			SupportedVersions: govcd.SupportedVersions{
+				VersionInfos: govcd.VersionInfos{{
+					Version:  APIVersion,
+					LoginUrl: baseURL(r) + "/api/sessions",
+				}},
+			},
+		})
+	})
+	s.handlePublic(http.MethodPost, "/oauth/tenant/{org}/token", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.EqualFold(PathParam(r, "org"), s.org.name) {
+			writeError(w, r, http.StatusBadRequest, fmt.Sprintf("organization %q not found", PathParam(r, "org")))
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			writeError(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch r.PostForm.Get("grant_type") {
+		case "urn:ietf:params:oauth:grant-type:jwt-bearer":
+			// The first refresh token of a registered API token is issued to its user.
+			_, token := s.findToken("urn:vcloud:token:" + r.PostForm.Get("client_id"))
+			if token == nil || r.PostForm.Get("assertion") != DefaultAccessToken {
+				writeError(w, r, http.StatusBadRequest, "invalid assertion or client ID")
+				return
+			}
+			token.RefreshToken = "fake-api-token-" + uuidOf(token.ID)
+			writeJSON(w, http.StatusOK, govcdtypes.ApiTokenRefresh{
+				AccessToken:  DefaultAccessToken,
+				TokenType:    "Bearer",
+				ExpiresIn:    3600,
+				RefreshToken: token.RefreshToken,
+			})
+		default:
+			if refreshToken := r.PostForm.Get("refresh_token"); refreshToken != DefaultAPIToken && !s.validRefreshToken(refreshToken) {
+				writeError(w, r, http.StatusBadRequest, "invalid refresh token")
+				return
+			}
+			writeJSON(w, http.StatusOK, govcdtypes.ApiTokenRefresh{
+				AccessToken: DefaultAccessToken,
+				TokenType:   "Bearer",
+				ExpiresIn:   3600,
+			})
+		}
+	})
+	s.handle(http.MethodPost, "/oauth/tenant/{org}/register", func(w http.ResponseWriter, r *http.Request) {
+		params := govcdtypes.ApiTokenParams{}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil || params.ClientName == "" {
+			writeError(w, r, http.StatusBadRequest, "invalid token parameters")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for _, token := range s.tokens {
+			if token.Name == params.ClientName {
+				writeError(w, r, http.StatusBadRequest, fmt.Sprintf("token %q already exists", params.ClientName))
+				return
+			}
+		}
+
+		token := &Token{ID: "urn:vcloud:token:" + uuid.NewString(), Name: params.ClientName}
+		s.tokens = append(s.tokens, token)
+
+		params.ClientID = uuidOf(token.ID)
+		params.GrantTypes = []string{"refresh_token"}
+		writeJSON(w, http.StatusOK, params)
+	})
+	s.handlePublic(http.MethodPost, "/cloudapi/1.0.0/sessions", func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || !strings.EqualFold(user, DefaultUser+"@"+s.org.name) || password != DefaultPassword {
+			writeError(w, r, http.StatusUnauthorized, "invalid credentials")
+			return
+		}
+		w.Header().Set("X-Vmware-Vcloud-Access-Token", DefaultAccessToken)
+		writeJSON(w, http.StatusOK, s.sessionInfo())
+	})
+	s.handle(http.MethodGet, "/cloudapi/1.0.0/sessions/current", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, s.sessionInfo())
+	})
+
+	// * API tokens
+	// Like VCD, the OpenAPI answers 403 for a token which does not exist.
+	s.handle(http.MethodGet, "/cloudapi/1.0.0/tokens/{tokenId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		_, token := s.findToken(PathParam(r, "tokenId"))
+		if token == nil {
+			writeError(w, r, http.StatusForbidden, fmt.Sprintf("token %q not found", PathParam(r, "tokenId")))
+			return
+		}
+		writeJSON(w, http.StatusOK, govcdtypes.Token{
+			ID:    token.ID,
+			Name:  token.Name,
+			Owner: &govcdtypes.OpenApiReference{Name: DefaultUser},
+			Org:   &govcdtypes.OpenApiReference{Name: s.org.name, ID: s.OrgID()},
+			Type:  "REFRESH",
+		})
+	})
+	s.handle(http.MethodDelete, "/cloudapi/1.0.0/tokens/{tokenId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		i, _ := s.findToken(PathParam(r, "tokenId"))
+		if i < 0 {
+			writeError(w, r, http.StatusForbidden, fmt.Sprintf("token %q not found", PathParam(r, "tokenId")))
+			return
+		}
+		s.tokens = append(s.tokens[:i], s.tokens[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// * Organization
+	s.handle(http.MethodGet, "/api/org", func(w http.ResponseWriter, r *http.Request) {
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"OrgList"`
+			*govcdtypes.OrgList
+		}{
+			OrgList: &govcdtypes.OrgList{
+				Org: []*govcdtypes.Org{{
+					HREF: baseURL(r) + "/api/org/" + s.org.id,
+					Type: orgContentType,
+					Name: s.org.name,
+				}},
+			},
+		})
+	})
+	s.handle(http.MethodGet, "/api/org/{orgId}", func(w http.ResponseWriter, r *http.Request) {
+		if PathParam(r, "orgId") != s.org.id {
+			writeError(w, r, http.StatusForbidden, "organization not found")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		links := govcdtypes.LinkList{}
+		for _, vdc := range s.vdcs {
+			links = append(links, &govcdtypes.Link{
+				HREF: baseURL(r) + "/api/vdc/" + uuidOf(vdc.ID),
+				Type: vdcContentType,
+				Name: vdc.Name,
+				Rel:  "down",
+			})
+		}
+
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"Org"`
+			*govcdtypes.Org
+		}{
+			Org: &govcdtypes.Org{
+				HREF:      baseURL(r) + "/api/org/" + s.org.id,
+				Type:      orgContentType,
+				ID:        s.OrgID(),
+				Name:      s.org.name,
+				FullName:  s.org.name,
+				IsEnabled: true,
+				Link:      links,
+			},
+		})
+	})
+	s.handle(http.MethodGet, "/api/admin/org/{orgId}", func(w http.ResponseWriter, r *http.Request) {
+		if PathParam(r, "orgId") != s.org.id {
+			writeError(w, r, http.StatusForbidden, "organization not found")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		vdcs := &govcdtypes.VDCList{}
+		for _, vdc := range s.vdcs {
+			vdcs.Vdcs = append(vdcs.Vdcs, &govcdtypes.Reference{
+				HREF: baseURL(r) + "/api/admin/vdc/" + uuidOf(vdc.ID),
+				ID:   vdc.ID,
+				Type: vdcContentType,
+				Name: vdc.Name,
+			})
+		}
+
+		writeXML(w, http.StatusOK, &govcdtypes.AdminOrg{
+			Xmlns:     govcdtypes.XMLNamespaceVCloud,
+			HREF:      baseURL(r) + "/api/admin/org/" + s.org.id,
+			Type:      "application/vnd.vmware.admin.organization+xml",
+			ID:        s.OrgID(),
+			Name:      s.org.name,
+			FullName:  s.org.name,
+			IsEnabled: true,
+			Catalogs:  &govcdtypes.CatalogsList{},
+			OrgSettings: &govcdtypes.OrgSettings{
+				OrgGeneralSettings: &govcdtypes.OrgGeneralSettings{},
+				OrgVAppLeaseSettings: &govcdtypes.VAppLeaseSettings{
+					DeleteOnStorageLeaseExpiration: addrOf(false),
+					DeploymentLeaseSeconds:         addrOf(0),
+					StorageLeaseSeconds:            addrOf(0),
+				},
+			},
+			Vdcs: vdcs,
+		})
+	})
+
+	// * VDCs
+	s.handle(http.MethodGet, "/api/vdc/{vdcId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for _, vdc := range s.vdcs {
+			if uuidOf(vdc.ID) != PathParam(r, "vdcId") {
+				continue
+			}
+			writeXML(w, http.StatusOK, struct {
+				XMLName xml.Name `xml:"Vdc"`
+				*govcdtypes.Vdc
+			}{
+				Vdc: &govcdtypes.Vdc{
+					HREF:            baseURL(r) + "/api/vdc/" + uuidOf(vdc.ID),
+					Type:            vdcContentType,
+					ID:              vdc.ID,
+					Name:            vdc.Name,
+					Description:     vdc.Description,
+					AllocationModel: "Flex",
+					IsEnabled:       true,
+					Link: govcdtypes.LinkList{{
+						HREF: baseURL(r) + "/api/org/" + s.org.id,
+						Type: orgContentType,
+						Name: s.org.name,
+						Rel:  "up",
+					}},
+				},
+			})
+			return
+		}
+		writeError(w, r, http.StatusForbidden, fmt.Sprintf("VDC %q not found", PathParam(r, "vdcId")))
+	})
+
+	// * VDC groups
+	s.handle(http.MethodGet, "/cloudapi/1.0.0/vdcGroups", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		filters := parseFilter(r.URL.Query().Get("filter"))
+		groups := []*govcdtypes.VdcGroup{}
+		for _, group := range s.vdcGroups() {
+			if filters["name"] != "" && filters["name"] != group.Name {
+				continue
+			}
+			groups = append(groups, group)
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"resultTotal": len(groups),
+			"pageCount":   1,
+			"page":        1,
+			"pageSize":    len(groups),
+			"values":      groups,
+		})
+	})
+
+	// * Query service
+	s.handle(http.MethodGet, "/api/query", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != govcdtypes.QtOrgVdc {
+			writeError(w, r, http.StatusBadRequest, fmt.Sprintf("query type %q not supported", r.URL.Query().Get("type")))
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		filters := parseFilter(queryFilter(r))
+		records := make([]*govcdtypes.QueryResultOrgVdcRecordType, 0, len(s.vdcs))
+		for _, vdc := range s.vdcs {
+			if filters["name"] != "" && filters["name"] != vdc.Name {
+				continue
+			}
+			records = append(records, &govcdtypes.QueryResultOrgVdcRecordType{
+				HREF:      baseURL(r) + "/api/vdc/" + uuidOf(vdc.ID),
+				Name:      vdc.Name,
+				OrgName:   s.org.name,
+				IsEnabled: "true",
+			})
+		}
+
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"QueryResultRecords"`
+			*govcdtypes.QueryResultRecordsType
+		}{
+			QueryResultRecordsType: &govcdtypes.QueryResultRecordsType{
+				Page:         1,
+				PageSize:     len(records),
+				Total:        float64(len(records)),
+				OrgVdcRecord: records,
+			},
+		})
+	})
+
+	// * NSX-T edge gateways
+	s.handle(http.MethodGet, "/cloudapi/1.0.0/edgeGateways", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		filters := parseFilter(r.URL.Query().Get("filter"))
+		edges := make([]*govcdtypes.OpenAPIEdgeGateway, 0, len(s.edgeGateways))
+		for _, edge := range s.edgeGateways {
+			e := s.openAPIEdgeGateway(edge)
+			if filters["name"] != "" && filters["name"] != e.Name {
+				continue
+			}
+			if filters["ownerRef.id"] != "" && filters["ownerRef.id"] != e.OwnerRef.ID {
+				continue
+			}
+			edges = append(edges, e)
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"resultTotal": len(edges),
+			"pageCount":   1,
+			"page":        1,
+			"pageSize":    len(edges),
+			"values":      edges,
+		})
+	})
+	s.handle(http.MethodGet, "/cloudapi/1.0.0/edgeGateways/{edgeId}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		for _, edge := range s.edgeGateways {
+			if edge.ID == PathParam(r, "edgeId") {
+				writeJSON(w, http.StatusOK, s.openAPIEdgeGateway(edge))
+				return
+			}
+		}
+		writeError(w, r, http.StatusNotFound, fmt.Sprintf("edge gateway %q not found", PathParam(r, "edgeId")))
+	})
+}
+
+// Token is an API token of the user.
+type Token struct {
+	ID   string
+	Name string
+	// RefreshToken is the API token itself, issued once the token is registered.
+	RefreshToken string
+}
+
+// Tokens returns the API tokens of the user.
+func (s *Server) Tokens() []Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens := make([]Token, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		tokens = append(tokens, *token)
+	}
+	return tokens
+}
+
+func (s *Server) findToken(id string) (int, *Token) {
+	for i, token := range s.tokens {
+		if token.ID == id {
+			return i, token
+		}
+	}
+	return -1, nil
+}
+
+// validRefreshToken returns true if the refresh token is the one of a registered API token. The caller must hold the lock.
+func (s *Server) validRefreshToken(refreshToken string) bool {
+	for _, token := range s.tokens {
+		if token.RefreshToken != "" && token.RefreshToken == refreshToken {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) sessionInfo() govcdtypes.CurrentSessionInfo {
+	return govcdtypes.CurrentSessionInfo{
+		ID:                        uuid.NewString(),
+		User:                      govcdtypes.OpenApiReference{Name: DefaultUser, ID: "urn:vcloud:user:" + uuid.NewSHA1(uuid.NameSpaceOID, []byte(DefaultUser)).String()},
+		Org:                       govcdtypes.OpenApiReference{Name: s.org.name, ID: s.OrgID()},
+		Roles:                     []string{"Organization Administrator"},
+		SessionIdleTimeoutMinutes: 30,
+	}
+}
+
+// vdcGroups returns the VCD representation of the VDC groups, made of the VDCs with the same VdcGroup.
+// The caller must hold the lock.
+func (s *Server) vdcGroups() []*govcdtypes.VdcGroup {
+	groups := []*govcdtypes.VdcGroup{}
+	byName := map[string]*govcdtypes.VdcGroup{}
+	for _, vdc := range s.vdcs {
+		if vdc.VdcGroup == "" {
+			continue
+		}
+
+		group, ok := byName[vdc.VdcGroup]
+		if !ok {
+			group = &govcdtypes.VdcGroup{
+				Id:                  vdcGroupID(vdc.VdcGroup),
+				Name:                vdc.VdcGroup,
+				OrgId:               s.OrgID(),
+				NetworkProviderType: "NSX_T",
+				Status:              "REALIZED",
+				Type:                "LOCAL",
+			}
+			byName[vdc.VdcGroup] = group
+			groups = append(groups, group)
+		}
+		group.ParticipatingOrgVdcs = append(group.ParticipatingOrgVdcs, govcdtypes.ParticipatingOrgVdcs{
+			VdcRef: govcdtypes.OpenApiReference{Name: vdc.Name, ID: vdc.ID},
+			OrgRef: govcdtypes.OpenApiReference{Name: s.org.name, ID: s.OrgID()},
+			Status: "REALIZED",
+		})
+	}
+	return groups
+}
+
+// vdcGroupID returns the ID of the VDC group, derived from its name like the VDC groups are derived from the VDCs.
+func vdcGroupID(name string) string {
+	return "urn:vcloud:vdcGroup:" + uuid.NewSHA1(uuid.NameSpaceOID, []byte(name)).String()
+}
+
+// openAPIEdgeGateway returns the VCD representation of the edge gateway. The caller must hold the lock.
+func (s *Server) openAPIEdgeGateway(edge *EdgeGateway) *govcdtypes.OpenAPIEdgeGateway {
+	owner := &govcdtypes.OpenApiReference{Name: edge.OwnerName}
+	if edge.OwnerType == "vdc-group" {
+		owner.ID = vdcGroupID(edge.OwnerName)
+	} else if _, vdc := s.findVDC(edge.OwnerName); vdc != nil {
+		owner.ID = vdc.ID
+	}
+
+	return &govcdtypes.OpenAPIEdgeGateway{
+		Status:             "REALIZED",
+		ID:                 edge.ID,
+		Name:               edge.Name,
+		Description:        edge.Description,
+		OwnerRef:           owner,
+		OrgVdc:             owner,
+		Org:                &govcdtypes.OpenApiReference{Name: s.org.name, ID: s.OrgID()},
+		EdgeGatewayUplinks: []govcdtypes.EdgeGatewayUplinks{{UplinkName: edge.Tier0VrfName}},
+		GatewayBacking: &govcdtypes.OpenAPIEdgeGatewayBacking{
+			BackingID:   uuidOf(edge.ID),
+			GatewayType: "NSXT_BACKED",
+		},
+	}
+}
+
+// parseFilter parses the FIQL filter of the OpenAPI ("name==foo;ownerRef.id==bar").
+// Only the equality and the AND operators are supported.
+func parseFilter(filter string) map[string]string {
+	filters := map[string]string{}
+	for _, condition := range strings.Split(filter, ";") {
+		if key, value, ok := strings.Cut(condition, "=="); ok {
+			filters[key] = value
+		}
+	}
+	return filters
+}
+
+// queryFilter returns the filter of a request to the query service. The filter is not encoded
+// by govcd and its ";" separators make the query parser of net/url drop it.
+func queryFilter(r *http.Request) string {
+	for _, param := range strings.Split(r.URL.RawQuery, "&") {
+		if value, ok := strings.CutPrefix(param, "filter="); ok {
+			if filter, err := url.QueryUnescape(value); err == nil {
+				return filter
+			}
+			return value
+		}
+	}
+	return ""
+}
+
+// uuidOf returns the UUID of the URN.
+func uuidOf(urn string) string {
+	return urn[strings.LastIndex(urn, ":")+1:]
+}
+
+func addrOf[T any](v T) *T {
+	return &v
+}
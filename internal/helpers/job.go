@@ -33,7 +33,12 @@ func GetJobStatus(
 	client *client.CloudAvenue,
 	jobID string,
 ) (JobStatusMessage, error) {
-	jobStatus, httpR, err := client.APIClient.JobsApi.GetJobById(ctx, jobID)
+	apiClient, err := client.APIClient()
+	if err != nil {
+		return "", err
+	}
+
+	jobStatus, httpR, err := apiClient.JobsApi.GetJobById(ctx, jobID)
 	if err != nil {
 		return "", err
 	}
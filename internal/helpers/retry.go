@@ -0,0 +1,36 @@
+// Package helpers provides retry helpers for the CloudAvenue Terraform Provider.
+package helpers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+)
+
+// IsRetryableError returns true if the error is a transient error that is worth retrying.
+// API errors are classified with APIError.IsRetryable, other errors (VCD tasks, NetBackup jobs)
+// are classified from their message.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsRetryable()
+	}
+
+	if client.IsBusyEntity(err.Error()) || client.IsTransientError(err) {
+		return true
+	}
+
+	// NetBackup jobs return "timeout after X seconds" when the job is not done in time.
+	return strings.HasPrefix(err.Error(), "timeout after")
+}
+
+// Retry calls fn until it succeeds or returns a permanent error, using the retry policy of the provider.
+func Retry(ctx context.Context, c *client.CloudAvenue, fn func() error) error {
+	return c.RetryPolicy.Do(ctx, fn, IsRetryableError)
+}
@@ -0,0 +1,70 @@
+// Package helpers provides retry helpers for the CloudAvenue Terraform Provider.
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	cloudavenue "github.com/orange-cloudavenue/infrapi-sdk-go"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "throttling",
+			err:  &APIError{lastError: errors.New("429 Too Many Requests"), statusCode: http.StatusTooManyRequests},
+			want: true,
+		},
+		{
+			name: "service unavailable",
+			err:  fmt.Errorf("wrapped: %w", &APIError{lastError: errors.New("503 Service Unavailable"), statusCode: http.StatusServiceUnavailable}),
+			want: true,
+		},
+		{
+			name: "busy entity",
+			err: &APIError{
+				lastError:  errors.New("400 Bad Request"),
+				statusCode: http.StatusBadRequest,
+				ApiError:   cloudavenue.ApiError{Reason: "BUSY_ENTITY"},
+			},
+			want: true,
+		},
+		{
+			name: "not found",
+			err:  &APIError{lastError: errors.New("404 Not Found"), statusCode: http.StatusNotFound},
+			want: false,
+		},
+		{
+			name: "vcd task busy entity",
+			err:  errors.New("error waiting task: [400:BUSY_ENTITY] - The entity vm-01 is busy completing an operation"),
+			want: true,
+		},
+		{
+			name: "netbackup job timeout",
+			err:  errors.New("timeout after 45 seconds"),
+			want: true,
+		},
+		{
+			name: "permanent",
+			err:  errors.New("invalid name"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := IsRetryableError(tt.err); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -3,6 +3,9 @@ package testsacc
 import (
 	"bytes"
 	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
 	"strings"
 	"text/template"
 
@@ -14,15 +17,6 @@ var (
 	KeyValueStore = &map[string]any{}
 
 	templateFuncs = template.FuncMap{
-		"generate": func(resourceName, key string, extraOpts ...string) string {
-			if len(extraOpts) == 0 {
-				extraOpts = append(extraOpts, "")
-			}
-
-			randomString := generateRandomString(extraOpts[0])
-			(*KeyValueStore)[buildKeyValueStore(resourceName, key)] = randomString
-			return returnWithQuotes(randomString)
-		},
 		"get": func(resourceName, key string) string {
 			if v, ok := (*KeyValueStore)[buildKeyValueStore(resourceName, key)]; ok {
 				if s, ok := v.(string); ok {
@@ -53,7 +47,9 @@ func GenerateFromTemplate(resourceName, templateData string) TFData {
 	// if prefix of resourceName is "data." then remove it
 	resourceName = strings.TrimPrefix(resourceName, "data.")
 
-	t, _ := template.New(resourceName).Funcs(templateFuncs).Parse(templateData)
+	t, _ := template.New(resourceName).Funcs(templateFuncs).Funcs(template.FuncMap{
+		"generate": generateFunc(templateData),
+	}).Parse(templateData)
 	var tplTypes bytes.Buffer
 	_ = t.Execute(&tplTypes, resourceName)
 
@@ -80,8 +76,27 @@ func buildKeyValueStore(resourceName, key string) string {
 	return resourceName + "." + key
 }
 
+// generateFunc returns the generate function of the template.
+func generateFunc(templateData string) func(resourceName, key string, extraOpts ...string) string {
+	return func(resourceName, key string, extraOpts ...string) string {
+		if len(extraOpts) == 0 {
+			extraOpts = append(extraOpts, "")
+		}
+
+		randomString := generateRandomString(extraOpts[0], buildKeyValueStore(resourceName, key)+"\n"+templateData)
+		(*KeyValueStore)[buildKeyValueStore(resourceName, key)] = randomString
+		return returnWithQuotes(randomString)
+	}
+}
+
 // generateRandomString generates a random string.
-func generateRandomString(format string) string {
+// When the record/replay transport is enabled (CLOUDAVENUE_VCR_MODE), the string is derived from seed
+// so the requests sent by a test are the same at each run.
+func generateRandomString(format, seed string) string {
+	if os.Getenv("CLOUDAVENUE_VCR_MODE") != "" {
+		return generateSeededString(format, seed)
+	}
+
 	// generate random string
 	switch format {
 	case "longString":
@@ -96,3 +111,30 @@ func returnWithQuotes(s string) string {
 	s = strings.Trim(s, "\n")
 	return fmt.Sprintf(`"%s"`, s)
 }
+
+// generateSeededString generates a string derived from seed.
+func generateSeededString(format, seed string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	r := rand.New(rand.NewSource(int64(h.Sum64()))) //nolint:gosec // names of the test resources do not need a secure random
+
+	word := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = byte('a' + r.Intn(26))
+		}
+		return string(b)
+	}
+
+	switch format {
+	case "longString":
+		words := make([]string, 1+r.Intn(5))
+		for i := range words {
+			words[i] = word(3 + r.Intn(8))
+		}
+		sentence := strings.Join(words, " ")
+		return strings.ToUpper(sentence[:1]) + sentence[1:] + "."
+	default:
+		return word(16)
+	}
+}
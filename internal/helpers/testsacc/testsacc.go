@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -419,8 +420,18 @@ func GenerateTests(tacc TestACC) []resource.TestStep {
 		steps = make([]resource.TestStep, 0)
 	)
 
+	tests := tacc.Tests(ctx)
+
+	// Sort the tests so the steps are generated in the same order at each run.
+	testNames := make([]TestName, 0, len(tests))
+	for testName := range tests {
+		testNames = append(testNames, testName)
+	}
+	sort.Slice(testNames, func(i, j int) bool { return testNames[i] < testNames[j] })
+
 	// For each test
-	for testName, step := range tacc.Tests(ctx) {
+	for _, testName := range testNames {
+		step := tests[testName]
 		// resourceName is a concatenation of the resource name and the example name. For example, "cloudavenue_catalog.example".
 		resourceName := testName.ComputeResourceName(tacc.GetResourceName())
 
@@ -8,6 +8,8 @@ const (
 	Update Action = "Update"
 	Delete Action = "Delete"
 	Import Action = "Import"
+	Open   Action = "Open"
+	Close  Action = "Close"
 )
 
 // String returns the string representation of the action.
@@ -3,7 +3,7 @@ package metrics
 import (
 	"time"
 
-	tat "github.com/FrangipaneTeam/terraform-analytic-tool/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 // version that can be overwritten by a release process.
@@ -18,26 +18,48 @@ var target = "https://localhost"
 // GlobalExecutionID is the execution ID of the current Terraform run.
 var GlobalExecutionID = ""
 
-func New(resourceName, organizationID string, action Action) func() {
-	if everyThingIsOK() {
-		start := time.Now()
-		return func() {
-			timeElapsed := time.Since(start)
-			send(
-				tat.AnalyticRequest{
-					TerraformRequest: &tat.TerraformRequest{
-						TerraformExecutionID: GlobalExecutionID,
-						ClientVersion:        "terraform-cloudavenue/" + version,
-						ClientToken:          token,
-					},
-					ResourceName:   resourceName,
-					OrganizationID: organizationID,
-					Action:         action.String(),
-					ExecutionTime:  timeElapsed.Milliseconds(),
-				})
+// Outcome is the result of an action.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeError   Outcome = "error"
+)
+
+// Event is a telemetry event sent when an action is done.
+type Event struct {
+	ResourceName   string
+	OrganizationID string
+	Action         Action
+	Outcome        Outcome
+	Start          time.Time
+	Duration       time.Duration
+}
+
+// New starts measuring an action and returns the function to call when the action is done.
+// The outcome of the action is read from diags when the returned function is called.
+func New(resourceName, organizationID string, action Action, diags *diag.Diagnostics) func() {
+	s := getSink()
+	if s == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		outcome := OutcomeSuccess
+		if diags != nil && diags.HasError() {
+			outcome = OutcomeError
 		}
+
+		s.Send(Event{
+			ResourceName:   resourceName,
+			OrganizationID: organizationID,
+			Action:         action,
+			Outcome:        outcome,
+			Start:          start,
+			Duration:       time.Since(start),
+		})
 	}
-	return func() {}
 }
 
 // everyThingIsOK Check if all variables are set.
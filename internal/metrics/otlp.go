@@ -0,0 +1,177 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// otlpScopeName is the instrumentation scope of the spans.
+	otlpScopeName = "github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	// otlpTracesPath is the path of the OTLP/HTTP traces endpoint.
+	otlpTracesPath = "/v1/traces"
+
+	// Span kinds and status codes of the OTLP protocol.
+	otlpSpanKindInternal = 1
+	otlpStatusCodeOK     = 1
+	otlpStatusCodeError  = 2
+)
+
+// otlpSink exports the events as OpenTelemetry spans with the OTLP/HTTP JSON protocol.
+// All the spans of a provider process share the same trace.
+type otlpSink struct {
+	url     string
+	headers map[string]string
+	traceID string
+	client  *http.Client
+}
+
+// newOTLPSink creates a new OTLP sink for the endpoint.
+func newOTLPSink(endpoint string, headers map[string]string) *otlpSink {
+	return &otlpSink{
+		url:     strings.TrimSuffix(endpoint, "/") + otlpTracesPath,
+		headers: headers,
+		traceID: randomHex(16),
+		client:  &http.Client{Timeout: 1 * time.Second},
+	}
+}
+
+// Send implements Sink.
+// This not return an error because it's not critical.
+func (s *otlpSink) Send(event Event) {
+	body, err := json.Marshal(s.exportRequest(event))
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return
+	}
+	res.Body.Close()
+}
+
+// exportRequest builds the OTLP ExportTraceServiceRequest for the event.
+func (s *otlpSink) exportRequest(event Event) otlpExportRequest {
+	status := otlpStatus{Code: otlpStatusCodeOK}
+	if event.Outcome == OutcomeError {
+		status = otlpStatus{Code: otlpStatusCodeError, Message: event.Action.String() + " failed"}
+	}
+
+	attributes := []otlpAttribute{
+		newOTLPAttribute("cloudavenue.resource.name", event.ResourceName),
+		newOTLPAttribute("cloudavenue.org", event.OrganizationID),
+		newOTLPAttribute("cloudavenue.action", event.Action.String()),
+		newOTLPAttribute("cloudavenue.outcome", string(event.Outcome)),
+	}
+	if GlobalExecutionID != "" {
+		attributes = append(attributes, newOTLPAttribute("terraform.execution_id", GlobalExecutionID))
+	}
+
+	return otlpExportRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpAttribute{
+					newOTLPAttribute("service.name", "terraform-provider-cloudavenue"),
+					newOTLPAttribute("service.version", version),
+				},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: otlpScopeName, Version: version},
+				Spans: []otlpSpan{{
+					TraceID:           s.traceID,
+					SpanID:            randomHex(8),
+					Name:              event.ResourceName + "." + event.Action.String(),
+					Kind:              otlpSpanKindInternal,
+					StartTimeUnixNano: strconv.FormatInt(event.Start.UnixNano(), 10),
+					EndTimeUnixNano:   strconv.FormatInt(event.Start.Add(event.Duration).UnixNano(), 10),
+					Attributes:        attributes,
+					Status:            status,
+				}},
+			}},
+		}},
+	}
+}
+
+// randomHex returns n random bytes encoded in hexadecimal.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// OTLP/HTTP JSON payload.
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+type (
+	otlpExportRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+
+	otlpScope struct {
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+	}
+
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		Name              string          `json:"name"`
+		Kind              int             `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes"`
+		Status            otlpStatus      `json:"status"`
+	}
+
+	otlpAttribute struct {
+		Key   string       `json:"key"`
+		Value otlpAnyValue `json:"value"`
+	}
+
+	otlpAnyValue struct {
+		StringValue string `json:"stringValue"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// newOTLPAttribute creates a string attribute.
+func newOTLPAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
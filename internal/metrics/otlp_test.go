@@ -0,0 +1,197 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// collector is a stand-in for an OpenTelemetry collector receiving OTLP/HTTP JSON requests.
+type collector struct {
+	mu       sync.Mutex
+	requests []otlpExportRequest
+	headers  []http.Header
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != otlpTracesPath {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var req otlpExportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = append(c.requests, req)
+	c.headers = append(c.headers, r.Header.Clone())
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *collector) spans() []otlpSpan {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	spans := make([]otlpSpan, 0)
+	for _, req := range c.requests {
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}
+	return spans
+}
+
+func attributeValue(span otlpSpan, key string) string {
+	for _, a := range span.Attributes {
+		if a.Key == key {
+			return a.Value.StringValue
+		}
+	}
+	return ""
+}
+
+func TestOTLPSink(t *testing.T) {
+	t.Parallel()
+
+	c := &collector{}
+	server := httptest.NewServer(c)
+	t.Cleanup(server.Close)
+
+	sink := newOTLPSink(server.URL+"/", map[string]string{"Authorization": "Bearer t0k3n"})
+	start := time.Now()
+
+	sink.Send(Event{
+		ResourceName:   "cloudavenue_vdc",
+		OrganizationID: "cav01ev01ocb0001234",
+		Action:         Create,
+		Outcome:        OutcomeSuccess,
+		Start:          start,
+		Duration:       2 * time.Second,
+	})
+	sink.Send(Event{
+		ResourceName:   "cloudavenue_vdc",
+		OrganizationID: "cav01ev01ocb0001234",
+		Action:         Delete,
+		Outcome:        OutcomeError,
+		Start:          start,
+		Duration:       time.Second,
+	})
+
+	spans := c.spans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	if c.headers[0].Get("Authorization") != "Bearer t0k3n" {
+		t.Fatalf("expected Authorization header to be %q, got %q", "Bearer t0k3n", c.headers[0].Get("Authorization"))
+	}
+
+	tests := []struct {
+		span    otlpSpan
+		name    string
+		action  string
+		outcome string
+		status  int
+	}{
+		{spans[0], "cloudavenue_vdc.Create", "Create", "success", otlpStatusCodeOK},
+		{spans[1], "cloudavenue_vdc.Delete", "Delete", "error", otlpStatusCodeError},
+	}
+
+	for _, tt := range tests {
+		if tt.span.Name != tt.name {
+			t.Fatalf("expected span name to be %q, got %q", tt.name, tt.span.Name)
+		}
+		if v := attributeValue(tt.span, "cloudavenue.resource.name"); v != "cloudavenue_vdc" {
+			t.Fatalf("expected resource name to be %q, got %q", "cloudavenue_vdc", v)
+		}
+		if v := attributeValue(tt.span, "cloudavenue.org"); v != "cav01ev01ocb0001234" {
+			t.Fatalf("expected org to be %q, got %q", "cav01ev01ocb0001234", v)
+		}
+		if v := attributeValue(tt.span, "cloudavenue.action"); v != tt.action {
+			t.Fatalf("expected action to be %q, got %q", tt.action, v)
+		}
+		if v := attributeValue(tt.span, "cloudavenue.outcome"); v != tt.outcome {
+			t.Fatalf("expected outcome to be %q, got %q", tt.outcome, v)
+		}
+		if tt.span.Status.Code != tt.status {
+			t.Fatalf("expected status code to be %d, got %d", tt.status, tt.span.Status.Code)
+		}
+		if len(tt.span.TraceID) != 32 || len(tt.span.SpanID) != 16 {
+			t.Fatalf("expected hex trace and span IDs, got %q and %q", tt.span.TraceID, tt.span.SpanID)
+		}
+	}
+
+	if spans[0].TraceID != spans[1].TraceID {
+		t.Fatalf("expected spans to share the same trace, got %q and %q", spans[0].TraceID, spans[1].TraceID)
+	}
+	if spans[0].SpanID == spans[1].SpanID {
+		t.Fatalf("expected different span IDs, got %q", spans[0].SpanID)
+	}
+}
+
+func TestConfigure(t *testing.T) { //nolint:paralleltest // Configure sets the global sink
+	c := &collector{}
+	server := httptest.NewServer(c)
+	t.Cleanup(server.Close)
+	t.Cleanup(func() {
+		sinkMu.Lock()
+		defer sinkMu.Unlock()
+		sink, configured = nil, false
+	})
+
+	t.Run("InvalidMode", func(t *testing.T) {
+		if err := Configure(Config{Mode: "unknown"}); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+	})
+
+	t.Run("InvalidEndpoint", func(t *testing.T) {
+		if err := Configure(Config{Mode: ModeOTLP, OTLPEndpoint: "localhost"}); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+	})
+
+	t.Run("OTLP", func(t *testing.T) {
+		if err := Configure(Config{Mode: ModeOTLP, OTLPEndpoint: server.URL}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		diags := diag.Diagnostics{}
+		done := New("cloudavenue_vdc", "acme", Read, &diags)
+		diags.AddError("Error", "error")
+		done()
+
+		spans := c.spans()
+		if len(spans) != 1 {
+			t.Fatalf("expected 1 span, got %d", len(spans))
+		}
+		if v := attributeValue(spans[0], "cloudavenue.outcome"); v != string(OutcomeError) {
+			t.Fatalf("expected outcome to be %q, got %q", OutcomeError, v)
+		}
+	})
+
+	t.Run("Off", func(t *testing.T) {
+		if err := Configure(Config{Mode: ModeOff}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if getSink() != nil {
+			t.Fatalf("expected no sink, got %T", getSink())
+		}
+
+		New("cloudavenue_vdc", "acme", Read, nil)()
+		if len(c.spans()) != 1 {
+			t.Fatalf("expected no new span, got %d", len(c.spans())-1)
+		}
+	})
+}
@@ -10,6 +10,25 @@ import (
 	tat "github.com/FrangipaneTeam/terraform-analytic-tool/api"
 )
 
+// vendorSink sends the events to the analytics API of the provider maintainers.
+type vendorSink struct{}
+
+// Send implements Sink.
+func (vendorSink) Send(event Event) {
+	send(
+		tat.AnalyticRequest{
+			TerraformRequest: &tat.TerraformRequest{
+				TerraformExecutionID: GlobalExecutionID,
+				ClientVersion:        "terraform-cloudavenue/" + version,
+				ClientToken:          token,
+			},
+			ResourceName:   event.ResourceName,
+			OrganizationID: event.OrganizationID,
+			Action:         event.Action.String(),
+			ExecutionTime:  event.Duration.Milliseconds(),
+		})
+}
+
 // Send is a function to send an event
 // with a given configuration and client.
 // This not return an error because it's not critical.
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// Mode is the telemetry mode of the provider.
+type Mode string
+
+const (
+	// ModeOff disables the telemetry.
+	ModeOff Mode = "off"
+	// ModeVendor sends the telemetry to the provider maintainers (default).
+	ModeVendor Mode = "vendor"
+	// ModeOTLP exports the telemetry as OpenTelemetry spans to an OTLP/HTTP endpoint.
+	ModeOTLP Mode = "otlp"
+
+	// DefaultOTLPEndpoint is the default OTLP/HTTP endpoint (local OpenTelemetry collector).
+	DefaultOTLPEndpoint = "http://localhost:4318"
+)
+
+// Modes returns the list of the telemetry modes.
+func Modes() []string {
+	return []string{string(ModeOff), string(ModeVendor), string(ModeOTLP)}
+}
+
+// Config is the telemetry configuration.
+type Config struct {
+	// Mode is the telemetry mode, ModeVendor if empty.
+	Mode Mode
+	// OTLPEndpoint is the base URL of the OTLP/HTTP endpoint, used with ModeOTLP.
+	// The spans are sent to OTLPEndpoint/v1/traces.
+	OTLPEndpoint string
+	// OTLPHeaders are the headers added to the OTLP requests (e.g. authentication).
+	OTLPHeaders map[string]string
+}
+
+// Sink receives the telemetry events.
+type Sink interface {
+	Send(event Event)
+}
+
+var (
+	sinkMu     sync.RWMutex
+	sink       Sink
+	configured bool
+)
+
+// Configure sets the telemetry sink from the configuration.
+func Configure(cfg Config) error {
+	var s Sink
+
+	switch cfg.Mode {
+	case ModeOff:
+		s = nil
+	case ModeVendor, "":
+		s = defaultSink()
+	case ModeOTLP:
+		endpoint := cfg.OTLPEndpoint
+		if endpoint == "" {
+			endpoint = DefaultOTLPEndpoint
+		}
+		if u, err := url.Parse(endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid OTLP endpoint %q", endpoint)
+		}
+		s = newOTLPSink(endpoint, cfg.OTLPHeaders)
+	default:
+		return fmt.Errorf("invalid telemetry mode %q, must be one of %v", cfg.Mode, Modes())
+	}
+
+	sinkMu.Lock()
+	defer sinkMu.Unlock()
+	sink = s
+	configured = true
+
+	return nil
+}
+
+// getSink returns the configured sink or the default one. A nil sink disables the telemetry.
+func getSink() Sink {
+	sinkMu.RLock()
+	defer sinkMu.RUnlock()
+
+	if !configured {
+		return defaultSink()
+	}
+	return sink
+}
+
+// defaultSink returns the vendor sink if it has been set up by the release process.
+func defaultSink() Sink {
+	if !everyThingIsOK() {
+		return nil
+	}
+	return vendorSink{}
+}
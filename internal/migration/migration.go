@@ -0,0 +1,180 @@
+// Package migration migrates the configurations written for the vmware/vcd provider
+// to the Cloud Avenue provider.
+package migration
+
+// Resource is the Cloud Avenue equivalent of a vcd resource.
+type Resource struct {
+	// Type is the type of the Cloud Avenue resource.
+	Type string
+	// Attributes maps the vcd attributes renamed in the Cloud Avenue resource.
+	// The other attributes keep their name.
+	Attributes map[string]string
+	// ImportID lists the vcd attributes joined with a dot to build the import ID
+	// of the Cloud Avenue resource. Empty if the ID cannot be built from the vcd state.
+	ImportID []string
+}
+
+// VCDResources maps the vcd resources to their Cloud Avenue equivalents.
+var VCDResources = map[string]Resource{
+	"vcd_catalog_access_control": {
+		Type:     "cloudavenue_catalog_acl",
+		ImportID: []string{"catalog_id"},
+	},
+	"vcd_independent_disk": {
+		Type: "cloudavenue_vm_disk",
+	},
+	"vcd_inserted_media": {
+		Type: "cloudavenue_vm_inserted_media",
+	},
+	"vcd_library_certificate": {
+		Type:     "cloudavenue_certificate",
+		ImportID: []string{"alias"},
+	},
+	"vcd_network_isolated_v2": {
+		Type:     "cloudavenue_network_isolated",
+		ImportID: []string{"vdc", "name"},
+	},
+	"vcd_network_routed_v2": {
+		Type:     "cloudavenue_network_routed",
+		ImportID: []string{"edge_gateway_id", "name"},
+	},
+	"vcd_nsxt_alb_pool": {
+		Type: "cloudavenue_alb_pool",
+	},
+	"vcd_nsxt_alb_virtual_service": {
+		Type: "cloudavenue_alb_virtual_service",
+		Attributes: map[string]string{
+			"virtual_ip_address": "virtual_ip",
+			"ca_certificate_id":  "certificate_id",
+		},
+		ImportID: []string{"edge_gateway_id", "name"},
+	},
+	"vcd_nsxt_app_port_profile": {
+		Type: "cloudavenue_edgegateway_app_port_profile",
+	},
+	"vcd_nsxt_edgegateway": {
+		Type:     "cloudavenue_edgegateway",
+		ImportID: []string{"name"},
+	},
+	"vcd_nsxt_firewall": {
+		Type:     "cloudavenue_edgegateway_firewall",
+		ImportID: []string{"edge_gateway_id"},
+	},
+	"vcd_nsxt_ip_set": {
+		Type:     "cloudavenue_edgegateway_ip_set",
+		ImportID: []string{"edge_gateway_id", "name"},
+	},
+	"vcd_nsxt_ipsec_vpn_tunnel": {
+		Type:     "cloudavenue_edgegateway_vpn_ipsec",
+		ImportID: []string{"edge_gateway_id", "id"},
+	},
+	"vcd_nsxt_nat_rule": {
+		Type:     "cloudavenue_edgegateway_nat_rule",
+		ImportID: []string{"edge_gateway_id", "id"},
+	},
+	"vcd_nsxt_network_dhcp": {
+		Type:     "cloudavenue_network_dhcp",
+		ImportID: []string{"org_network_id"},
+	},
+	"vcd_nsxt_network_dhcp_binding": {
+		Type:     "cloudavenue_network_dhcp_binding",
+		ImportID: []string{"org_network_id", "name"},
+	},
+	"vcd_nsxt_security_group": {
+		Type:     "cloudavenue_edgegateway_security_group",
+		ImportID: []string{"edge_gateway_id", "id"},
+	},
+	"vcd_nsxt_edgegateway_dhcp_forwarding": {
+		Type:     "cloudavenue_edgegateway_dhcp_forwarding",
+		ImportID: []string{"edge_gateway_id"},
+	},
+	"vcd_nsxt_edgegateway_dns": {
+		Type:     "cloudavenue_edgegateway_dns_forwarder",
+		ImportID: []string{"edge_gateway_id"},
+	},
+	"vcd_nsxt_edgegateway_static_route": {
+		Type:     "cloudavenue_edgegateway_static_route",
+		ImportID: []string{"edge_gateway_id", "id"},
+	},
+	"vcd_vapp_network": {
+		Type:     "cloudavenue_vapp_isolated_network",
+		ImportID: []string{"vdc", "vapp_name", "name"},
+	},
+	"vcd_vapp_vm": {
+		Type:     "cloudavenue_vm",
+		ImportID: []string{"vdc", "vapp_name", "id"},
+	},
+	"vcd_vapp_access_control": {
+		Type: "cloudavenue_vapp_acl",
+	},
+	"vcd_vm": {
+		Type: "cloudavenue_vm",
+	},
+	"vcd_vm_internal_disk": {
+		Type: "cloudavenue_vm_disk",
+	},
+	"vcd_org_user": {
+		Type: "cloudavenue_iam_user",
+		Attributes: map[string]string{
+			"role":          "role_name",
+			"email_address": "email",
+		},
+		ImportID: []string{"name"},
+	},
+	"vcd_org_vdc": {
+		Type:     "cloudavenue_vdc",
+		ImportID: []string{"name"},
+	},
+	"vcd_org_vdc_access_control": {
+		Type:     "cloudavenue_vdc_acl",
+		ImportID: []string{"vdc"},
+	},
+	"vcd_role": {
+		Type:     "cloudavenue_iam_role",
+		ImportID: []string{"name"},
+	},
+	"vcd_security_tag": {
+		Type: "cloudavenue_vm_security_tag",
+		Attributes: map[string]string{
+			"name": "id",
+		},
+		ImportID: []string{"name"},
+	},
+	"vcd_catalog": {
+		Type:     "cloudavenue_catalog",
+		ImportID: []string{"name"},
+	},
+	"vcd_vapp": {
+		Type:     "cloudavenue_vapp",
+		ImportID: []string{"vdc", "name"},
+	},
+	"vcd_vapp_org_network": {
+		Type: "cloudavenue_vapp_org_network",
+		Attributes: map[string]string{
+			"org_network_name": "network_name",
+		},
+		ImportID: []string{"vapp_name", "org_network_name"},
+	},
+	"vcd_vm_affinity_rule": {
+		Type: "cloudavenue_vm_affinity_rule",
+	},
+}
+
+// VCDEquivalents returns the Cloud Avenue resource types indexed by vcd resource type.
+func VCDEquivalents() map[string]string {
+	equivalents := make(map[string]string, len(VCDResources))
+	for vcdType, r := range VCDResources {
+		equivalents[vcdType] = r.Type
+	}
+
+	return equivalents
+}
+
+// attribute returns the name of the vcd attribute in the Cloud Avenue resource.
+func (r Resource) attribute(name string) string {
+	if renamed, ok := r.Attributes[name]; ok {
+		return renamed
+	}
+
+	return name
+}
@@ -0,0 +1,179 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+)
+
+const (
+	vcdSource         = "registry.terraform.io/vmware/vcd"
+	cloudavenueSource = "registry.terraform.io/orange-cloudavenue/cloudavenue"
+)
+
+func loadSchemas(t *testing.T, filename, source string) Schemas {
+	t.Helper()
+
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatalf("error opening %s: %s", filename, err)
+	}
+	defer f.Close()
+
+	schemas, err := LoadSchemas(f, source)
+	if err != nil {
+		t.Fatalf("error loading %s: %s", filename, err)
+	}
+
+	return schemas
+}
+
+// TestVCDResources checks the equivalents against the schemas of both providers.
+func TestVCDResources(t *testing.T) {
+	t.Parallel()
+
+	vcdSchemas := loadSchemas(t, "testdata/vcd_schemas.json", vcdSource)
+	caSchemas := SchemasFromProvider(context.Background(), provider.New("test")(), "cloudavenue")
+
+	for vcdType, r := range VCDResources {
+		caSchema, ok := caSchemas[r.Type]
+		if !ok {
+			t.Errorf("%s: expected resource %s in the Cloud Avenue provider", vcdType, r.Type)
+			continue
+		}
+
+		for from, to := range r.Attributes {
+			if !caSchema.Attributes[to] {
+				t.Errorf("%s: expected attribute %s in %s", vcdType, to, r.Type)
+			}
+			if vcdSchema, ok := vcdSchemas[vcdType]; ok && !vcdSchema.Attributes[from] {
+				t.Errorf("%s: expected attribute %s in the vcd schema", vcdType, from)
+			}
+		}
+
+		vcdSchema, ok := vcdSchemas[vcdType]
+		if !ok {
+			continue
+		}
+		for _, name := range r.ImportID {
+			if !vcdSchema.Attributes[name] {
+				t.Errorf("%s: expected import ID attribute %s in the vcd schema", vcdType, name)
+			}
+		}
+	}
+}
+
+// TestCloudAvenueSchemasFixture checks that the fixture is in sync with the provider.
+func TestCloudAvenueSchemasFixture(t *testing.T) {
+	t.Parallel()
+
+	fixture := loadSchemas(t, "testdata/cloudavenue_schemas.json", cloudavenueSource)
+	schemas := SchemasFromProvider(context.Background(), provider.New("test")(), "cloudavenue")
+
+	for resourceType, s := range fixture {
+		want, ok := schemas[resourceType]
+		if !ok {
+			t.Errorf("expected resource %s in the provider", resourceType)
+			continue
+		}
+		for name := range s.Attributes {
+			if !want.Attributes[name] {
+				t.Errorf("%s: expected attribute %s in the provider", resourceType, name)
+			}
+		}
+		for name := range s.Blocks {
+			if !want.Blocks[name] {
+				t.Errorf("%s: expected block %s in the provider", resourceType, name)
+			}
+		}
+	}
+}
+
+// TestVCDResourcesMoveState checks that the moved blocks generated by the migration
+// are supported by the Cloud Avenue resources with the same renamed attributes.
+func TestVCDResourcesMoveState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resources := map[string]resource.Resource{}
+	for _, f := range provider.New("test")().Resources(ctx) {
+		r := f()
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "cloudavenue"}, metadata)
+		resources[metadata.TypeName] = r
+	}
+
+	for vcdType, r := range VCDResources {
+		target, ok := resources[r.Type].(resource.ResourceWithMoveState)
+		if !ok {
+			t.Errorf("%s: expected %s to support the move of the state", vcdType, r.Type)
+			continue
+		}
+
+		schema := &resource.SchemaResponse{}
+		target.Schema(ctx, resource.SchemaRequest{}, schema)
+
+		source := map[string]string{}
+		for from := range r.Attributes {
+			source[from] = "moved"
+		}
+		rawState, err := json.Marshal(source)
+		if err != nil {
+			t.Fatalf("error encoding the state: %s", err)
+		}
+
+		var moved *tfsdk.State
+		for _, mover := range target.MoveState(ctx) {
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: schema.Schema,
+					Raw:    tftypes.NewValue(schema.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			mover.StateMover(ctx, resource.MoveStateRequest{
+				SourceProviderAddress: vcdSource,
+				SourceTypeName:        vcdType,
+				SourceRawState:        &tfprotov6.RawState{JSON: rawState},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("%s: expected no error, got %v", vcdType, resp.Diagnostics)
+			}
+			if !resp.TargetState.Raw.IsNull() {
+				moved = &resp.TargetState
+				break
+			}
+		}
+		if moved == nil {
+			t.Errorf("%s: expected a state mover in %s", vcdType, r.Type)
+			continue
+		}
+
+		for from, to := range r.Attributes {
+			var value string
+			if diags := moved.GetAttribute(ctx, path.Root(to), &value); diags.HasError() || value != "moved" {
+				t.Errorf("%s: expected attribute %s moved to %s, got %q", vcdType, from, to, value)
+			}
+		}
+	}
+}
+
+func TestVCDEquivalents(t *testing.T) {
+	t.Parallel()
+
+	equivalents := VCDEquivalents()
+	if len(equivalents) != len(VCDResources) {
+		t.Fatalf("expected %d equivalents, got %d", len(VCDResources), len(equivalents))
+	}
+	if got := equivalents["vcd_nsxt_nat_rule"]; got != "cloudavenue_edgegateway_nat_rule" {
+		t.Fatalf("expected cloudavenue_edgegateway_nat_rule, got %s", got)
+	}
+}
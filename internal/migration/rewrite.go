@@ -0,0 +1,231 @@
+package migration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// commentPrefix prefixes the comments added in the configuration by the migration.
+const commentPrefix = "# MIGRATION: "
+
+var (
+	// metaArguments are the meta-arguments of the resources kept by the migration.
+	metaArguments = map[string]bool{"count": true, "for_each": true, "depends_on": true}
+	// metaBlocks are the meta-argument blocks of the resources kept by the migration.
+	metaBlocks = map[string]bool{"lifecycle": true, "provisioner": true, "connection": true}
+)
+
+// Diagnostic is an element of the configuration or of the state that must be migrated manually.
+type Diagnostic struct {
+	Filename string
+	Line     int
+	Address  string
+	Message  string
+}
+
+// String returns the diagnostic prefixed with its location.
+func (d Diagnostic) String() string {
+	if d.Filename == "" {
+		return fmt.Sprintf("%s: %s", d.Address, d.Message)
+	}
+
+	return fmt.Sprintf("%s:%d: %s: %s", d.Filename, d.Line, d.Address, d.Message)
+}
+
+// Move is a resource moved from the vcd provider to the Cloud Avenue provider.
+// The resource keeps its name.
+type Move struct {
+	VCDType string
+	Type    string
+	Name    string
+}
+
+// Result is the result of the migration of a configuration file.
+type Result struct {
+	// Src is the migrated configuration.
+	Src         []byte
+	Moves       []Move
+	Diagnostics []Diagnostic
+}
+
+// Migrator rewrites the vcd resources of the configurations with their Cloud Avenue equivalents.
+type Migrator struct {
+	// Schemas are the resource schemas of the Cloud Avenue provider.
+	Schemas Schemas
+}
+
+// File migrates a configuration file.
+// The vcd resources with a Cloud Avenue equivalent are renamed, their attributes without equivalent
+// are commented out and the references to the migrated resources are updated.
+func (m Migrator) File(filename string, src []byte) (*Result, error) {
+	wf, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	sf, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	// The references are renamed before the resources are rewritten with their raw expressions.
+	m.renameReferences(wf.Body())
+
+	result := &Result{}
+	syntaxBlocks := sf.Body.(*hclsyntax.Body).Blocks
+	for i, block := range wf.Body().Blocks() {
+		syntaxBlock := syntaxBlocks[i]
+		labels := block.Labels()
+		addDiag := func(address, message string) {
+			result.Diagnostics = append(result.Diagnostics, Diagnostic{
+				Filename: filename,
+				Line:     syntaxBlock.TypeRange.Start.Line,
+				Address:  address,
+				Message:  message,
+			})
+		}
+
+		switch {
+		case block.Type() == "resource" && len(labels) == 2 && strings.HasPrefix(labels[0], "vcd_"):
+			if move, ok := m.migrateResource(filename, block, syntaxBlock, result); ok {
+				result.Moves = append(result.Moves, move)
+			}
+		case block.Type() == "data" && len(labels) == 2 && strings.HasPrefix(labels[0], "vcd_"):
+			addDiag("data."+labels[0]+"."+labels[1], "data sources are not migrated, replace it manually")
+		case block.Type() == "provider" && len(labels) == 1 && labels[0] == "vcd":
+			addDiag("provider.vcd", "configure the cloudavenue provider instead")
+		}
+	}
+
+	result.Src = hclwrite.Format(wf.Bytes())
+
+	return result, nil
+}
+
+// migrateResource migrates a vcd resource block to its Cloud Avenue equivalent.
+func (m Migrator) migrateResource(filename string, block *hclwrite.Block, syntaxBlock *hclsyntax.Block, result *Result) (Move, bool) {
+	vcdType, name := block.Labels()[0], block.Labels()[1]
+	addDiag := func(line int, message string) {
+		result.Diagnostics = append(result.Diagnostics, Diagnostic{
+			Filename: filename,
+			Line:     line,
+			Address:  vcdType + "." + name,
+			Message:  message,
+		})
+	}
+
+	r, ok := VCDResources[vcdType]
+	if !ok {
+		addDiag(syntaxBlock.TypeRange.Start.Line, "no equivalent resource in the Cloud Avenue provider")
+		return Move{}, false
+	}
+	schema, ok := m.Schemas[r.Type]
+	if !ok {
+		addDiag(syntaxBlock.TypeRange.Start.Line, "no equivalent resource in the Cloud Avenue provider")
+		return Move{}, false
+	}
+
+	// The items of the body are rewritten in their original order.
+	type item struct {
+		rng   hcl.Range
+		name  string
+		block *hclwrite.Block
+	}
+
+	body := block.Body()
+	items := make([]item, 0, len(syntaxBlock.Body.Attributes)+len(syntaxBlock.Body.Blocks))
+	for attrName, attr := range syntaxBlock.Body.Attributes {
+		items = append(items, item{rng: attr.SrcRange, name: attrName})
+	}
+	for i, b := range body.Blocks() {
+		items = append(items, item{rng: syntaxBlock.Body.Blocks[i].Range(), name: b.Type(), block: b})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].rng.Start.Byte < items[j].rng.Start.Byte })
+
+	exprs := make(map[string]hclwrite.Tokens, len(syntaxBlock.Body.Attributes))
+	for attrName, attr := range body.Attributes() {
+		exprs[attrName] = attr.Expr().BuildTokens(nil)
+		body.RemoveAttribute(attrName)
+	}
+	for _, b := range body.Blocks() {
+		body.RemoveBlock(b)
+	}
+
+	// The blank lines between the items are kept.
+	body.Clear()
+	body.AppendNewline()
+	for i, it := range items {
+		if i > 0 && it.rng.Start.Line > items[i-1].rng.End.Line+1 {
+			body.AppendNewline()
+		}
+
+		if it.block != nil {
+			if metaBlocks[it.name] || schema.Blocks[it.name] {
+				body.AppendBlock(it.block)
+				continue
+			}
+
+			message := fmt.Sprintf("block %s has no equivalent in %s, migrate it manually", it.name, r.Type)
+			commentOut(body, message, it.block.BuildTokens(nil).Bytes())
+			addDiag(it.rng.Start.Line, message)
+			continue
+		}
+
+		target := r.attribute(it.name)
+		switch {
+		case metaArguments[it.name]:
+			body.SetAttributeRaw(it.name, exprs[it.name])
+		case schema.Attributes[target]:
+			body.SetAttributeRaw(target, exprs[it.name])
+		default:
+			message := fmt.Sprintf("attribute %s has no equivalent in %s", it.name, r.Type)
+			if it.name == "provider" {
+				message = "meta-argument provider refers to a vcd provider configuration"
+			}
+			commentOut(body, message, []byte(it.name+" = "+strings.TrimSpace(string(exprs[it.name].Bytes()))))
+			addDiag(it.rng.Start.Line, message)
+		}
+	}
+
+	block.SetLabels([]string{r.Type, name})
+
+	return Move{VCDType: vcdType, Type: r.Type, Name: name}, true
+}
+
+// renameReferences renames the references to the vcd resources with a Cloud Avenue equivalent.
+func (m Migrator) renameReferences(body *hclwrite.Body) {
+	for _, attr := range body.Attributes() {
+		for _, traversal := range attr.Expr().Variables() {
+			root := strings.TrimSpace(string(traversal.BuildTokens(nil)[0].Bytes))
+			if !strings.HasPrefix(root, "vcd_") {
+				continue
+			}
+
+			if r, ok := VCDResources[root]; ok && m.Schemas[r.Type].Attributes != nil {
+				attr.Expr().RenameVariablePrefix([]string{root}, []string{r.Type})
+			}
+		}
+	}
+
+	for _, block := range body.Blocks() {
+		m.renameReferences(block.Body())
+	}
+}
+
+// commentOut appends the source commented out with the message to the body.
+func commentOut(body *hclwrite.Body, message string, src []byte) {
+	lines := strings.Split(strings.TrimRight(string(src), "\n"), "\n")
+
+	// The indentation of the source is removed, the body is indented by hclwrite.Format.
+	indent := len(lines[0]) - len(strings.TrimLeft(lines[0], " "))
+	tokens := hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte(commentPrefix + message + "\n")}}
+	for _, line := range lines {
+		line = strings.TrimPrefix(line, strings.Repeat(" ", indent))
+		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComment, Bytes: []byte("# " + line + "\n")})
+	}
+	body.AppendUnstructuredTokens(tokens)
+}
@@ -0,0 +1,64 @@
+package migration
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestMigratorFile(t *testing.T) {
+	t.Parallel()
+
+	src, err := os.ReadFile("testdata/main.tf")
+	if err != nil {
+		t.Fatalf("error reading testdata/main.tf: %s", err)
+	}
+	want, err := os.ReadFile("testdata/main.migrated.tf")
+	if err != nil {
+		t.Fatalf("error reading testdata/main.migrated.tf: %s", err)
+	}
+
+	m := Migrator{Schemas: loadSchemas(t, "testdata/cloudavenue_schemas.json", cloudavenueSource)}
+	result, err := m.File("main.tf", src)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if string(result.Src) != string(want) {
+		t.Errorf("expected migrated configuration:\n%s\ngot:\n%s", want, result.Src)
+	}
+
+	wantMoves := []Move{
+		{VCDType: "vcd_nsxt_ip_set", Type: "cloudavenue_edgegateway_ip_set", Name: "example"},
+		{VCDType: "vcd_nsxt_nat_rule", Type: "cloudavenue_edgegateway_nat_rule", Name: "example"},
+		{VCDType: "vcd_org_user", Type: "cloudavenue_iam_user", Name: "example"},
+		{VCDType: "vcd_nsxt_edgegateway_static_route", Type: "cloudavenue_edgegateway_static_route", Name: "example"},
+	}
+	if !reflect.DeepEqual(result.Moves, wantMoves) {
+		t.Errorf("expected moves %v, got %v", wantMoves, result.Moves)
+	}
+
+	wantDiags := []string{
+		"main.tf:1: provider.vcd: configure the cloudavenue provider instead",
+		"main.tf:5: data.vcd_nsxt_edgegateway.example: data sources are not migrated, replace it manually",
+		"main.tf:10: vcd_nsxt_ip_set.example: attribute org has no equivalent in cloudavenue_edgegateway_ip_set",
+		"main.tf:24: vcd_nsxt_nat_rule.example: attribute logging has no equivalent in cloudavenue_edgegateway_nat_rule",
+		"main.tf:41: vcd_nsxt_edgegateway_static_route.example: block next_hop has no equivalent in cloudavenue_edgegateway_static_route, migrate it manually",
+		"main.tf:50: vcd_edgegateway.nsxv: no equivalent resource in the Cloud Avenue provider",
+	}
+	diags := make([]string, 0, len(result.Diagnostics))
+	for _, d := range result.Diagnostics {
+		diags = append(diags, d.String())
+	}
+	if !reflect.DeepEqual(diags, wantDiags) {
+		t.Errorf("expected diagnostics %q, got %q", wantDiags, diags)
+	}
+}
+
+func TestMigratorFileInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := (Migrator{}).File("main.tf", []byte(`resource "vcd_nsxt_ip_set" {`)); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
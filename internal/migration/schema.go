@@ -0,0 +1,92 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Schema lists the top-level attributes and blocks of a resource.
+type Schema struct {
+	Attributes map[string]bool
+	Blocks     map[string]bool
+}
+
+// Schemas are the resource schemas of a provider indexed by resource type.
+type Schemas map[string]Schema
+
+// providerSchemas is the output of `terraform providers schema -json`.
+type providerSchemas struct {
+	ProviderSchemas map[string]struct {
+		ResourceSchemas map[string]struct {
+			Block struct {
+				Attributes map[string]json.RawMessage `json:"attributes"`
+				BlockTypes map[string]json.RawMessage `json:"block_types"`
+			} `json:"block"`
+		} `json:"resource_schemas"`
+	} `json:"provider_schemas"`
+}
+
+// LoadSchemas reads the resource schemas of the provider from the output of
+// `terraform providers schema -json`. source is the source address of the provider
+// (registry.terraform.io/vmware/vcd).
+func LoadSchemas(r io.Reader, source string) (Schemas, error) {
+	var ps providerSchemas
+	if err := json.NewDecoder(r).Decode(&ps); err != nil {
+		return nil, fmt.Errorf("error decoding the provider schemas: %w", err)
+	}
+
+	p, ok := ps.ProviderSchemas[source]
+	if !ok {
+		return nil, fmt.Errorf("provider %s not found in the provider schemas", source)
+	}
+
+	schemas := make(Schemas, len(p.ResourceSchemas))
+	for resourceType, rs := range p.ResourceSchemas {
+		s := Schema{
+			Attributes: make(map[string]bool, len(rs.Block.Attributes)),
+			Blocks:     make(map[string]bool, len(rs.Block.BlockTypes)),
+		}
+		for name := range rs.Block.Attributes {
+			s.Attributes[name] = true
+		}
+		for name := range rs.Block.BlockTypes {
+			s.Blocks[name] = true
+		}
+		schemas[resourceType] = s
+	}
+
+	return schemas, nil
+}
+
+// SchemasFromProvider returns the resource schemas of a provider built with the plugin framework.
+func SchemasFromProvider(ctx context.Context, p provider.Provider, providerTypeName string) Schemas {
+	schemas := Schemas{}
+	for _, f := range p.Resources(ctx) {
+		r := f()
+
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, metadata)
+
+		schema := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schema)
+
+		s := Schema{
+			Attributes: make(map[string]bool, len(schema.Schema.Attributes)),
+			Blocks:     make(map[string]bool, len(schema.Schema.Blocks)),
+		}
+		for name := range schema.Schema.Attributes {
+			s.Attributes[name] = true
+		}
+		for name := range schema.Schema.Blocks {
+			s.Blocks[name] = true
+		}
+		schemas[metadata.TypeName] = s
+	}
+
+	return schemas
+}
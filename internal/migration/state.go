@@ -0,0 +1,159 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Import is the import of an instance of a vcd resource in its Cloud Avenue equivalent.
+type Import struct {
+	Move
+	// Key is the index key of the instance (count or for_each), nil if the resource has a single instance.
+	Key any
+	// ID is the import ID of the Cloud Avenue resource.
+	ID string
+}
+
+// state is the subset of the Terraform state (version 4) read by the migration.
+type state struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   any            `json:"index_key"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// Imports builds the imports of the moved resources from the state of the vcd resources
+// (the terraform.tfstate file or the output of `terraform state pull`).
+func Imports(r io.Reader, moves []Move) ([]Import, []Diagnostic, error) {
+	var s state
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, nil, fmt.Errorf("error decoding the state: %w", err)
+	}
+	if s.Version != 4 {
+		return nil, nil, fmt.Errorf("unsupported state version %d, expected 4", s.Version)
+	}
+
+	moved := make(map[string]Move, len(moves))
+	for _, move := range moves {
+		moved[move.VCDType+"."+move.Name] = move
+	}
+
+	var (
+		imports []Import
+		diags   []Diagnostic
+	)
+	for _, res := range s.Resources {
+		address := res.Type + "." + res.Name
+		move, ok := moved[address]
+		if !ok || res.Mode != "managed" {
+			continue
+		}
+
+		if res.Module != "" {
+			diags = append(diags, Diagnostic{Address: res.Module + "." + address, Message: "resources of modules are not migrated"})
+			continue
+		}
+
+		importID := VCDResources[res.Type].ImportID
+		if len(importID) == 0 {
+			diags = append(diags, Diagnostic{Address: address, Message: fmt.Sprintf("the import ID of %s cannot be built from the state, import it manually", move.Type)})
+			continue
+		}
+
+	instances:
+		for _, instance := range res.Instances {
+			parts := make([]string, 0, len(importID))
+			for _, name := range importID {
+				value, _ := instance.Attributes[name].(string)
+				if value == "" {
+					diags = append(diags, Diagnostic{Address: address, Message: fmt.Sprintf("attribute %s is empty in the state, import it manually", name)})
+					continue instances
+				}
+				parts = append(parts, value)
+			}
+
+			imports = append(imports, Import{
+				Move: move,
+				Key:  instance.IndexKey,
+				ID:   strings.Join(parts, "."),
+			})
+		}
+	}
+
+	return imports, diags, nil
+}
+
+// MovedBlocks returns the moved blocks of the resources.
+// Terraform moves the state of a resource to another provider only if the
+// resource of the target provider supports it.
+func MovedBlocks(moves []Move) []byte {
+	f := hclwrite.NewEmptyFile()
+	for i, move := range moves {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+
+		body := f.Body().AppendNewBlock("moved", nil).Body()
+		body.SetAttributeTraversal("from", address(move.VCDType, move.Name, nil))
+		body.SetAttributeTraversal("to", address(move.Type, move.Name, nil))
+	}
+
+	return f.Bytes()
+}
+
+// ImportBlocks returns the import blocks of the Cloud Avenue resources and the removed blocks
+// forgetting the vcd resources without destroying them.
+func ImportBlocks(imports []Import) []byte {
+	f := hclwrite.NewEmptyFile()
+	removed := map[Move]bool{}
+	for _, i := range imports {
+		body := f.Body().AppendNewBlock("import", nil).Body()
+		body.SetAttributeTraversal("to", address(i.Type, i.Name, i.Key))
+		body.SetAttributeValue("id", cty.StringVal(i.ID))
+		f.Body().AppendNewline()
+	}
+
+	for _, i := range imports {
+		if removed[i.Move] {
+			continue
+		}
+		removed[i.Move] = true
+
+		body := f.Body().AppendNewBlock("removed", nil).Body()
+		body.SetAttributeTraversal("from", address(i.VCDType, i.Name, nil))
+		body.AppendNewBlock("lifecycle", nil).Body().SetAttributeValue("destroy", cty.False)
+		f.Body().AppendNewline()
+	}
+
+	return hclwrite.Format(f.Bytes())
+}
+
+// address returns the address of the resource instance.
+func address(resourceType, name string, key any) hcl.Traversal {
+	traversal := hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	}
+
+	switch k := key.(type) {
+	case float64:
+		traversal = append(traversal, hcl.TraverseIndex{Key: cty.NumberIntVal(int64(k))})
+	case string:
+		traversal = append(traversal, hcl.TraverseIndex{Key: cty.StringVal(k)})
+	}
+
+	return traversal
+}
@@ -0,0 +1,88 @@
+package migration
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var stateMoves = []Move{
+	{VCDType: "vcd_nsxt_ip_set", Type: "cloudavenue_edgegateway_ip_set", Name: "example"},
+	{VCDType: "vcd_nsxt_nat_rule", Type: "cloudavenue_edgegateway_nat_rule", Name: "example"},
+	{VCDType: "vcd_org_user", Type: "cloudavenue_iam_user", Name: "example"},
+	{VCDType: "vcd_nsxt_alb_pool", Type: "cloudavenue_alb_pool", Name: "example"},
+}
+
+func TestImports(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Open("testdata/terraform.tfstate")
+	if err != nil {
+		t.Fatalf("error opening testdata/terraform.tfstate: %s", err)
+	}
+	defer f.Close()
+
+	imports, diags, err := Imports(f, stateMoves)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	wantDiags := []string{
+		"vcd_nsxt_nat_rule.example: attribute id is empty in the state, import it manually",
+		"vcd_nsxt_alb_pool.example: the import ID of cloudavenue_alb_pool cannot be built from the state, import it manually",
+		"module.network.vcd_nsxt_ip_set.example: resources of modules are not migrated",
+	}
+	gotDiags := make([]string, 0, len(diags))
+	for _, d := range diags {
+		gotDiags = append(gotDiags, d.String())
+	}
+	if !reflect.DeepEqual(gotDiags, wantDiags) {
+		t.Errorf("expected diagnostics %q, got %q", wantDiags, gotDiags)
+	}
+
+	want, err := os.ReadFile("testdata/imports.tf")
+	if err != nil {
+		t.Fatalf("error reading testdata/imports.tf: %s", err)
+	}
+	if got := ImportBlocks(imports); string(got) != string(want) {
+		t.Errorf("expected import blocks:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestImportsInvalidState(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"invalid JSON":        `{`,
+		"unsupported version": `{"version": 3, "resources": []}`,
+	}
+
+	for name, state := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, _, err := Imports(strings.NewReader(state), stateMoves); err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestMovedBlocks(t *testing.T) {
+	t.Parallel()
+
+	want := `moved {
+  from = vcd_nsxt_ip_set.example
+  to   = cloudavenue_edgegateway_ip_set.example
+}
+
+moved {
+  from = vcd_org_user.example
+  to   = cloudavenue_iam_user.example
+}
+`
+	if got := MovedBlocks([]Move{stateMoves[0], stateMoves[2]}); string(got) != want {
+		t.Fatalf("expected moved blocks:\n%s\ngot:\n%s", want, got)
+	}
+}
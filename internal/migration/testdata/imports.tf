@@ -0,0 +1,36 @@
+import {
+  to = cloudavenue_edgegateway_ip_set.example
+  id = "urn:vcloud:gateway:6f0a9a2c-3c4d-4c3e-9d3f-1a2b3c4d5e6f.my-ip-set"
+}
+
+import {
+  to = cloudavenue_edgegateway_nat_rule.example[0]
+  id = "urn:vcloud:gateway:6f0a9a2c-3c4d-4c3e-9d3f-1a2b3c4d5e6f.8d1a3e5f-2b4c-4d6e-8f0a-1b2c3d4e5f60"
+}
+
+import {
+  to = cloudavenue_iam_user.example
+  id = "my-user"
+}
+
+removed {
+  from = vcd_nsxt_ip_set.example
+  lifecycle {
+    destroy = false
+  }
+}
+
+removed {
+  from = vcd_nsxt_nat_rule.example
+  lifecycle {
+    destroy = false
+  }
+}
+
+removed {
+  from = vcd_org_user.example
+  lifecycle {
+    destroy = false
+  }
+}
+
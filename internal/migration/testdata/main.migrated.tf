@@ -0,0 +1,59 @@
+provider "vcd" {
+  org = "my-org"
+}
+
+data "vcd_nsxt_edgegateway" "example" {
+  name = "my-edge-gateway"
+}
+
+resource "cloudavenue_edgegateway_ip_set" "example" {
+  # MIGRATION: attribute org has no equivalent in cloudavenue_edgegateway_ip_set
+  # org = "my-org"
+  edge_gateway_id = data.vcd_nsxt_edgegateway.example.id
+  name            = "my-ip-set"
+  ip_addresses    = ["192.168.1.10"]
+}
+
+resource "cloudavenue_edgegateway_nat_rule" "example" {
+  count = 2
+
+  edge_gateway_id  = data.vcd_nsxt_edgegateway.example.id
+  name             = "dnat-${count.index}"
+  rule_type        = "DNAT"
+  external_address = "89.1.1.${count.index}"
+  internal_address = "192.168.1.${count.index}"
+  # MIGRATION: attribute logging has no equivalent in cloudavenue_edgegateway_nat_rule
+  # logging = true
+
+  depends_on = [cloudavenue_edgegateway_ip_set.example]
+}
+
+resource "cloudavenue_iam_user" "example" {
+  name      = "my-user"
+  role_name = "Organization Administrator"
+  email     = "user@example.com"
+  password  = var.password
+}
+
+resource "cloudavenue_edgegateway_static_route" "example" {
+  edge_gateway_id = data.vcd_nsxt_edgegateway.example.id
+  name            = "my-route"
+  network_cidr    = "10.0.0.0/24"
+
+  # MIGRATION: block next_hop has no equivalent in cloudavenue_edgegateway_static_route, migrate it manually
+  # next_hop {
+  #   ip_address = "192.168.1.254"
+  # }
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+resource "vcd_edgegateway" "nsxv" {
+  name = "my-nsxv-edge-gateway"
+}
+
+output "ip_set_id" {
+  value = cloudavenue_edgegateway_ip_set.example.id
+}
@@ -0,0 +1,56 @@
+provider "vcd" {
+  org = "my-org"
+}
+
+data "vcd_nsxt_edgegateway" "example" {
+  name = "my-edge-gateway"
+}
+
+resource "vcd_nsxt_ip_set" "example" {
+  org             = "my-org"
+  edge_gateway_id = data.vcd_nsxt_edgegateway.example.id
+  name            = "my-ip-set"
+  ip_addresses    = ["192.168.1.10"]
+}
+
+resource "vcd_nsxt_nat_rule" "example" {
+  count = 2
+
+  edge_gateway_id  = data.vcd_nsxt_edgegateway.example.id
+  name             = "dnat-${count.index}"
+  rule_type        = "DNAT"
+  external_address = "89.1.1.${count.index}"
+  internal_address = "192.168.1.${count.index}"
+  logging          = true
+
+  depends_on = [vcd_nsxt_ip_set.example]
+}
+
+resource "vcd_org_user" "example" {
+  name          = "my-user"
+  role          = "Organization Administrator"
+  email_address = "user@example.com"
+  password      = var.password
+}
+
+resource "vcd_nsxt_edgegateway_static_route" "example" {
+  edge_gateway_id = data.vcd_nsxt_edgegateway.example.id
+  name            = "my-route"
+  network_cidr    = "10.0.0.0/24"
+
+  next_hop {
+    ip_address = "192.168.1.254"
+  }
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+resource "vcd_edgegateway" "nsxv" {
+  name = "my-nsxv-edge-gateway"
+}
+
+output "ip_set_id" {
+  value = vcd_nsxt_ip_set.example.id
+}
@@ -1,5 +1,15 @@
 package alb
 
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+)
+
 const (
 	categoryName = "alb"
 )
@@ -8,3 +18,30 @@ type base struct {
 	id   string
 	name string
 }
+
+// getServiceEngineGroupAssignments returns the service engine groups assigned to the Edge Gateway.
+func getServiceEngineGroupAssignments(c *client.CloudAvenue, edgeGatewayID string) ([]*govcd.NsxtAlbServiceEngineGroupAssignment, error) {
+	vmware, err := c.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Set("filter", "gatewayRef.id=="+edgeGatewayID)
+
+	return vmware.GetAllAlbServiceEngineGroupAssignments(queryParams)
+}
+
+// checkALBEnabled returns an error if the ALB is not enabled on the Edge Gateway.
+func checkALBEnabled(edgeGW edgegw.EdgeGateway) error {
+	albConfig, err := edgeGW.GetAlbSettings()
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the ALB settings of the Edge Gateway %s: %w", edgeGW.GetName(), err)
+	}
+
+	if !albConfig.Enabled {
+		return fmt.Errorf("the ALB is not enabled on the Edge Gateway %s, it must be enabled before creating ALB pools or virtual services", edgeGW.GetName())
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Package alb provides a Terraform datasource.
+package alb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+var (
+	_ datasource.DataSource              = &edgeGatewaySettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &edgeGatewaySettingsDataSource{}
+)
+
+func NewEdgeGatewaySettingsDataSource() datasource.DataSource {
+	return &edgeGatewaySettingsDataSource{}
+}
+
+type edgeGatewaySettingsDataSource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the data source.
+func (d *edgeGatewaySettingsDataSource) Init(ctx context.Context, dm *EdgeGatewaySettingsModel) (diags diag.Diagnostics) {
+	var err error
+
+	d.org, diags = org.Init(d.client)
+	if diags.HasError() {
+		return
+	}
+
+	d.edgegw, err = d.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(dm.EdgeGatewayID.Get()),
+		Name: types.StringValue(dm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+func (d *edgeGatewaySettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_edgegateway_settings"
+}
+
+func (d *edgeGatewaySettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = edgeGatewaySettingsSchema(ctx).GetDataSource(ctx)
+}
+
+func (d *edgeGatewaySettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *edgeGatewaySettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer metrics.New("data.cloudavenue_alb_edgegateway_settings", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	config := &EdgeGatewaySettingsModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the data source
+	resp.Diagnostics.Append(d.Init(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the data source read logic here.
+	*/
+
+	albConfig, err := d.edgegw.GetAlbSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving ALB settings", err.Error())
+		return
+	}
+
+	data := config.Copy()
+	data.ID.Set(d.edgegw.GetID())
+	data.EdgeGatewayID.Set(d.edgegw.GetID())
+	data.EdgeGatewayName.Set(d.edgegw.GetName())
+	data.FromNsxtAlbConfig(albConfig)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,80 @@
+package alb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+)
+
+func edgeGatewaySettingsSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_alb_edgegateway_settings` data source allows you to retrieve the Advanced Load Balancer settings of an Edge Gateway.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The ID of the Edge Gateway.",
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"enabled": superschema.SuperBoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Defines if the Advanced Load Balancer is enabled on the Edge Gateway. ALB pools and virtual services can only be created when it is enabled.",
+				},
+			},
+			"supported_feature_set": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The feature set of the Advanced Load Balancer available on the Edge Gateway (`STANDARD` or `PREMIUM`).",
+				},
+			},
+			"service_network_definition": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The IPv4 network, in Gateway CIDR format, used by the service engines of the Advanced Load Balancer.",
+				},
+			},
+			"ipv6_service_network_definition": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The IPv6 network, in Gateway CIDR format, used by the service engines of the Advanced Load Balancer.",
+				},
+			},
+			"transparent_mode_enabled": superschema.SuperBoolAttribute{
+				DataSource: &schemaD.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Defines if the virtual services preserve the IP address of the clients.",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package alb_test
+
+import (
+	"context"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/alb"
+)
+
+// Unit test for the schema of the data source cloudavenue_alb_edgegateway_settings.
+func TestEdgeGatewaySettingsDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &fwdatasource.SchemaResponse{}
+
+	// Instantiate the datasource.DataSource and call its Schema method
+	alb.NewEdgeGatewaySettingsDataSource().Schema(ctx, fwdatasource.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
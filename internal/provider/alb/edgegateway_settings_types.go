@@ -0,0 +1,41 @@
+package alb
+
+import (
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+type EdgeGatewaySettingsModel struct {
+	EdgeGatewayID                supertypes.StringValue `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName              supertypes.StringValue `tfsdk:"edge_gateway_name"`
+	Enabled                      supertypes.BoolValue   `tfsdk:"enabled"`
+	ID                           supertypes.StringValue `tfsdk:"id"`
+	Ipv6ServiceNetworkDefinition supertypes.StringValue `tfsdk:"ipv6_service_network_definition"`
+	ServiceNetworkDefinition     supertypes.StringValue `tfsdk:"service_network_definition"`
+	SupportedFeatureSet          supertypes.StringValue `tfsdk:"supported_feature_set"`
+	TransparentModeEnabled       supertypes.BoolValue   `tfsdk:"transparent_mode_enabled"`
+}
+
+func (rm *EdgeGatewaySettingsModel) Copy() *EdgeGatewaySettingsModel {
+	x := &EdgeGatewaySettingsModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// * CustomFuncs
+
+// FromNsxtAlbConfig sets the model from the NSX-T ALB configuration of the Edge Gateway.
+func (rm *EdgeGatewaySettingsModel) FromNsxtAlbConfig(albConfig *govcdtypes.NsxtAlbConfig) {
+	rm.Enabled.Set(albConfig.Enabled)
+	rm.SupportedFeatureSet = utils.SuperStringValueOrNull(albConfig.SupportedFeatureSet)
+	rm.ServiceNetworkDefinition = utils.SuperStringValueOrNull(albConfig.ServiceNetworkDefinition)
+	rm.Ipv6ServiceNetworkDefinition = utils.SuperStringValueOrNull(albConfig.Ipv6ServiceNetworkDefinition)
+
+	rm.TransparentModeEnabled.SetNull()
+	if albConfig.TransparentModeEnabled != nil {
+		rm.TransparentModeEnabled.Set(*albConfig.TransparentModeEnabled)
+	}
+}
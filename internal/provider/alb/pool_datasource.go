@@ -79,7 +79,7 @@ func (d *albPoolDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *albPoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_alb_pool", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_alb_pool", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var (
 		data  *albPoolModel
@@ -156,13 +156,18 @@ func (d *albPoolDataSource) GetName() string {
 
 // GetAlbPool returns the govcd.NsxtAlbPool.
 func (d *albPoolDataSource) GetAlbPool() (*govcd.NsxtAlbPool, error) {
+	vmware, err := d.client.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
 	if d.GetID() != "" {
-		return d.client.Vmware.GetAlbPoolById(d.GetID())
+		return vmware.GetAlbPoolById(d.GetID())
 	}
 
 	nsxtEdge, err := d.org.GetEdgeGateway(d.edgegw)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve Edge gateway '%s'", d.edgegw.GetIDOrName())
 	}
-	return d.client.Vmware.GetAlbPoolByName(nsxtEdge.GetID(), d.GetName())
+	return vmware.GetAlbPoolByName(nsxtEdge.GetID(), d.GetName())
 }
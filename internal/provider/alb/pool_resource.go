@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -20,6 +19,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
@@ -29,6 +30,8 @@ var (
 	_ resource.Resource                = &albPoolResource{}
 	_ resource.ResourceWithConfigure   = &albPoolResource{}
 	_ resource.ResourceWithImportState = &albPoolResource{}
+	_ resource.ResourceWithMoveState   = &albPoolResource{}
+	_ resource.ResourceWithModifyPlan  = &albPoolResource{}
 	_ albPool                          = &albPoolResource{}
 )
 
@@ -92,7 +95,7 @@ func (r *albPoolResource) Configure(ctx context.Context, req resource.ConfigureR
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *albPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -126,8 +129,14 @@ func (r *albPoolResource) Create(ctx context.Context, req resource.CreateRequest
 	edgeGW.Lock(ctx)
 	defer edgeGW.Unlock(ctx)
 
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	// Create ALB Pool
-	createdAlbPool, err := r.client.Vmware.CreateNsxtAlbPool(albPoolConfig)
+	createdAlbPool, err := vmware.CreateNsxtAlbPool(albPoolConfig)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create ALB Pool", err.Error())
 		return
@@ -142,7 +151,7 @@ func (r *albPoolResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *albPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var (
 		state *albPoolModel
@@ -226,7 +235,7 @@ func (r *albPoolResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *albPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var plan *albPoolModel
 
@@ -278,7 +287,7 @@ func (r *albPoolResource) Update(ctx context.Context, req resource.UpdateRequest
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *albPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *albPoolModel
 
@@ -317,21 +326,67 @@ func (r *albPoolResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
-func (r *albPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Import)()
+// ModifyPlan Check if the ALB is enabled on the Edge Gateway.
+func (r *albPoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed or the provider is not configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
 
-	idParts := strings.Split(req.ID, ".")
+	plan := &albPoolModel{}
+	if d := req.Plan.Get(ctx, plan); d.HasError() {
+		// return because plan is empty
+		return
+	}
 
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: edge_gateway_name.alb_pool_name. Got: %q", req.ID),
-		)
+	// The Edge Gateway is not known yet, it is probably created in the same apply.
+	if plan.EdgeGatewayID.IsUnknown() || plan.EdgeGatewayName.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	edgeGW, err := r.org.GetEdgeGateway(r.edgegw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get Edge Gateway", err.Error())
+		return
+	}
+
+	if err := checkALBEnabled(edgeGW); err != nil {
+		attributePath := path.Root("edge_gateway_name")
+		if plan.EdgeGatewayName.IsNull() {
+			attributePath = path.Root("edge_gateway_id")
+		}
+		resp.Diagnostics.AddAttributeError(attributePath, "ALB is not available on the Edge Gateway", err.Error())
+	}
+}
+
+func (r *albPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_alb_pool", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.Name("alb_pool", "ALB pool")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// Only one of edge_gateway_id and edge_gateway_name can be set.
+	if edgeGateway := id.Get("edge_gateway"); edgeGateway.IsID() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), edgeGateway.ID())...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), edgeGateway.Name())...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("alb_pool").Name())...)
+}
+
+// MoveState moves the state of the vcd_nsxt_alb_pool resource of the vcd provider to the resource.
+func (r *albPoolResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_alb_pool", nil),
+	}
 }
 
 // GetID returns the ID of the albPool.
@@ -346,15 +401,20 @@ func (r *albPoolResource) GetName() string {
 
 // GetAlbPool returns the govcd.NsxtAlbPool.
 func (r *albPoolResource) GetAlbPool() (*govcd.NsxtAlbPool, error) {
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
 	if r.GetID() != "" {
-		return r.client.Vmware.GetAlbPoolById(r.GetID())
+		return vmware.GetAlbPoolById(r.GetID())
 	}
 
 	nsxtEdge, err := r.org.GetEdgeGateway(r.edgegw)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve Edge gateway '%s'", r.edgegw.GetIDOrName())
 	}
-	return r.client.Vmware.GetAlbPoolByName(nsxtEdge.EdgeGateway.ID, r.GetName())
+	return vmware.GetAlbPoolByName(nsxtEdge.EdgeGateway.ID, r.GetName())
 }
 
 // getAlbPoolConfig is the main function for getting *govcdtypes.NsxtAlbPool for API request. It nests multiple smaller
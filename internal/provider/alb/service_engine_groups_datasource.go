@@ -0,0 +1,132 @@
+// Package alb provides a Terraform datasource.
+package alb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+var (
+	_ datasource.DataSource              = &serviceEngineGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceEngineGroupsDataSource{}
+)
+
+func NewServiceEngineGroupsDataSource() datasource.DataSource {
+	return &serviceEngineGroupsDataSource{}
+}
+
+type serviceEngineGroupsDataSource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the data source.
+func (d *serviceEngineGroupsDataSource) Init(ctx context.Context, dm *ServiceEngineGroupsModel) (diags diag.Diagnostics) {
+	var err error
+
+	d.org, diags = org.Init(d.client)
+	if diags.HasError() {
+		return
+	}
+
+	d.edgegw, err = d.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(dm.EdgeGatewayID.Get()),
+		Name: types.StringValue(dm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+func (d *serviceEngineGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_service_engine_groups"
+}
+
+func (d *serviceEngineGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = serviceEngineGroupsSchema(ctx).GetDataSource(ctx)
+}
+
+func (d *serviceEngineGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *serviceEngineGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer metrics.New("data.cloudavenue_alb_service_engine_groups", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	config := &ServiceEngineGroupsModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the data source
+	resp.Diagnostics.Append(d.Init(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the data source read logic here.
+	*/
+
+	assignments, err := getServiceEngineGroupAssignments(d.client, d.edgegw.GetID())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving service engine groups", err.Error())
+		return
+	}
+
+	serviceEngineGroups := make(ServiceEngineGroupsModelServiceEngineGroups, 0, len(assignments))
+	for _, assignment := range assignments {
+		serviceEngineGroup := ServiceEngineGroupsModelServiceEngineGroup{}
+		serviceEngineGroup.ID.Set(assignment.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef.ID)
+		serviceEngineGroup.Name.Set(assignment.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef.Name)
+		// Capacity and reservation are only set for a shared service engine group.
+		serviceEngineGroup.MaxVirtualServices.SetIntPtr(assignment.NsxtAlbServiceEngineGroupAssignment.MaxVirtualServices)
+		serviceEngineGroup.ReservedVirtualServices.SetIntPtr(assignment.NsxtAlbServiceEngineGroupAssignment.MinVirtualServices)
+		serviceEngineGroup.DeployedVirtualServices.SetInt(assignment.NsxtAlbServiceEngineGroupAssignment.NumDeployedVirtualServices)
+
+		serviceEngineGroups = append(serviceEngineGroups, serviceEngineGroup)
+	}
+
+	data := config.Copy()
+	data.ID.Set(utils.GenerateUUID("alb_service_engine_groups", d.edgegw.GetID()).ValueString())
+	data.EdgeGatewayID.Set(d.edgegw.GetID())
+	data.EdgeGatewayName.Set(d.edgegw.GetName())
+	resp.Diagnostics.Append(data.ServiceEngineGroups.Set(ctx, serviceEngineGroups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
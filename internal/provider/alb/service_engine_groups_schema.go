@@ -0,0 +1,88 @@
+package alb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+)
+
+func serviceEngineGroupsSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_alb_service_engine_groups` data source allows you to retrieve the Advanced Load Balancer service engine groups assigned to an Edge Gateway.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Generated ID of the resource.",
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"service_engine_groups": superschema.SuperListNestedAttribute{
+				DataSource: &schemaD.ListNestedAttribute{
+					Computed:            true,
+					MarkdownDescription: "The list of the service engine groups assigned to the Edge Gateway.",
+				},
+				Attributes: superschema.Attributes{
+					"id": superschema.SuperStringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the service engine group.",
+						},
+					},
+					"name": superschema.SuperStringAttribute{
+						DataSource: &schemaD.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the service engine group.",
+						},
+					},
+					"max_virtual_services": superschema.SuperInt64Attribute{
+						DataSource: &schemaD.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The maximum number of virtual services the Edge Gateway can deploy on the service engine group. Only set for a shared service engine group.",
+						},
+					},
+					"reserved_virtual_services": superschema.SuperInt64Attribute{
+						DataSource: &schemaD.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of virtual services guaranteed to the Edge Gateway on the service engine group. Only set for a shared service engine group.",
+						},
+					},
+					"deployed_virtual_services": superschema.SuperInt64Attribute{
+						DataSource: &schemaD.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of virtual services of the Edge Gateway deployed on the service engine group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package alb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+type ServiceEngineGroupsModel struct {
+	EdgeGatewayID       supertypes.StringValue     `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName     supertypes.StringValue     `tfsdk:"edge_gateway_name"`
+	ID                  supertypes.StringValue     `tfsdk:"id"`
+	ServiceEngineGroups supertypes.ListNestedValue `tfsdk:"service_engine_groups"`
+}
+
+// * ServiceEngineGroups.
+type ServiceEngineGroupsModelServiceEngineGroups []ServiceEngineGroupsModelServiceEngineGroup
+
+// * ServiceEngineGroup.
+type ServiceEngineGroupsModelServiceEngineGroup struct {
+	DeployedVirtualServices supertypes.Int64Value  `tfsdk:"deployed_virtual_services"`
+	ID                      supertypes.StringValue `tfsdk:"id"`
+	MaxVirtualServices      supertypes.Int64Value  `tfsdk:"max_virtual_services"`
+	Name                    supertypes.StringValue `tfsdk:"name"`
+	ReservedVirtualServices supertypes.Int64Value  `tfsdk:"reserved_virtual_services"`
+}
+
+func (rm *ServiceEngineGroupsModel) Copy() *ServiceEngineGroupsModel {
+	x := &ServiceEngineGroupsModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// GetServiceEngineGroups returns the value of the ServiceEngineGroups field.
+func (rm *ServiceEngineGroupsModel) GetServiceEngineGroups(ctx context.Context) (values ServiceEngineGroupsModelServiceEngineGroups, diags diag.Diagnostics) {
+	values = make(ServiceEngineGroupsModelServiceEngineGroups, 0)
+	d := rm.ServiceEngineGroups.Get(ctx, &values, false)
+	return values, d
+}
@@ -0,0 +1,481 @@
+// Package alb provides a Terraform resource.
+package alb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &virtualServiceResource{}
+	_ resource.ResourceWithConfigure   = &virtualServiceResource{}
+	_ resource.ResourceWithImportState = &virtualServiceResource{}
+	_ resource.ResourceWithMoveState   = &virtualServiceResource{}
+
+	_ resource.ResourceWithValidateConfig = &virtualServiceResource{}
+)
+
+// NewVirtualServiceResource is a helper function to simplify the provider implementation.
+func NewVirtualServiceResource() resource.Resource {
+	return &virtualServiceResource{}
+}
+
+// virtualServiceResource is the resource implementation.
+type virtualServiceResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the resource.
+func (r *virtualServiceResource) Init(ctx context.Context, rm *VirtualServiceModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.org, diags = org.Init(r.client)
+	if diags.HasError() {
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(rm.EdgeGatewayID.Get()),
+		Name: types.StringValue(rm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *virtualServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_virtual_service"
+}
+
+// Schema defines the schema for the resource.
+func (r *virtualServiceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = virtualServiceSchema(ctx).GetResource(ctx)
+}
+
+func (r *virtualServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig validates the service ports of the configuration.
+func (r *virtualServiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config := NewVirtualService(req.Config)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() || !config.ServicePorts.IsKnown() {
+		return
+	}
+
+	servicePorts, d := config.GetServicePorts(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, servicePort := range servicePorts {
+		if servicePort.Start.IsKnown() && servicePort.End.IsKnown() && servicePort.End.Get() <= servicePort.Start.Get() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("service_ports"),
+				"Invalid service port",
+				fmt.Sprintf("The end port %d must be greater than the start port %d.", servicePort.End.Get(), servicePort.Start.Get()),
+			)
+		}
+
+		// The certificate may be unknown until the apply.
+		if servicePort.SSLEnabled.IsKnown() && servicePort.SSLEnabled.Get() && config.CertificateID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("certificate_id"),
+				"Missing certificate",
+				fmt.Sprintf("The service port %d is SSL enabled, the certificate_id attribute is required.", servicePort.Start.Get()),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *virtualServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer metrics.New("cloudavenue_alb_virtual_service", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
+
+	plan := &VirtualServiceModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource creation logic here.
+	*/
+
+	// The virtual services share the lock of the ALB pools of the Edge Gateway.
+	r.edgegw.Lock(ctx)
+	defer r.edgegw.Unlock(ctx)
+
+	resp.Diagnostics.Append(r.checkVirtualIP(plan.VirtualIP.Get())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualServiceConfig, d := plan.ToNsxtAlbVirtualService(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceEngineGroupRef, err := r.getServiceEngineGroupRef(plan.ServiceEngineGroupName.Get())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving service engine group", err.Error())
+		return
+	}
+	virtualServiceConfig.ServiceEngineGroupRef = *serviceEngineGroupRef
+	virtualServiceConfig.GatewayRef = govcdtypes.OpenApiReference{ID: r.edgegw.GetID()}
+
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
+	createdVirtualService, err := vmware.CreateNsxtAlbVirtualService(virtualServiceConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating virtual service", err.Error())
+		return
+	}
+	plan.ID.Set(createdVirtualService.NsxtAlbVirtualService.ID)
+
+	state, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *virtualServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer metrics.New("cloudavenue_alb_virtual_service", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	state := &VirtualServiceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource read here
+	*/
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *virtualServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer metrics.New("cloudavenue_alb_virtual_service", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
+
+	var (
+		plan  = &VirtualServiceModel{}
+		state = &VirtualServiceModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource update here
+	*/
+
+	r.edgegw.Lock(ctx)
+	defer r.edgegw.Unlock(ctx)
+
+	if !plan.VirtualIP.Equal(state.VirtualIP) {
+		resp.Diagnostics.Append(r.checkVirtualIP(plan.VirtualIP.Get())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
+	virtualService, err := vmware.GetAlbVirtualServiceById(state.ID.Get())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving virtual service", err.Error())
+		return
+	}
+
+	virtualServiceConfig, d := plan.ToNsxtAlbVirtualService(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	virtualServiceConfig.GatewayRef = virtualService.NsxtAlbVirtualService.GatewayRef
+	virtualServiceConfig.ServiceEngineGroupRef = virtualService.NsxtAlbVirtualService.ServiceEngineGroupRef
+
+	if _, err := virtualService.Update(virtualServiceConfig); err != nil {
+		resp.Diagnostics.AddError("Error updating virtual service", err.Error())
+		return
+	}
+
+	stateRefreshed, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *virtualServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer metrics.New("cloudavenue_alb_virtual_service", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
+
+	state := &VirtualServiceModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource deletion here
+	*/
+
+	r.edgegw.Lock(ctx)
+	defer r.edgegw.Unlock(ctx)
+
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
+	virtualService, err := vmware.GetAlbVirtualServiceById(state.ID.Get())
+	if err != nil {
+		// The virtual service is already deleted
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving virtual service", err.Error())
+		return
+	}
+
+	if err := virtualService.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting virtual service", err.Error())
+		return
+	}
+}
+
+func (r *virtualServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_alb_virtual_service", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.Name("virtual_service", "ALB virtual service")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// Only one of edge_gateway_id and edge_gateway_name can be set.
+	if edgeGateway := id.Get("edge_gateway"); edgeGateway.IsID() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), edgeGateway.ID())...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), edgeGateway.Name())...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("virtual_service").Name())...)
+}
+
+// MoveState moves the state of the vcd_nsxt_alb_virtual_service resource of the vcd provider to the resource.
+func (r *virtualServiceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_alb_virtual_service", map[string]string{
+			"virtual_ip_address": "virtual_ip",
+			"ca_certificate_id":  "certificate_id",
+		}),
+	}
+}
+
+// * CustomFuncs
+
+func (r *virtualServiceResource) read(ctx context.Context, planOrState *VirtualServiceModel) (stateRefreshed *VirtualServiceModel, found bool, diags diag.Diagnostics) {
+	stateRefreshed = planOrState.Copy()
+
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		diags.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return nil, true, diags
+	}
+
+	var virtualService *govcd.NsxtAlbVirtualService
+	if planOrState.ID.IsKnown() {
+		virtualService, err = vmware.GetAlbVirtualServiceById(planOrState.ID.Get())
+	} else {
+		virtualService, err = vmware.GetAlbVirtualServiceByName(r.edgegw.GetID(), planOrState.Name.Get())
+	}
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving virtual service", err.Error())
+		return nil, true, diags
+	}
+
+	diags.Append(stateRefreshed.FromNsxtAlbVirtualService(ctx, virtualService.NsxtAlbVirtualService)...)
+	stateRefreshed.EdgeGatewayID.Set(r.edgegw.GetID())
+	stateRefreshed.EdgeGatewayName.Set(r.edgegw.GetName())
+
+	return stateRefreshed, true, diags
+}
+
+// getServiceEngineGroupRef returns the reference of the service engine group assigned to the Edge Gateway.
+// If the name is empty, the Edge Gateway must have only one service engine group.
+func (r *virtualServiceResource) getServiceEngineGroupRef(name string) (*govcdtypes.OpenApiReference, error) {
+	assignments, err := getServiceEngineGroupAssignments(r.client, r.edgegw.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		if name != "" && assignment.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef.Name == name {
+			return assignment.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef, nil
+		}
+		names = append(names, assignment.NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef.Name)
+	}
+
+	switch {
+	case name != "":
+		return nil, fmt.Errorf("the service engine group %s is not assigned to the Edge Gateway %s (assigned: %s)", name, r.edgegw.GetName(), strings.Join(names, ", "))
+	case len(assignments) == 0:
+		return nil, fmt.Errorf("no service engine group is assigned to the Edge Gateway %s, the ALB is not enabled", r.edgegw.GetName())
+	case len(assignments) > 1:
+		return nil, fmt.Errorf("several service engine groups are assigned to the Edge Gateway %s (%s), set the service_engine_group_name attribute", r.edgegw.GetName(), strings.Join(names, ", "))
+	}
+
+	return assignments[0].NsxtAlbServiceEngineGroupAssignment.ServiceEngineGroupRef, nil
+}
+
+// checkVirtualIP checks that the virtual IP is one of the public IPs of the Edge Gateway.
+func (r *virtualServiceResource) checkVirtualIP(virtualIP string) (diags diag.Diagnostics) {
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		diags.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
+	publicIPs, httpR, err := apiClient.PublicIPApi.GetPublicIPs(r.client.Auth)
+	if httpR != nil {
+		defer func() {
+			err = errors.Join(err, httpR.Body.Close())
+		}()
+	}
+
+	if apiErr := helpers.CheckAPIError(err, httpR); apiErr != nil {
+		diags.Append(apiErr.GetTerraformDiagnostic())
+		return
+	}
+
+	edgeGatewayIPs := make([]string, 0)
+	for _, cfg := range publicIPs.NetworkConfig {
+		if cfg.EdgeGatewayName != r.edgegw.GetName() {
+			continue
+		}
+		if cfg.UplinkIp == virtualIP {
+			return
+		}
+		edgeGatewayIPs = append(edgeGatewayIPs, cfg.UplinkIp)
+	}
+
+	diags.AddAttributeError(
+		path.Root("virtual_ip"),
+		"Invalid virtual IP",
+		fmt.Sprintf("The virtual IP %s is not a public IP of the Edge Gateway %s (public IPs: %s).", virtualIP, r.edgegw.GetName(), strings.Join(edgeGatewayIPs, ", ")),
+	)
+	return
+}
@@ -0,0 +1,100 @@
+package alb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/alb"
+)
+
+// Unit test for the validation of the configuration of the resource cloudavenue_alb_virtual_service.
+func TestVirtualServiceResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	alb.NewVirtualServiceResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	typ := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	portsType := typ.AttributeTypes["service_ports"].(tftypes.Set)
+	portType := portsType.ElementType.(tftypes.Object)
+
+	port := func(start int, end any, sslEnabled bool) tftypes.Value {
+		return tftypes.NewValue(portType, map[string]tftypes.Value{
+			"start":       tftypes.NewValue(tftypes.Number, start),
+			"end":         tftypes.NewValue(tftypes.Number, end),
+			"ssl_enabled": tftypes.NewValue(tftypes.Bool, sslEnabled),
+			"type":        tftypes.NewValue(tftypes.String, nil),
+		})
+	}
+
+	tests := []struct {
+		name          string
+		certificateID any
+		port          tftypes.Value
+		wantError     bool
+	}{
+		{
+			name: "SinglePort",
+			port: port(80, nil, false),
+		},
+		{
+			name: "Range",
+			port: port(80, 90, false),
+		},
+		{
+			name:      "EndEqualToStart",
+			port:      port(80, 80, false),
+			wantError: true,
+		},
+		{
+			name:      "EndLowerThanStart",
+			port:      port(90, 80, false),
+			wantError: true,
+		},
+		{
+			name:      "SSLWithoutCertificate",
+			port:      port(443, nil, true),
+			wantError: true,
+		},
+		{
+			name:          "SSLWithCertificate",
+			certificateID: "urn:vcloud:certificateLibraryItem:6f1d3a4e-7c1b-4b9e-9d2e-5a8f0c3b2e71",
+			port:          port(443, nil, true),
+		},
+		{
+			// The certificate is known at the apply.
+			name:          "SSLWithUnknownCertificate",
+			certificateID: tftypes.UnknownValue,
+			port:          port(443, nil, true),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := map[string]tftypes.Value{}
+			for name, attributeType := range typ.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+			attributes["certificate_id"] = tftypes.NewValue(tftypes.String, tt.certificateID)
+			attributes["service_ports"] = tftypes.NewValue(portsType, []tftypes.Value{tt.port})
+
+			resp := &fwresource.ValidateConfigResponse{}
+			alb.NewVirtualServiceResource().(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(typ, attributes)},
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("expected error %t, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package alb
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+const (
+	virtualServiceApplicationProfileHTTP  = "HTTP"
+	virtualServiceApplicationProfileHTTPS = "HTTPS"
+	virtualServiceApplicationProfileL4    = "L4"
+)
+
+func virtualServiceSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_alb_virtual_service` resource allows you to manage an Advanced Load Balancer Virtual Service. A virtual service exposes the members of an ALB pool on a virtual IP of the Edge Gateway.",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_alb_virtual_service` data source allows you to retrieve information about an Advanced Load Balancer Virtual Service.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The ID of the virtual service.",
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the virtual service.",
+					Required:            true,
+				},
+			},
+			"description": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The description of the virtual service.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"enabled": superschema.SuperBoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Defines if the virtual service accepts traffic.",
+					Computed:            true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					Optional: true,
+					Default:  booldefault.StaticBool(true),
+				},
+			},
+			"pool_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the ALB pool serving the traffic of the virtual service.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"service_engine_group_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the service engine group running the virtual service.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "It must be assigned to the Edge Gateway. If not set, the only service engine group assigned to the Edge Gateway is used.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"virtual_ip": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The virtual IP address on which the virtual service accepts traffic.",
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "It must be one of the public IPs of the Edge Gateway.",
+					Required:            true,
+					Validators: []validator.String{
+						fstringvalidator.IsIP(),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"application_profile_type": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The type of the application profile, it defines the protocol of the traffic load balanced by the virtual service.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       virtualServiceApplicationProfileHTTP,
+								Description: "The virtual service load balances HTTP traffic.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       virtualServiceApplicationProfileHTTPS,
+								Description: "The virtual service load balances HTTPS traffic, a certificate is required.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       virtualServiceApplicationProfileL4,
+								Description: "The virtual service load balances TCP or UDP traffic.",
+							},
+						),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"certificate_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the certificate of the organization library used to serve the encrypted traffic.",
+				},
+				Resource: &schemaR.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fstringvalidator.RequireIfAttributeIsOneOf(path.MatchRoot("application_profile_type"), []attr.Value{types.StringValue(virtualServiceApplicationProfileHTTPS)}),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					Computed: true,
+				},
+			},
+			"service_ports": superschema.SuperSetNestedAttribute{
+				Common: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The ports on which the virtual service accepts traffic.",
+				},
+				Resource: &schemaR.SetNestedAttribute{
+					Required: true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				DataSource: &schemaD.SetNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"start": superschema.SuperInt64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The port, or the first port of the range.",
+						},
+						Resource: &schemaR.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						DataSource: &schemaD.Int64Attribute{
+							Computed: true,
+						},
+					},
+					"end": superschema.SuperInt64Attribute{
+						Common: &schemaR.Int64Attribute{
+							MarkdownDescription: "The last port of the range.",
+						},
+						Resource: &schemaR.Int64Attribute{
+							MarkdownDescription: "It must be greater than `start`. If not set, the service port is the single port `start`.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						DataSource: &schemaD.Int64Attribute{
+							Computed: true,
+						},
+					},
+					"ssl_enabled": superschema.SuperBoolAttribute{
+						Common: &schemaR.BoolAttribute{
+							MarkdownDescription: "Defines if the traffic of the port is encrypted with the certificate `certificate_id`.",
+							Computed:            true,
+						},
+						Resource: &schemaR.BoolAttribute{
+							Optional: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+					"type": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The type of the TCP/UDP profile of the port.",
+							Computed:            true,
+						},
+						Resource: &schemaR.StringAttribute{
+							Optional: true,
+							Default:  stringdefault.StaticString("TCP_PROXY"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("TCP_PROXY", "TCP_FAST_PATH", "UDP_FAST_PATH"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
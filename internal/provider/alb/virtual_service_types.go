@@ -0,0 +1,197 @@
+package alb
+
+import (
+	"context"
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+type VirtualServiceModel struct {
+	ApplicationProfileType supertypes.StringValue    `tfsdk:"application_profile_type"`
+	CertificateID          supertypes.StringValue    `tfsdk:"certificate_id"`
+	Description            supertypes.StringValue    `tfsdk:"description"`
+	EdgeGatewayID          supertypes.StringValue    `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName        supertypes.StringValue    `tfsdk:"edge_gateway_name"`
+	Enabled                supertypes.BoolValue      `tfsdk:"enabled"`
+	ID                     supertypes.StringValue    `tfsdk:"id"`
+	Name                   supertypes.StringValue    `tfsdk:"name"`
+	PoolID                 supertypes.StringValue    `tfsdk:"pool_id"`
+	ServiceEngineGroupName supertypes.StringValue    `tfsdk:"service_engine_group_name"`
+	ServicePorts           supertypes.SetNestedValue `tfsdk:"service_ports"`
+	VirtualIP              supertypes.StringValue    `tfsdk:"virtual_ip"`
+}
+
+// * ServicePorts.
+type VirtualServiceModelServicePorts []VirtualServiceModelServicePort
+
+// * ServicePort.
+type VirtualServiceModelServicePort struct {
+	End        supertypes.Int64Value  `tfsdk:"end"`
+	SSLEnabled supertypes.BoolValue   `tfsdk:"ssl_enabled"`
+	Start      supertypes.Int64Value  `tfsdk:"start"`
+	Type       supertypes.StringValue `tfsdk:"type"`
+}
+
+func NewVirtualService(t any) *VirtualServiceModel {
+	switch x := t.(type) {
+	case tfsdk.State:
+		return &VirtualServiceModel{
+			ApplicationProfileType: supertypes.NewStringNull(),
+			CertificateID:          supertypes.NewStringNull(),
+			Description:            supertypes.NewStringNull(),
+			EdgeGatewayID:          supertypes.NewStringUnknown(),
+			EdgeGatewayName:        supertypes.NewStringUnknown(),
+			Enabled:                supertypes.NewBoolUnknown(),
+			ID:                     supertypes.NewStringUnknown(),
+			Name:                   supertypes.NewStringNull(),
+			PoolID:                 supertypes.NewStringNull(),
+			ServiceEngineGroupName: supertypes.NewStringUnknown(),
+			ServicePorts:           supertypes.NewSetNestedNull(x.Schema.GetAttributes()["service_ports"].GetType().(supertypes.SetNestedType).ElementType()),
+			VirtualIP:              supertypes.NewStringNull(),
+		}
+
+	case tfsdk.Plan:
+		return &VirtualServiceModel{
+			ApplicationProfileType: supertypes.NewStringNull(),
+			CertificateID:          supertypes.NewStringNull(),
+			Description:            supertypes.NewStringNull(),
+			EdgeGatewayID:          supertypes.NewStringUnknown(),
+			EdgeGatewayName:        supertypes.NewStringUnknown(),
+			Enabled:                supertypes.NewBoolUnknown(),
+			ID:                     supertypes.NewStringUnknown(),
+			Name:                   supertypes.NewStringNull(),
+			PoolID:                 supertypes.NewStringNull(),
+			ServiceEngineGroupName: supertypes.NewStringUnknown(),
+			ServicePorts:           supertypes.NewSetNestedNull(x.Schema.GetAttributes()["service_ports"].GetType().(supertypes.SetNestedType).ElementType()),
+			VirtualIP:              supertypes.NewStringNull(),
+		}
+
+	case tfsdk.Config:
+		return &VirtualServiceModel{
+			ApplicationProfileType: supertypes.NewStringNull(),
+			CertificateID:          supertypes.NewStringNull(),
+			Description:            supertypes.NewStringNull(),
+			EdgeGatewayID:          supertypes.NewStringUnknown(),
+			EdgeGatewayName:        supertypes.NewStringUnknown(),
+			Enabled:                supertypes.NewBoolUnknown(),
+			ID:                     supertypes.NewStringUnknown(),
+			Name:                   supertypes.NewStringNull(),
+			PoolID:                 supertypes.NewStringNull(),
+			ServiceEngineGroupName: supertypes.NewStringUnknown(),
+			ServicePorts:           supertypes.NewSetNestedNull(x.Schema.GetAttributes()["service_ports"].GetType().(supertypes.SetNestedType).ElementType()),
+			VirtualIP:              supertypes.NewStringNull(),
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (rm *VirtualServiceModel) Copy() *VirtualServiceModel {
+	x := &VirtualServiceModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// GetServicePorts returns the value of the ServicePorts field.
+func (rm *VirtualServiceModel) GetServicePorts(ctx context.Context) (values VirtualServiceModelServicePorts, diags diag.Diagnostics) {
+	values = make(VirtualServiceModelServicePorts, 0)
+	d := rm.ServicePorts.Get(ctx, &values, false)
+	return values, d
+}
+
+// * CustomFuncs
+
+// ToNsxtAlbVirtualService returns the NSX-T ALB virtual service representation of the model.
+// The references to the Edge Gateway and the service engine group are set by the caller.
+func (rm *VirtualServiceModel) ToNsxtAlbVirtualService(ctx context.Context) (*govcdtypes.NsxtAlbVirtualService, diag.Diagnostics) {
+	servicePorts, d := rm.GetServicePorts(ctx)
+	if d.HasError() {
+		return nil, d
+	}
+
+	virtualService := &govcdtypes.NsxtAlbVirtualService{
+		ID:          rm.ID.Get(),
+		Name:        rm.Name.Get(),
+		Description: rm.Description.Get(),
+		Enabled:     rm.Enabled.GetPtr(),
+		ApplicationProfile: govcdtypes.NsxtAlbVirtualServiceApplicationProfile{
+			SystemDefined: true,
+			Type:          rm.ApplicationProfileType.Get(),
+		},
+		LoadBalancerPoolRef: govcdtypes.OpenApiReference{ID: rm.PoolID.Get()},
+		VirtualIpAddress:    rm.VirtualIP.Get(),
+		ServicePorts:        make([]govcdtypes.NsxtAlbVirtualServicePort, 0, len(servicePorts)),
+	}
+
+	if rm.CertificateID.IsKnown() {
+		virtualService.CertificateRef = &govcdtypes.OpenApiReference{ID: rm.CertificateID.Get()}
+	}
+
+	for _, servicePort := range servicePorts {
+		port := govcdtypes.NsxtAlbVirtualServicePort{
+			PortStart:  servicePort.Start.GetIntPtr(),
+			SslEnabled: servicePort.SSLEnabled.GetPtr(),
+			TcpUdpProfile: &govcdtypes.NsxtAlbVirtualServicePortTcpUdpProfile{
+				SystemDefined: true,
+				Type:          servicePort.Type.Get(),
+			},
+		}
+		if servicePort.End.IsKnown() {
+			port.PortEnd = servicePort.End.GetIntPtr()
+		}
+
+		virtualService.ServicePorts = append(virtualService.ServicePorts, port)
+	}
+
+	return virtualService, d
+}
+
+// FromNsxtAlbVirtualService sets the model from the NSX-T ALB virtual service representation.
+func (rm *VirtualServiceModel) FromNsxtAlbVirtualService(ctx context.Context, virtualService *govcdtypes.NsxtAlbVirtualService) diag.Diagnostics {
+	rm.ID.Set(virtualService.ID)
+	rm.Name.Set(virtualService.Name)
+	rm.Description = utils.SuperStringValueOrNull(virtualService.Description)
+	rm.Enabled.SetPtr(virtualService.Enabled)
+	rm.ApplicationProfileType.Set(virtualService.ApplicationProfile.Type)
+	rm.PoolID.Set(virtualService.LoadBalancerPoolRef.ID)
+	rm.ServiceEngineGroupName.Set(virtualService.ServiceEngineGroupRef.Name)
+	rm.VirtualIP.Set(virtualService.VirtualIpAddress)
+
+	rm.CertificateID.SetNull()
+	if virtualService.CertificateRef != nil && virtualService.CertificateRef.ID != "" {
+		rm.CertificateID.Set(virtualService.CertificateRef.ID)
+	}
+
+	servicePorts := make(VirtualServiceModelServicePorts, 0, len(virtualService.ServicePorts))
+	for _, port := range virtualService.ServicePorts {
+		servicePort := VirtualServiceModelServicePort{
+			End:        supertypes.NewInt64Null(),
+			SSLEnabled: supertypes.NewBoolValue(false),
+			Start:      supertypes.NewInt64Null(),
+			Type:       supertypes.NewStringNull(),
+		}
+		servicePort.Start.SetIntPtr(port.PortStart)
+		// A single port is returned with the same start and end.
+		if port.PortEnd != nil && (port.PortStart == nil || *port.PortEnd != *port.PortStart) {
+			servicePort.End.SetIntPtr(port.PortEnd)
+		}
+		if port.SslEnabled != nil {
+			servicePort.SSLEnabled.Set(*port.SslEnabled)
+		}
+		if port.TcpUdpProfile != nil {
+			servicePort.Type.Set(port.TcpUdpProfile.Type)
+		}
+		servicePorts = append(servicePorts, servicePort)
+	}
+
+	return rm.ServicePorts.Set(ctx, servicePorts)
+}
@@ -50,7 +50,7 @@ func (d *backupDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *backupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_backup", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_backup", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &backupModel{}
 
@@ -65,15 +65,10 @@ func (d *backupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Refresh data NetBackup from the API
-	job, err := d.client.CAVSDK.V1.Netbackup.Inventory.Refresh()
-	if err != nil {
+	if err := s.refreshInventory(ctx); err != nil {
 		resp.Diagnostics.AddError("Error refreshing NetBackup inventory", err.Error())
 		return
 	}
-	if err := job.Wait(1, 45); err != nil {
-		resp.Diagnostics.AddError("Error waiting for NetBackup inventory refresh", err.Error())
-		return
-	}
 
 	// Read data from the API
 	data, _, diags := s.read(ctx, config)
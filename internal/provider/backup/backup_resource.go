@@ -3,7 +3,6 @@ package backup
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
@@ -12,7 +11,10 @@ import (
 	v1common "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/common/netbackup"
 	"github.com/orange-cloudavenue/cloudavenue-sdk-go/v1/netbackup"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 var (
@@ -66,7 +68,7 @@ func (r *backupResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &backupModel{}
 
@@ -84,15 +86,10 @@ func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Refresh data NetBackup from the API
-	job, err := r.client.CAVSDK.V1.Netbackup.Inventory.Refresh()
-	if err != nil {
+	if err := r.refreshInventory(ctx); err != nil {
 		resp.Diagnostics.AddError("Error refreshing NetBackup inventory", err.Error())
 		return
 	}
-	if err := job.Wait(1, 45); err != nil {
-		resp.Diagnostics.AddError("Error waiting for NetBackup inventory refresh", err.Error())
-		return
-	}
 
 	// Get the type target object
 	typeTarget, d := r.getTarget(plan)
@@ -133,7 +130,7 @@ func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &backupModel{}
 
@@ -160,7 +157,7 @@ func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &backupModel{}
@@ -248,7 +245,7 @@ func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &backupModel{}
 
@@ -283,33 +280,31 @@ func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports the resource into the Terraform state.
 func (r *backupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_backup", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
 	// * Import with custom logic
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: Type.TargetName Got: %q", req.ID),
-		)
+	id, d := importid.Parse(req.ID, importid.Format{
+		importid.Part{Name: "type", Help: "the scope of the backup (vdc, vapp or vm)"},
+		importid.NameOrURN("target", "VDC, vApp or VM", uuid.VDC, uuid.VAPP, uuid.VM),
+	})
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Refresh data NetBackup from the API
-	job, err := r.client.CAVSDK.V1.Netbackup.Inventory.Refresh()
-	if err != nil {
+	if err := r.refreshInventory(ctx); err != nil {
 		resp.Diagnostics.AddError("Error refreshing NetBackup inventory", err.Error())
 		return
 	}
-	if err := job.Wait(1, 45); err != nil {
-		resp.Diagnostics.AddError("Error waiting for NetBackup inventory refresh", err.Error())
-		return
-	}
 
 	data := NewBackup()
-	data.Type.Set(idParts[0])
-	data.TargetName.Set(idParts[1])
+	data.Type.Set(id.Get("type").Name())
+	if target := id.Get("target"); target.IsID() {
+		data.TargetID.Set(target.ID())
+	} else {
+		data.TargetName.Set(target.Name())
+	}
 
 	// Use generic read function to refresh the state
 	stateRefreshed, _, d := r.read(ctx, data)
@@ -333,6 +328,23 @@ type target interface {
 	ListProtectionLevels() (*netbackup.ProtectionLevels, error)
 }
 
+// refreshInventory refreshes the NetBackup inventory and waits for the job to be done.
+// The refresh is retried if the NetBackup job times out.
+func (r *backupResource) refreshInventory(ctx context.Context) error {
+	netBackup, err := r.client.NetBackup()
+	if err != nil {
+		return err
+	}
+
+	return helpers.Retry(ctx, r.client, func() error {
+		job, err := netBackup.Inventory.Refresh()
+		if err != nil {
+			return err
+		}
+		return job.Wait(1, 45)
+	})
+}
+
 // Apply the protection level for a policy to the target.
 // A target can be a vdc, vapp or vm.
 // Return a policy with the protection level ID.
@@ -456,14 +468,19 @@ func (r *backupResource) read(ctx context.Context, planOrState *backupModel) (st
 // getTarget returns the target object from the plan or state.
 // A target can be a vdc, vapp or vm netbackup object.
 func (r *backupResource) getTarget(data *backupModel) (typeTarget target, d diag.Diagnostics) {
-	var err error
+	netBackup, err := r.client.NetBackup()
+	if err != nil {
+		d.AddError("Unable to Configure NetBackup Client", err.Error())
+		return nil, d
+	}
+
 	switch data.Type.Get() {
 	case vdc:
-		typeTarget, err = r.client.CAVSDK.V1.Netbackup.VCloud.GetVdcByNameOrIdentifier(data.getTargetIDOrName())
+		typeTarget, err = netBackup.VCloud.GetVdcByNameOrIdentifier(data.getTargetIDOrName())
 	case vapp:
-		typeTarget, err = r.client.CAVSDK.V1.Netbackup.VCloud.GetVAppByNameOrIdentifier(data.getTargetIDOrName())
+		typeTarget, err = netBackup.VCloud.GetVAppByNameOrIdentifier(data.getTargetIDOrName())
 	case vm:
-		typeTarget, err = r.client.CAVSDK.V1.Netbackup.Machines.GetMachineByNameOrIdentifier(data.getTargetIDOrName())
+		typeTarget, err = netBackup.Machines.GetMachineByNameOrIdentifier(data.getTargetIDOrName())
 	}
 	if err != nil {
 		d.AddError(fmt.Sprintf("Error getting vCloud Director %s", data.Type.Get()), err.Error())
@@ -66,7 +66,7 @@ func (d *aclDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 }
 
 func (d *aclDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_catalog_acl", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_catalog_acl", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &ACLModel{}
 
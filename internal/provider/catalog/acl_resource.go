@@ -15,6 +15,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &aclResource{}
 	_ resource.ResourceWithConfigure   = &aclResource{}
 	_ resource.ResourceWithImportState = &aclResource{}
+	_ resource.ResourceWithMoveState   = &aclResource{}
 )
 
 // NewACLResource is a helper function to simplify the provider implementation.
@@ -77,7 +80,7 @@ func (r *aclResource) Configure(ctx context.Context, req resource.ConfigureReque
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &ACLModel{}
 
@@ -114,7 +117,7 @@ func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read refreshes the Terraform state with the latest data.
 func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &ACLModel{}
 
@@ -150,7 +153,7 @@ func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &ACLModel{}
@@ -191,7 +194,7 @@ func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &ACLModel{}
 
@@ -223,10 +226,13 @@ func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_catalog_acl", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	// import format is catalogIDOrName
-	var d diag.Diagnostics
+	id, d := importid.Parse(req.ID, importid.Format{importid.NameOrURN("catalog", "catalog", uuid.Catalog)})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
 	r.adminOrg, d = adminorg.Init(r.client)
 	resp.Diagnostics.Append(d...)
@@ -234,7 +240,7 @@ func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateR
 		return
 	}
 
-	catalog, err := r.adminOrg.GetCatalogByNameOrId(req.ID, true)
+	catalog, err := r.adminOrg.GetCatalogByNameOrId(id.Get("catalog").String(), true)
 	if err != nil {
 		resp.Diagnostics.AddError("error when retrieving catalog", err.Error())
 		return
@@ -245,6 +251,13 @@ func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("catalog_name"), catalog.Catalog.Name)...)
 }
 
+// MoveState moves the state of the vcd_catalog_access_control resource of the vcd provider to the resource.
+func (r *aclResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_catalog_access_control", nil),
+	}
+}
+
 // * Custom Funcs
 
 // read the ACL from the API.
@@ -78,7 +78,7 @@ func (d *catalogDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *catalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_catalog", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_catalog", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &catalogDataSourceModel{}
 
@@ -93,6 +93,12 @@ func (d *catalogDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	vmware, err := d.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	catalog, err := d.GetCatalog()
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to find catalog", err.Error())
@@ -122,7 +128,7 @@ func (d *catalogDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	)
 
 	filter := fmt.Sprintf("catalog==%s", url.QueryEscape(catalog.AdminCatalog.HREF))
-	mediaResults, err := d.client.Vmware.QueryWithNotEncodedParams(nil, map[string]string{"type": "media", "filter": filter, "filterEncoded": "true"})
+	mediaResults, err := vmware.QueryWithNotEncodedParams(nil, map[string]string{"type": "media", "filter": filter, "filterEncoded": "true"})
 	if err != nil {
 		resp.Diagnostics.AddWarning(
 			"Unable to get media records",
@@ -17,6 +17,9 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -24,6 +27,7 @@ var (
 	_ resource.Resource                = &catalogResource{}
 	_ resource.ResourceWithConfigure   = &catalogResource{}
 	_ resource.ResourceWithImportState = &catalogResource{}
+	_ resource.ResourceWithMoveState   = &catalogResource{}
 	_ catalog                          = &catalogResource{}
 )
 
@@ -82,7 +86,7 @@ func (r *catalogResource) Configure(ctx context.Context, req resource.ConfigureR
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *catalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -131,7 +135,7 @@ func (r *catalogResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *catalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &catalogResourceModel{}
 
@@ -173,7 +177,7 @@ func (r *catalogResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *catalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var plan, state *catalogResourceModel
 
@@ -189,13 +193,19 @@ func (r *catalogResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	adminCatalog, err := r.GetCatalog()
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving Catalog", err.Error())
 		return
 	}
 
-	newAdminCatalog := govcd.NewAdminCatalogWithParent(&r.client.Vmware.Client, r.adminOrg)
+	newAdminCatalog := govcd.NewAdminCatalogWithParent(&vmware.Client, r.adminOrg)
 	newAdminCatalog.AdminCatalog.ID = adminCatalog.AdminCatalog.ID
 	newAdminCatalog.AdminCatalog.HREF = adminCatalog.AdminCatalog.HREF
 	newAdminCatalog.AdminCatalog.Name = plan.Name.ValueString()
@@ -241,7 +251,7 @@ func (r *catalogResource) Update(ctx context.Context, req resource.UpdateRequest
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *catalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &catalogResourceModel{}
 
@@ -275,8 +285,23 @@ func (r *catalogResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *catalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Import)()
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	defer metrics.New("cloudavenue_catalog", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.NameOrURN("catalog", "catalog", uuid.Catalog)})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// The catalog is retrieved by name or ID and its name is refreshed by the read.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("catalog").String())...)
+}
+
+// MoveState moves the state of the vcd_catalog resource of the vcd provider to the resource.
+func (r *catalogResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_catalog", nil),
+	}
 }
 
 // createCatalogStorageProfile creates a storage profile reference.
@@ -70,7 +70,7 @@ func (d *vAppTemplateDataSource) Schema(ctx context.Context, _ datasource.Schema
 }
 
 func (d *vAppTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_catalog_vapp_template", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_catalog_vapp_template", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &VAPPTemplateModel{}
 
@@ -85,6 +85,12 @@ func (d *vAppTemplateDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	vmware, err := d.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	catalog, err := d.adminOrg.GetAdminCatalogByNameOrId(d.GetIDOrName(), true)
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving catalog", err.Error())
@@ -113,14 +119,14 @@ func (d *vAppTemplateDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 			stateUpdated.TemplateName.Set(vAppTemplate.Name)
 
-			vappTemplate, err := d.client.Vmware.GetVAppTemplateById(stateUpdated.TemplateID.Get())
+			vappTemplate, err := vmware.GetVAppTemplateById(stateUpdated.TemplateID.Get())
 			if err != nil {
 				resp.Diagnostics.AddError("Error retrieving vApp Template", err.Error())
 				return
 			}
 
 			// This checks that the vApp Template is synchronized in the catalog
-			if _, err = d.client.Vmware.QuerySynchronizedVAppTemplateById(stateUpdated.TemplateID.Get()); err != nil {
+			if _, err = vmware.QuerySynchronizedVAppTemplateById(stateUpdated.TemplateID.Get()); err != nil {
 				resp.Diagnostics.AddError("Error check vApp Template synchronization", err.Error())
 				return
 			}
@@ -70,7 +70,7 @@ func (d *catalogsDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *catalogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_catalogs", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_catalogs", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &catalogsDataSourceModel{}
 	resp.Diagnostics.Append(d.Init(ctx, state)...)
@@ -78,6 +78,12 @@ func (d *catalogsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	vmware, err := d.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	catalogs := make(map[string]catalogDataSourceModel)
 	catalogsName := make([]string, 0)
 
@@ -129,7 +135,7 @@ func (d *catalogsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			)
 
 			filter := fmt.Sprintf("catalog==%s", url.QueryEscape(catalog.AdminCatalog.HREF))
-			mediaResults, err := d.client.Vmware.QueryWithNotEncodedParams(nil, map[string]string{"type": "media", "filter": filter, "filterEncoded": "true"})
+			mediaResults, err := vmware.QueryWithNotEncodedParams(nil, map[string]string{"type": "media", "filter": filter, "filterEncoded": "true"})
 			if err != nil {
 				resp.Diagnostics.AddWarning(
 					"Unable to get media records",
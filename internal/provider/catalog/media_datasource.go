@@ -73,7 +73,7 @@ func (d *catalogMediaDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *catalogMediaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_catalog_media", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_catalog_media", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &catalogMediaDataSourceModel{}
 
@@ -73,7 +73,7 @@ func (d *catalogMediasDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *catalogMediasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_catalog_medias", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_catalog_medias", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &catalogMediasDataSourceModel{}
 
@@ -0,0 +1,5 @@
+package certificate
+
+const (
+	categoryName = "certificate"
+)
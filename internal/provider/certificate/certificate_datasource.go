@@ -0,0 +1,115 @@
+// Package certificate provides a Terraform datasource.
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+)
+
+var (
+	_ datasource.DataSource              = &certificateDataSource{}
+	_ datasource.DataSourceWithConfigure = &certificateDataSource{}
+)
+
+func NewCertificateDataSource() datasource.DataSource {
+	return &certificateDataSource{}
+}
+
+type certificateDataSource struct {
+	client   *client.CloudAvenue
+	adminOrg adminorg.AdminOrg
+}
+
+// Init Initializes the data source.
+func (d *certificateDataSource) Init(ctx context.Context, dm *CertificateDataSourceModel) (diags diag.Diagnostics) {
+	d.adminOrg, diags = adminorg.Init(d.client)
+	return
+}
+
+func (d *certificateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName
+}
+
+func (d *certificateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = certificateSchema(ctx).GetDataSource(ctx)
+}
+
+func (d *certificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *certificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer metrics.New("data.cloudavenue_certificate", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	config := &CertificateDataSourceModel{}
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the data source
+	resp.Diagnostics.Append(d.Init(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the data source read logic here.
+	*/
+
+	s := &certificateResource{
+		client:   d.client,
+		adminOrg: d.adminOrg,
+	}
+
+	// The certificate is looked up by alias.
+	data, found, diags := s.read(ctx, &CertificateModel{
+		Alias:                   config.Alias,
+		Certificate:             supertypes.NewStringNull(),
+		Description:             supertypes.NewStringNull(),
+		ID:                      supertypes.NewStringNull(),
+		Issuer:                  supertypes.NewStringNull(),
+		NotAfter:                supertypes.NewStringNull(),
+		NotBefore:               supertypes.NewStringNull(),
+		PrivateKey:              supertypes.NewStringNull(),
+		PrivateKeyPassphrase:    supertypes.NewStringNull(),
+		Subject:                 supertypes.NewStringNull(),
+		SubjectAlternativeNames: supertypes.NewSetNull(supertypes.StringType{}),
+	})
+	if !found {
+		resp.Diagnostics.AddError("Certificate not found", fmt.Sprintf("The certificate %s was not found in the certificate library", config.Alias.Get()))
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, data.ToDataSource())...)
+}
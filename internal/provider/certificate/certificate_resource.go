@@ -0,0 +1,292 @@
+// Package certificate provides a Terraform resource.
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &certificateResource{}
+	_ resource.ResourceWithConfigure   = &certificateResource{}
+	_ resource.ResourceWithImportState = &certificateResource{}
+	_ resource.ResourceWithMoveState   = &certificateResource{}
+)
+
+// NewCertificateResource is a helper function to simplify the provider implementation.
+func NewCertificateResource() resource.Resource {
+	return &certificateResource{}
+}
+
+// certificateResource is the resource implementation.
+type certificateResource struct {
+	client   *client.CloudAvenue
+	adminOrg adminorg.AdminOrg
+}
+
+// Init Initializes the resource.
+func (r *certificateResource) Init(ctx context.Context, rm *CertificateModel) (diags diag.Diagnostics) {
+	r.adminOrg, diags = adminorg.Init(r.client)
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *certificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName
+}
+
+// Schema defines the schema for the resource.
+func (r *certificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = certificateSchema(ctx).GetResource(ctx)
+}
+
+func (r *certificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *certificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer metrics.New("cloudavenue_certificate", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
+
+	plan := NewCertificate(req.Plan)
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource creation logic here.
+	*/
+
+	// Check the certificate before sending it to the API to return a clear diagnostic.
+	if _, err := parseCertificate(plan.Certificate.Get()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate"), "Invalid certificate", err.Error())
+		return
+	}
+
+	createdCertificate, err := r.adminOrg.AddCertificateToLibrary(plan.ToCertificateLibraryItem())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating certificate", err.Error())
+		return
+	}
+	plan.ID.Set(createdCertificate.CertificateLibrary.Id)
+
+	state, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer metrics.New("cloudavenue_certificate", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	state := NewCertificate(req.State)
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refresh the state
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *certificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer metrics.New("cloudavenue_certificate", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
+
+	var (
+		plan  = NewCertificate(req.Plan)
+		state = NewCertificate(req.State)
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource update here
+	*/
+
+	// Only the alias and the description can be updated, the other attributes require a replacement.
+	certificate, err := r.adminOrg.GetCertificateFromLibraryById(state.ID.Get())
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving certificate", err.Error())
+		return
+	}
+
+	certificate.CertificateLibrary.Alias = plan.Alias.Get()
+	certificate.CertificateLibrary.Description = plan.Description.Get()
+
+	if _, err := certificate.Update(); err != nil {
+		resp.Diagnostics.AddError("Error updating certificate", err.Error())
+		return
+	}
+
+	stateRefreshed, _, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer metrics.New("cloudavenue_certificate", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
+
+	state := NewCertificate(req.State)
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource deletion here
+	*/
+
+	certificate, err := r.adminOrg.GetCertificateFromLibraryById(state.ID.Get())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error retrieving certificate", err.Error())
+		return
+	}
+
+	if err := certificate.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error deleting certificate", err.Error())
+		return
+	}
+}
+
+func (r *certificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_certificate", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.NameOrURN("certificate", "certificate", uuid.Certificate)})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// The certificate is retrieved by ID or alias and the other attributes are set by the read.
+	if certificate := id.Get("certificate"); certificate.IsID() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), certificate.ID())...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alias"), certificate.Name())...)
+	}
+}
+
+// MoveState moves the state of the vcd_library_certificate resource of the vcd provider to the resource.
+func (r *certificateResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_library_certificate", nil),
+	}
+}
+
+// * CustomFuncs
+
+// read is a generic read function that can be used by the resource Create, Read and Update functions.
+func (r *certificateResource) read(ctx context.Context, planOrState *CertificateModel) (stateRefreshed *CertificateModel, found bool, diags diag.Diagnostics) {
+	stateRefreshed = planOrState.Copy()
+
+	var (
+		certificate *govcd.Certificate
+		err         error
+	)
+
+	if planOrState.ID.IsKnown() {
+		certificate, err = r.adminOrg.GetCertificateFromLibraryById(planOrState.ID.Get())
+	} else {
+		certificate, err = r.adminOrg.GetCertificateFromLibraryByName(planOrState.Alias.Get())
+	}
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving certificate", err.Error())
+		return nil, true, diags
+	}
+
+	diags.Append(stateRefreshed.FromCertificateLibraryItem(ctx, certificate.CertificateLibrary)...)
+
+	return stateRefreshed, true, diags
+}
@@ -0,0 +1,199 @@
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+var ErrNoPEMCertificate = errors.New("no PEM encoded certificate found")
+
+type CertificateModel struct {
+	Alias                   supertypes.StringValue `tfsdk:"alias"`
+	Certificate             supertypes.StringValue `tfsdk:"certificate"`
+	Description             supertypes.StringValue `tfsdk:"description"`
+	ID                      supertypes.StringValue `tfsdk:"id"`
+	Issuer                  supertypes.StringValue `tfsdk:"issuer"`
+	NotAfter                supertypes.StringValue `tfsdk:"not_after"`
+	NotBefore               supertypes.StringValue `tfsdk:"not_before"`
+	PrivateKey              supertypes.StringValue `tfsdk:"private_key"`
+	PrivateKeyPassphrase    supertypes.StringValue `tfsdk:"private_key_passphrase"`
+	Subject                 supertypes.StringValue `tfsdk:"subject"`
+	SubjectAlternativeNames supertypes.SetValue    `tfsdk:"subject_alternative_names"`
+}
+
+type CertificateDataSourceModel struct {
+	Alias                   supertypes.StringValue `tfsdk:"alias"`
+	Certificate             supertypes.StringValue `tfsdk:"certificate"`
+	Description             supertypes.StringValue `tfsdk:"description"`
+	ID                      supertypes.StringValue `tfsdk:"id"`
+	Issuer                  supertypes.StringValue `tfsdk:"issuer"`
+	NotAfter                supertypes.StringValue `tfsdk:"not_after"`
+	NotBefore               supertypes.StringValue `tfsdk:"not_before"`
+	Subject                 supertypes.StringValue `tfsdk:"subject"`
+	SubjectAlternativeNames supertypes.SetValue    `tfsdk:"subject_alternative_names"`
+}
+
+func NewCertificate(t any) *CertificateModel {
+	switch x := t.(type) {
+	case tfsdk.State:
+		return &CertificateModel{
+			Alias:                   supertypes.NewStringNull(),
+			Certificate:             supertypes.NewStringNull(),
+			Description:             supertypes.NewStringNull(),
+			ID:                      supertypes.NewStringUnknown(),
+			Issuer:                  supertypes.NewStringUnknown(),
+			NotAfter:                supertypes.NewStringUnknown(),
+			NotBefore:               supertypes.NewStringUnknown(),
+			PrivateKey:              supertypes.NewStringNull(),
+			PrivateKeyPassphrase:    supertypes.NewStringNull(),
+			Subject:                 supertypes.NewStringUnknown(),
+			SubjectAlternativeNames: supertypes.NewSetUnknown(x.Schema.GetAttributes()["subject_alternative_names"].GetType().(supertypes.SetType).ElementType()),
+		}
+
+	case tfsdk.Plan:
+		return &CertificateModel{
+			Alias:                   supertypes.NewStringNull(),
+			Certificate:             supertypes.NewStringNull(),
+			Description:             supertypes.NewStringNull(),
+			ID:                      supertypes.NewStringUnknown(),
+			Issuer:                  supertypes.NewStringUnknown(),
+			NotAfter:                supertypes.NewStringUnknown(),
+			NotBefore:               supertypes.NewStringUnknown(),
+			PrivateKey:              supertypes.NewStringNull(),
+			PrivateKeyPassphrase:    supertypes.NewStringNull(),
+			Subject:                 supertypes.NewStringUnknown(),
+			SubjectAlternativeNames: supertypes.NewSetUnknown(x.Schema.GetAttributes()["subject_alternative_names"].GetType().(supertypes.SetType).ElementType()),
+		}
+
+	case tfsdk.Config:
+		return &CertificateModel{
+			Alias:                   supertypes.NewStringNull(),
+			Certificate:             supertypes.NewStringNull(),
+			Description:             supertypes.NewStringNull(),
+			ID:                      supertypes.NewStringUnknown(),
+			Issuer:                  supertypes.NewStringUnknown(),
+			NotAfter:                supertypes.NewStringUnknown(),
+			NotBefore:               supertypes.NewStringUnknown(),
+			PrivateKey:              supertypes.NewStringNull(),
+			PrivateKeyPassphrase:    supertypes.NewStringNull(),
+			Subject:                 supertypes.NewStringUnknown(),
+			SubjectAlternativeNames: supertypes.NewSetUnknown(x.Schema.GetAttributes()["subject_alternative_names"].GetType().(supertypes.SetType).ElementType()),
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (rm *CertificateModel) Copy() *CertificateModel {
+	x := &CertificateModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// GetSubjectAlternativeNames returns the value of the SubjectAlternativeNames field.
+func (rm *CertificateModel) GetSubjectAlternativeNames(ctx context.Context) (values []string, diags diag.Diagnostics) {
+	values = make([]string, 0)
+	d := rm.SubjectAlternativeNames.Get(ctx, &values, false)
+	return values, d
+}
+
+// * CustomFuncs
+
+// ToCertificateLibraryItem returns the certificate library item representation of the model.
+func (rm *CertificateModel) ToCertificateLibraryItem() *govcdtypes.CertificateLibraryItem {
+	return &govcdtypes.CertificateLibraryItem{
+		Id:                   rm.ID.Get(),
+		Alias:                rm.Alias.Get(),
+		Description:          rm.Description.Get(),
+		Certificate:          rm.Certificate.Get(),
+		PrivateKey:           rm.PrivateKey.Get(),
+		PrivateKeyPassphrase: rm.PrivateKeyPassphrase.Get(),
+	}
+}
+
+// FromCertificateLibraryItem sets the model from the certificate library item representation.
+// The private key and its passphrase are never returned by the API and are kept unchanged.
+func (rm *CertificateModel) FromCertificateLibraryItem(ctx context.Context, item *govcdtypes.CertificateLibraryItem) (diags diag.Diagnostics) {
+	rm.ID.Set(item.Id)
+	rm.Alias.Set(item.Alias)
+	rm.Description = utils.SuperStringValueOrNull(item.Description)
+
+	cert, err := parseCertificate(item.Certificate)
+	if err != nil {
+		diags.AddError("Error parsing the certificate", err.Error())
+		return
+	}
+
+	// The API can return the certificate with another formatting,
+	// the configured value is kept if it is the same certificate.
+	if known, err := parseCertificate(rm.Certificate.Get()); err != nil || !bytes.Equal(known.Raw, cert.Raw) {
+		rm.Certificate.Set(item.Certificate)
+	}
+
+	rm.Subject.Set(cert.Subject.String())
+	rm.Issuer.Set(cert.Issuer.String())
+	rm.NotBefore.Set(cert.NotBefore.UTC().Format(time.RFC3339))
+	rm.NotAfter.Set(cert.NotAfter.UTC().Format(time.RFC3339))
+
+	return rm.SubjectAlternativeNames.Set(ctx, subjectAlternativeNames(cert))
+}
+
+// ToDataSource returns the data source representation of the model.
+func (rm *CertificateModel) ToDataSource() *CertificateDataSourceModel {
+	return &CertificateDataSourceModel{
+		Alias:                   rm.Alias,
+		Certificate:             rm.Certificate,
+		Description:             rm.Description,
+		ID:                      rm.ID,
+		Issuer:                  rm.Issuer,
+		NotAfter:                rm.NotAfter,
+		NotBefore:               rm.NotBefore,
+		Subject:                 rm.Subject,
+		SubjectAlternativeNames: rm.SubjectAlternativeNames,
+	}
+}
+
+// parseCertificate parses the first certificate of the PEM encoded chain.
+func parseCertificate(s string) (*x509.Certificate, error) {
+	rest := []byte(s)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, ErrNoPEMCertificate
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// subjectAlternativeNames returns the DNS names, IP addresses, email addresses and URIs of the certificate.
+func subjectAlternativeNames(cert *x509.Certificate) []string {
+	names := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses)+len(cert.EmailAddresses)+len(cert.URIs))
+	names = append(names, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	names = append(names, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+
+	return names
+}
@@ -0,0 +1,99 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+)
+
+func newTestCertificate(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating the key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		Subject:        pkix.Name{CommonName: "example.com"},
+		NotBefore:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		DNSNames:       []string{"example.com", "www.example.com"},
+		IPAddresses:    []net.IP{net.ParseIP("192.168.0.1")},
+		EmailAddresses: []string{"admin@example.com"},
+		URIs:           []*url.URL{{Scheme: "https", Host: "example.com"}},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating the certificate: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestParseCertificate(t *testing.T) {
+	t.Parallel()
+
+	certificate := newTestCertificate(t)
+
+	tests := []struct {
+		name          string
+		pem           string
+		expectedError bool
+	}{
+		{name: "Certificate", pem: certificate},
+		{name: "Chain", pem: certificate + certificate},
+		{name: "AfterPrivateKey", pem: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("key")})) + certificate},
+		{name: "Empty", pem: "", expectedError: true},
+		{name: "NotPEM", pem: "not a certificate", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cert, err := parseCertificate(tt.pem)
+			if tt.expectedError {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if cert.Subject.CommonName != "example.com" {
+				t.Fatalf("expected the subject example.com, got %s", cert.Subject.CommonName)
+			}
+			if got := cert.NotAfter.UTC().Format(time.RFC3339); got != "2025-01-01T00:00:00Z" {
+				t.Fatalf("expected the expiration date 2025-01-01T00:00:00Z, got %s", got)
+			}
+		})
+	}
+}
+
+func TestSubjectAlternativeNames(t *testing.T) {
+	t.Parallel()
+
+	cert, err := parseCertificate(newTestCertificate(t))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := []string{"example.com", "www.example.com", "192.168.0.1", "admin@example.com", "https://example.com"}
+	if got := subjectAlternativeNames(cert); !slices.Equal(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,107 @@
+package edgegw
+
+import (
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+// endpointDNSForwarder is the OpenAPI endpoint of the DNS forwarder of an Edge Gateway.
+// It is not available in the version of go-vcloud-director used by the provider.
+const endpointDNSForwarder = govcdtypes.OpenApiPathVersion1_0_0 + "edgeGateways/%s/dns"
+
+// DNSForwarder is the DNS forwarder configuration of an NSX-T Edge Gateway.
+type DNSForwarder struct {
+	// Enabled defines whether the DNS forwarder is enabled.
+	Enabled bool `json:"enabled"`
+	// ListenerIP is the IP address on which the DNS forwarder listens.
+	// If it is not set, the IP address is allocated by the API.
+	ListenerIP string `json:"listenerIp,omitempty"`
+	// DefaultForwarderZone is the zone used for the domain names without a conditional zone.
+	DefaultForwarderZone *DNSForwarderZone `json:"defaultForwarderZone,omitempty"`
+	// ConditionalForwarderZones are the zones used for specific domain names.
+	ConditionalForwarderZones []*DNSForwarderZone `json:"conditionalForwarderZones,omitempty"`
+	// Version must be set to the current version of the configuration on update.
+	Version *govcdtypes.VersionField `json:"version,omitempty"`
+}
+
+// DNSForwarderZone is a forwarder zone of the DNS forwarder.
+type DNSForwarderZone struct {
+	ID              string   `json:"id,omitempty"`
+	DisplayName     string   `json:"displayName"`
+	DNSDomainNames  []string `json:"dnsDomainNames,omitempty"`
+	UpstreamServers []string `json:"upstreamServers,omitempty"`
+}
+
+// GetDNSForwarder returns the DNS forwarder configuration of the Edge Gateway.
+func (e EdgeGateway) GetDNSForwarder() (*DNSForwarder, error) {
+	if e.EdgeGateway == nil || e.GetID() == "" {
+		return nil, fmt.Errorf("cannot get DNS forwarder for NSX-T Edge Gateway without ID")
+	}
+
+	vmware, err := e.Client.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
+	urlRef, err := vmware.Client.OpenApiBuildEndpoint(fmt.Sprintf(endpointDNSForwarder, e.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	dnsForwarder := &DNSForwarder{}
+	if err := vmware.Client.OpenApiGetItem(vmware.Client.APIVersion, urlRef, nil, dnsForwarder, nil); err != nil {
+		return nil, err
+	}
+
+	return dnsForwarder, nil
+}
+
+// UpdateDNSForwarder updates the DNS forwarder configuration of the Edge Gateway.
+func (e EdgeGateway) UpdateDNSForwarder(dnsForwarderConfig *DNSForwarder) (*DNSForwarder, error) {
+	if e.EdgeGateway == nil || e.GetID() == "" {
+		return nil, fmt.Errorf("cannot update DNS forwarder for NSX-T Edge Gateway without ID")
+	}
+
+	vmware, err := e.Client.Vmware()
+	if err != nil {
+		return nil, err
+	}
+
+	urlRef, err := vmware.Client.OpenApiBuildEndpoint(fmt.Sprintf(endpointDNSForwarder, e.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	// The version of the current configuration is required to update it.
+	updatedDNSForwarder, err := e.GetDNSForwarder()
+	if err != nil {
+		return nil, err
+	}
+	dnsForwarderConfig.Version = updatedDNSForwarder.Version
+
+	if err := vmware.Client.OpenApiPutItem(vmware.Client.APIVersion, urlRef, nil, dnsForwarderConfig, updatedDNSForwarder, nil); err != nil {
+		return nil, err
+	}
+
+	return updatedDNSForwarder, nil
+}
+
+// DeleteDNSForwarder resets the DNS forwarder configuration of the Edge Gateway.
+func (e EdgeGateway) DeleteDNSForwarder() error {
+	if e.EdgeGateway == nil || e.GetID() == "" {
+		return fmt.Errorf("cannot delete DNS forwarder for NSX-T Edge Gateway without ID")
+	}
+
+	vmware, err := e.Client.Vmware()
+	if err != nil {
+		return err
+	}
+
+	urlRef, err := vmware.Client.OpenApiBuildEndpoint(fmt.Sprintf(endpointDNSForwarder, e.GetID()))
+	if err != nil {
+		return err
+	}
+
+	return vmware.Client.OpenApiDeleteItem(vmware.Client.APIVersion, urlRef, nil, nil)
+}
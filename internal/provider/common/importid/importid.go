@@ -0,0 +1,238 @@
+// Package importid parses the import IDs of the resources.
+//
+// An import ID is made of parts joined with a dot, from the parent levels to the
+// imported object (edge_gateway.nat_rule, vdc.vapp.vm...). Each parent level accepts
+// the name or the URN of the object.
+package importid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// Separator separates the parts of an import ID.
+const Separator = "."
+
+// Part is a part of an import ID.
+type Part struct {
+	// Name is the name of the part in the formats (vdc, edge_gateway...).
+	Name string
+	// Description describes the object identified by the part in the diagnostics.
+	Description string
+	// URNs are the types of the URNs accepted by the part.
+	URNs []uuid.VcloudUUID
+	// UUID accepts the IDs which are not URNs (the IDs of the NAT rules, of the static routes...).
+	UUID bool
+	// IDOnly rejects the names.
+	IDOnly bool
+	// Help replaces the description of the accepted values in the diagnostics.
+	Help string
+	// Dotted accepts the separator in the value (IP addresses, bucket names...).
+	// Only the last part of a format can be dotted.
+	Dotted bool
+}
+
+// The parent levels of the import IDs.
+var (
+	VDC           = NameOrURN("vdc", "VDC", uuid.VDC)
+	VDCOrVDCGroup = NameOrURN("vdc", "VDC or VDC group", uuid.VDC, uuid.VDCGroup)
+	VApp          = NameOrURN("vapp", "vApp", uuid.VAPP)
+	EdgeGateway   = NameOrURN("edge_gateway", "edge gateway", uuid.Gateway)
+)
+
+// Name returns a part accepting only a name.
+func Name(name, description string) Part {
+	return Part{Name: name, Description: description}
+}
+
+// NameOrID returns a part accepting a name or an ID which is not a URN.
+func NameOrID(name, description string) Part {
+	return Part{Name: name, Description: description, UUID: true}
+}
+
+// NameOrURN returns a part accepting a name or a URN of the types.
+func NameOrURN(name, description string, urns ...uuid.VcloudUUID) Part {
+	return Part{Name: name, Description: description, URNs: urns}
+}
+
+// URN returns a part accepting only a URN of the types.
+func URN(name, description string, urns ...uuid.VcloudUUID) Part {
+	return Part{Name: name, Description: description, URNs: urns, IDOnly: true}
+}
+
+// DottedName returns a part accepting only a name which can contain the separator.
+func DottedName(name, description string) Part {
+	return Part{Name: name, Description: description, Dotted: true}
+}
+
+// parse returns the value of the part and true if the part accepts it.
+func (p Part) parse(s string) (Value, bool) {
+	switch {
+	case s == "":
+		return Value{}, false
+	case strings.HasPrefix(s, "urn:"):
+		for _, urn := range p.URNs {
+			if uuid.VcloudUUID(s).IsType(urn) {
+				return Value{value: s, id: true}, true
+			}
+		}
+		return Value{}, false
+	case uuid.IsUUIDV4(s) && (p.UUID || (p.IDOnly && len(p.URNs) > 0)):
+		// A UUID without prefix is accepted by the parts accepting only URNs and is normalized.
+		if len(p.URNs) > 0 {
+			return Value{value: uuid.Normalize(p.URNs[0], s).String(), id: true}, true
+		}
+		return Value{value: s, id: true}, true
+	case p.IDOnly:
+		return Value{}, false
+	default:
+		return Value{value: s}, true
+	}
+}
+
+// describe returns the description of the values accepted by the part.
+func (p Part) describe() string {
+	if p.Help != "" {
+		return p.Name + " is " + p.Help
+	}
+
+	accepted := make([]string, 0, len(p.URNs)+2)
+	if !p.IDOnly {
+		accepted = append(accepted, "name")
+	}
+	for _, urn := range p.URNs {
+		accepted = append(accepted, "URN ("+urn.String()+"<uuid>)")
+	}
+	if p.UUID {
+		accepted = append(accepted, "ID")
+	}
+
+	return fmt.Sprintf("%s is the %s of the %s", p.Name, strings.Join(accepted, " or "), p.Description)
+}
+
+// Format is an accepted format of an import ID.
+type Format []Part
+
+// String returns the format with the names of the parts (vdc.vapp.vm).
+func (f Format) String() string {
+	names := make([]string, len(f))
+	for i, p := range f {
+		names[i] = p.Name
+	}
+
+	return strings.Join(names, Separator)
+}
+
+// Value is the value of a part of an import ID.
+type Value struct {
+	value string
+	id    bool
+}
+
+// String returns the value as written in the import ID. The UUIDs of the parts
+// accepting only URNs are returned with their prefix.
+func (v Value) String() string {
+	return v.value
+}
+
+// IsID returns true if the value is a URN or an ID.
+func (v Value) IsID() bool {
+	return v.id
+}
+
+// ID returns the value if it is a URN or an ID, an empty string otherwise.
+func (v Value) ID() string {
+	if v.id {
+		return v.value
+	}
+
+	return ""
+}
+
+// Name returns the value if it is a name, an empty string otherwise.
+func (v Value) Name() string {
+	if v.id {
+		return ""
+	}
+
+	return v.value
+}
+
+// ID is a parsed import ID.
+type ID struct {
+	// Format is the format matched by the import ID.
+	Format Format
+	values map[string]Value
+}
+
+// Has returns true if the format of the import ID contains the part.
+func (id ID) Has(name string) bool {
+	_, ok := id.values[name]
+	return ok
+}
+
+// Get returns the value of the part. The value is empty if the format does not contain the part.
+func (id ID) Get(name string) Value {
+	return id.values[name]
+}
+
+// Parse parses the import ID with the first matching format.
+// The diagnostics list the accepted formats if the import ID matches none of them.
+func Parse(importID string, formats ...Format) (ID, diag.Diagnostics) {
+formats:
+	for _, format := range formats {
+		parts := strings.Split(importID, Separator)
+		if len(format) > 0 && format[len(format)-1].Dotted {
+			parts = strings.SplitN(importID, Separator, len(format))
+		}
+		if len(format) != len(parts) {
+			continue
+		}
+
+		id := ID{Format: format, values: make(map[string]Value, len(format))}
+		for i, p := range format {
+			v, ok := p.parse(parts[i])
+			if !ok {
+				continue formats
+			}
+			id.values[p.Name] = v
+		}
+
+		return id, nil
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Unexpected Import Identifier", help(importID, formats...))
+
+	return ID{}, diags
+}
+
+// help returns the description of the accepted formats.
+func help(importID string, formats ...Format) string {
+	var (
+		b         strings.Builder
+		described = map[string]bool{}
+	)
+
+	fmt.Fprintf(&b, "The import ID %q does not match any of the accepted formats:\n", importID)
+	for _, format := range formats {
+		fmt.Fprintf(&b, "  - %s\n", format)
+	}
+
+	b.WriteString("where:\n")
+	for _, format := range formats {
+		for _, p := range format {
+			if described[p.Name] {
+				continue
+			}
+			described[p.Name] = true
+			fmt.Fprintf(&b, "  - %s\n", p.describe())
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
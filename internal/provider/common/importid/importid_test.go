@@ -0,0 +1,195 @@
+package importid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testUUID = "12345678-1234-1234-1234-123456789012"
+
+var (
+	testNATRule = NameOrID("nat_rule", "NAT rule")
+	testVM      = URN("vm", "VM", uuid.VM)
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		importID string
+		formats  []Format
+		format   string
+		values   map[string]string
+		ids      map[string]bool
+	}{
+		{
+			name:     "Names",
+			importID: "my-edge.my-rule",
+			formats:  []Format{{EdgeGateway, testNATRule}},
+			format:   "edge_gateway.nat_rule",
+			values:   map[string]string{"edge_gateway": "my-edge", "nat_rule": "my-rule"},
+		},
+		{
+			name:     "URNAndID",
+			importID: uuid.Gateway.String() + testUUID + "." + testUUID,
+			formats:  []Format{{EdgeGateway, testNATRule}},
+			format:   "edge_gateway.nat_rule",
+			values:   map[string]string{"edge_gateway": uuid.Gateway.String() + testUUID, "nat_rule": testUUID},
+			ids:      map[string]bool{"edge_gateway": true, "nat_rule": true},
+		},
+		{
+			name:     "OptionalParent",
+			importID: "my-vapp." + uuid.VM.String() + testUUID,
+			formats:  []Format{{VDC, VApp, testVM}, {VApp, testVM}},
+			format:   "vapp.vm",
+			values:   map[string]string{"vapp": "my-vapp", "vm": uuid.VM.String() + testUUID},
+			ids:      map[string]bool{"vm": true},
+		},
+		{
+			name:     "UUIDNormalized",
+			importID: uuid.VDC.String() + testUUID + ".my-vapp." + testUUID,
+			formats:  []Format{{VDC, VApp, testVM}, {VApp, testVM}},
+			format:   "vdc.vapp.vm",
+			values:   map[string]string{"vdc": uuid.VDC.String() + testUUID, "vapp": "my-vapp", "vm": uuid.VM.String() + testUUID},
+			ids:      map[string]bool{"vdc": true, "vm": true},
+		},
+		{
+			name:     "VDCGroup",
+			importID: uuid.VDCGroup.String() + testUUID + ".my-network",
+			formats:  []Format{{VDCOrVDCGroup, Name("network", "network")}},
+			format:   "vdc.network",
+			values:   map[string]string{"vdc": uuid.VDCGroup.String() + testUUID, "network": "my-network"},
+			ids:      map[string]bool{"vdc": true},
+		},
+		{
+			name:     "NextFormat",
+			importID: "my-vdc.my-vapp",
+			formats:  []Format{{VApp, testVM}, {VDC, VApp}},
+			format:   "vdc.vapp",
+			values:   map[string]string{"vdc": "my-vdc", "vapp": "my-vapp"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, diags := Parse(tt.importID, tt.formats...)
+			if diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+			if id.Format.String() != tt.format {
+				t.Fatalf("expected format %s, got %s", tt.format, id.Format)
+			}
+			for name, value := range tt.values {
+				if !id.Has(name) {
+					t.Fatalf("expected part %s", name)
+				}
+				v := id.Get(name)
+				if v.String() != value {
+					t.Fatalf("expected %s to be %q, got %q", name, value, v)
+				}
+				if v.IsID() != tt.ids[name] {
+					t.Fatalf("expected %s IsID to be %t, got %t", name, tt.ids[name], v.IsID())
+				}
+				if tt.ids[name] && (v.ID() != value || v.Name() != "") || !tt.ids[name] && (v.Name() != value || v.ID() != "") {
+					t.Fatalf("expected %s to be returned by ID or Name only, got ID %q and Name %q", name, v.ID(), v.Name())
+				}
+			}
+			if id.Has("unknown") || id.Get("unknown").String() != "" {
+				t.Fatalf("expected no unknown part")
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	formats := []Format{{VDC, VApp, testVM}, {VApp, testVM}}
+
+	tests := []struct {
+		name     string
+		importID string
+	}{
+		{name: "TooManyParts", importID: "a.b.c.d"},
+		{name: "TooFewParts", importID: "my-vapp"},
+		{name: "EmptyPart", importID: "my-vdc..my-vm"},
+		{name: "NameInsteadOfURN", importID: "my-vapp.my-vm"},
+		{name: "WrongURNType", importID: uuid.VDC.String() + testUUID + ".my-vapp." + uuid.VAPP.String() + testUUID},
+		{name: "WrongParentURNType", importID: uuid.Gateway.String() + testUUID + ".my-vapp." + testUUID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, diags := Parse(tt.importID, formats...)
+			if !diags.HasError() {
+				t.Fatalf("expected an error, got none")
+			}
+
+			detail := diags[0].Detail()
+			for _, want := range []string{
+				tt.importID,
+				"  - vdc.vapp.vm\n",
+				"  - vapp.vm\n",
+				"  - vdc is the name or URN (urn:vcloud:vdc:<uuid>) of the VDC\n",
+				"  - vapp is the name or URN (urn:vcloud:vapp:<uuid>) of the vApp\n",
+				"  - vm is the URN (urn:vcloud:vm:<uuid>) of the VM",
+			} {
+				if !strings.Contains(detail, want) {
+					t.Fatalf("expected the detail to contain %q, got %q", want, detail)
+				}
+			}
+			if strings.Count(detail, "vdc is") != 1 {
+				t.Fatalf("expected the parts to be described once, got %q", detail)
+			}
+		})
+	}
+}
+
+func TestPartDescribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		part Part
+		want string
+	}{
+		{part: Name("bucket", "bucket"), want: "bucket is the name of the bucket"},
+		{part: testNATRule, want: "nat_rule is the name or ID of the NAT rule"},
+		{part: VDCOrVDCGroup, want: "vdc is the name or URN (urn:vcloud:vdc:<uuid>) or URN (urn:vcloud:vdcGroup:<uuid>) of the VDC or VDC group"},
+		{part: Part{Name: "disk", Help: "the ID of the disk"}, want: "disk is the ID of the disk"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.part.describe(); got != tt.want {
+			t.Fatalf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestParseDotted(t *testing.T) {
+	t.Parallel()
+
+	formats := []Format{{VDC, DottedName("bucket", "bucket")}, {DottedName("bucket", "bucket")}}
+
+	id, diags := Parse("my-vdc.my.bucket", formats...)
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+	if id.Format.String() != "vdc.bucket" || id.Get("vdc").Name() != "my-vdc" || id.Get("bucket").Name() != "my.bucket" {
+		t.Fatalf("expected vdc my-vdc and bucket my.bucket, got %s %q %q", id.Format, id.Get("vdc"), id.Get("bucket"))
+	}
+
+	id, diags = Parse("192.168.1.1", Format{DottedName("ip_address", "IP address")})
+	if diags.HasError() {
+		t.Fatalf("expected no error, got %v", diags)
+	}
+	if id.Get("ip_address").Name() != "192.168.1.1" {
+		t.Fatalf("expected ip_address 192.168.1.1, got %q", id.Get("ip_address"))
+	}
+}
@@ -0,0 +1,116 @@
+// Package movestate moves the state of the resources of the vmware/vcd provider
+// to their Cloud Avenue equivalents.
+//
+// A `moved` block (Terraform 1.8 and later) whose `from` is a vcd resource calls the
+// state movers of the Cloud Avenue resource. The attributes of the vcd state with the same
+// name and type in the Cloud Avenue resource are copied, the others are null and are set by
+// the refresh following the move. The resource is neither destroyed nor recreated.
+package movestate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
+)
+
+// VCDProviderAddress is the address of the vmware/vcd provider without its hostname.
+const VCDProviderAddress = "vmware/vcd"
+
+// FromVCD returns the state mover of the vcd resource sourceType.
+// attributes maps the vcd attributes renamed in the Cloud Avenue resource,
+// the other attributes keep their name.
+func FromVCD(sourceType string, attributes map[string]string) resource.StateMover {
+	return fromVCD(sourceType, attributes, nil)
+}
+
+// FromVCDWithStep returns the state mover of the vcd resource sourceType for the resources
+// whose layout differs from the vcd one: step moves the attributes of the vcd state to their path.
+func FromVCDWithStep(sourceType string, step stateupgrade.Step) resource.StateMover {
+	return fromVCD(sourceType, nil, step)
+}
+
+func fromVCD(sourceType string, attributes map[string]string, step stateupgrade.Step) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			// The hostname of the provider address is ignored (registry.terraform.io or registry.opentofu.org).
+			if req.SourceTypeName != sourceType || !strings.HasSuffix(req.SourceProviderAddress, "/"+VCDProviderAddress) {
+				return
+			}
+
+			if req.SourceRawState == nil || req.SourceRawState.JSON == nil {
+				resp.Diagnostics.AddError("Unable to move the resource state", fmt.Sprintf("The state of %s is empty.", sourceType))
+				return
+			}
+
+			raw := req.SourceRawState.JSON
+			if step != nil {
+				// The numbers are kept as json.Number to not lose the precision of the large integers.
+				state := stateupgrade.State{}
+				decoder := json.NewDecoder(bytes.NewReader(raw))
+				decoder.UseNumber()
+				if err := decoder.Decode(&state); err != nil {
+					resp.Diagnostics.AddError("Unable to move the resource state", fmt.Sprintf("Error decoding the state of %s: %s", sourceType, err))
+					return
+				}
+
+				if err := step(state); err != nil {
+					resp.Diagnostics.AddError("Unable to move the resource state", fmt.Sprintf("Error moving the attributes of %s: %s", sourceType, err))
+					return
+				}
+
+				var err error
+				if raw, err = json.Marshal(state); err != nil {
+					resp.Diagnostics.AddError("Unable to move the resource state", fmt.Sprintf("Error encoding the state of %s: %s", sourceType, err))
+					return
+				}
+			}
+
+			source := map[string]json.RawMessage{}
+			if err := json.Unmarshal(raw, &source); err != nil {
+				resp.Diagnostics.AddError("Unable to move the resource state", fmt.Sprintf("Error decoding the state of %s: %s", sourceType, err))
+				return
+			}
+
+			// The target attributes are indexed by their vcd name.
+			names := make(map[string]string, len(attributes))
+			for from, to := range attributes {
+				names[to] = from
+			}
+
+			targetType, ok := resp.TargetState.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				resp.Diagnostics.AddError("Unable to move the resource state", "The schema of the resource is not an object.")
+				return
+			}
+
+			values := make(map[string]tftypes.Value, len(targetType.AttributeTypes))
+			for name, attributeType := range targetType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+
+				sourceName := name
+				if n, ok := names[name]; ok {
+					sourceName = n
+				}
+
+				raw, ok := source[sourceName]
+				if !ok {
+					continue
+				}
+
+				// The attributes with a different type in the vcd resource are left null.
+				if value, err := tftypes.ValueFromJSONWithOpts(raw, attributeType, tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}); err == nil {
+					values[name] = value
+				}
+			}
+
+			resp.TargetState.Raw = tftypes.NewValue(targetType, values)
+		},
+	}
+}
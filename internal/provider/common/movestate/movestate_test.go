@@ -0,0 +1,193 @@
+package movestate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
+)
+
+var testSchema = schemaR.Schema{
+	Attributes: map[string]schemaR.Attribute{
+		"id":         schemaR.StringAttribute{Computed: true},
+		"name":       schemaR.StringAttribute{Required: true},
+		"role_name":  schemaR.StringAttribute{Required: true},
+		"enabled":    schemaR.BoolAttribute{Optional: true},
+		"quota":      schemaR.Int64Attribute{Optional: true},
+		"ip_address": schemaR.ListAttribute{ElementType: types.StringType, Optional: true},
+	},
+}
+
+type testModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	RoleName  types.String `tfsdk:"role_name"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Quota     types.Int64  `tfsdk:"quota"`
+	IPAddress types.List   `tfsdk:"ip_address"`
+}
+
+func moveState(t *testing.T, sourceType, providerAddress, rawState string) *resource.MoveStateResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: testSchema,
+			Raw:    tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	FromVCD("vcd_org_user", map[string]string{"role": "role_name"}).StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: providerAddress,
+		SourceTypeName:        sourceType,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}, resp)
+
+	return resp
+}
+
+func TestFromVCD(t *testing.T) {
+	t.Parallel()
+
+	resp := moveState(t, "vcd_org_user", "registry.terraform.io/vmware/vcd", `{
+		"id": "urn:vcloud:user:0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+		"name": "my-user",
+		"role": "Organization Administrator",
+		"enabled": true,
+		"quota": "10",
+		"ip_address": "192.168.1.1",
+		"org": "my-org"
+	}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got %v", resp.Diagnostics)
+	}
+
+	var got testModel
+	if diags := resp.TargetState.Get(context.Background(), &got); diags.HasError() {
+		t.Fatalf("expected a valid target state, got %v", diags)
+	}
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{name: "id", got: got.ID.ValueString(), expected: "urn:vcloud:user:0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d"},
+		{name: "name", got: got.Name.ValueString(), expected: "my-user"},
+		{name: "role_name", got: got.RoleName.ValueString(), expected: "Organization Administrator"},
+		{name: "enabled", got: got.Enabled.String(), expected: "true"},
+		{name: "quota", got: got.Quota.String(), expected: "10"},
+		// The attributes with a different type are left null.
+		{name: "ip_address", got: got.IPAddress.String(), expected: "<null>"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.name, tt.expected, tt.got)
+		}
+	}
+}
+
+func TestFromVCDSkipped(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		sourceType      string
+		providerAddress string
+	}{
+		"other resource": {
+			sourceType:      "vcd_org_group",
+			providerAddress: "registry.terraform.io/vmware/vcd",
+		},
+		"other provider": {
+			sourceType:      "vcd_org_user",
+			providerAddress: "registry.terraform.io/example/vcd",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := moveState(t, tt.sourceType, tt.providerAddress, `{"name": "my-user"}`)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got %v", resp.Diagnostics)
+			}
+			if !resp.TargetState.Raw.IsNull() {
+				t.Fatalf("expected a null target state, got %s", resp.TargetState.Raw)
+			}
+		})
+	}
+}
+
+func TestFromVCDOpenTofu(t *testing.T) {
+	t.Parallel()
+
+	resp := moveState(t, "vcd_org_user", "registry.opentofu.org/vmware/vcd", `{"name": "my-user"}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got %v", resp.Diagnostics)
+	}
+	if resp.TargetState.Raw.IsNull() {
+		t.Fatal("expected a target state, got null")
+	}
+}
+
+func TestFromVCDInvalidState(t *testing.T) {
+	t.Parallel()
+
+	if resp := moveState(t, "vcd_org_user", "registry.terraform.io/vmware/vcd", `{`); !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestFromVCDWithStep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mover := func(step stateupgrade.Step) *resource.MoveStateResponse {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: testSchema,
+				Raw:    tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil),
+			},
+		}
+		FromVCDWithStep("vcd_org_user", step).StateMover(ctx, resource.MoveStateRequest{
+			SourceProviderAddress: "registry.terraform.io/vmware/vcd",
+			SourceTypeName:        "vcd_org_user",
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"name": "my-user", "user": {"role": "Organization Administrator"}, "quota": 9007199254740993}`)},
+		}, resp)
+		return resp
+	}
+
+	resp := mover(func(s stateupgrade.State) error {
+		return s.Move("user.role", "role_name")
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got %v", resp.Diagnostics)
+	}
+
+	var got testModel
+	if diags := resp.TargetState.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("expected a valid target state, got %v", diags)
+	}
+	if got.RoleName.ValueString() != "Organization Administrator" {
+		t.Errorf("role_name: expected Organization Administrator, got %s", got.RoleName)
+	}
+	// The large integers keep their precision.
+	if got.Quota.ValueInt64() != 9007199254740993 {
+		t.Errorf("quota: expected 9007199254740993, got %s", got.Quota)
+	}
+
+	if resp := mover(func(stateupgrade.State) error { return errors.New("invalid state") }); !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error, got nil")
+	}
+}
@@ -254,7 +254,6 @@ func GetSchema(opts ...networkSchemaOpts) superschema.Schema {
 				},
 				Validators: []validator.String{
 					stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_id"), path.MatchRoot("edge_gateway_name")),
-					stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_id"), path.MatchRoot("edge_gateway_name")),
 				},
 			},
 		}
@@ -264,12 +263,14 @@ func GetSchema(opts ...networkSchemaOpts) superschema.Schema {
 				Computed:            true,
 			},
 			Resource: &schemaR.StringAttribute{
-				MarkdownDescription: "The name of the edge gateway in which the routed network should be located.",
-				Optional:            true,
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_id"), path.MatchRoot("edge_gateway_name")),
+				},
 			},
 		}
 		_schema.Attributes["interface_type"] = superschema.StringAttribute{
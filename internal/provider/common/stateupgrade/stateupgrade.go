@@ -0,0 +1,175 @@
+// Package stateupgrade provides a framework to write the state upgraders of the resources.
+//
+// The upgrade of a resource is a list of steps: the step i transforms the raw state written
+// with the schema version i into the state of the version i+1, so the schema version of the
+// resource is the number of steps. The upgrader of a prior version applies all the steps from
+// this version and decodes the result with the current schema.
+//
+//	var vdcUpgradeSteps = []stateupgrade.Step{
+//		// 0 to 1: the vdc_group attribute was removed.
+//		func(s stateupgrade.State) error {
+//			s.Remove("vdc_group")
+//			return nil
+//		},
+//	}
+//
+//	resp.Schema.Version = stateupgrade.Version(vdcUpgradeSteps)
+//
+//	func (r *vdcResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+//		return stateupgrade.Upgraders(vdcUpgradeSteps)
+//	}
+package stateupgrade
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// State is the raw state of a resource decoded from JSON.
+// The paths of the attributes are their names separated by dots (settings.customization).
+type State map[string]any
+
+// Step transforms the raw state of a schema version into the raw state of the next version.
+type Step func(state State) error
+
+// Version returns the schema version of a resource upgraded with the steps.
+func Version(steps []Step) int64 {
+	return int64(len(steps))
+}
+
+// Upgraders returns the state upgraders of a resource from each prior schema version.
+func Upgraders(steps []Step) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(steps))
+	for version := range steps {
+		upgraders[int64(version)] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError("Unable to upgrade the resource state", "The state is empty.")
+					return
+				}
+
+				// The numbers are kept as json.Number to not lose the precision of the large integers.
+				state := State{}
+				decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+				decoder.UseNumber()
+				if err := decoder.Decode(&state); err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade the resource state", fmt.Sprintf("Error decoding the state: %s", err))
+					return
+				}
+
+				for i, step := range steps[version:] {
+					if err := step(state); err != nil {
+						resp.Diagnostics.AddError("Unable to upgrade the resource state", fmt.Sprintf("Error upgrading the state from version %d: %s", version+i, err))
+						return
+					}
+				}
+
+				raw, err := json.Marshal(state)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade the resource state", fmt.Sprintf("Error encoding the state: %s", err))
+					return
+				}
+
+				// The attributes removed from the schema are ignored and the attributes added are null.
+				value, err := tftypes.ValueFromJSONWithOpts(raw, resp.State.Schema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade the resource state", fmt.Sprintf("The upgraded state does not match the schema: %s", err))
+					return
+				}
+
+				resp.State.Raw = value
+			},
+		}
+	}
+
+	return upgraders
+}
+
+// Has returns true if the attribute is set in the state.
+func (s State) Has(path string) bool {
+	parent, name := s.parent(path, false)
+	if parent == nil {
+		return false
+	}
+	v, ok := parent[name]
+
+	return ok && v != nil
+}
+
+// Remove removes the attribute from the state.
+func (s State) Remove(path string) {
+	if parent, name := s.parent(path, false); parent != nil {
+		delete(parent, name)
+	}
+}
+
+// Move moves the attribute to another path, the missing parent objects are created.
+// Nothing is done if the attribute is not set.
+func (s State) Move(from, to string) error {
+	if !s.Has(from) {
+		s.Remove(from)
+		return nil
+	}
+
+	fromParent, fromName := s.parent(from, false)
+	toParent, toName := s.parent(to, true)
+	if toParent == nil {
+		return fmt.Errorf("unable to move %s to %s: a parent of %s is not an object", from, to, to)
+	}
+
+	toParent[toName] = fromParent[fromName]
+	delete(fromParent, fromName)
+
+	return nil
+}
+
+// Unwrap replaces a list of one object by the object, the blocks of the prior schemas
+// are stored as lists. An empty list is replaced by null.
+func (s State) Unwrap(path string) error {
+	parent, name := s.parent(path, false)
+	if parent == nil {
+		return nil
+	}
+
+	list, ok := parent[name].([]any)
+	if !ok {
+		return nil
+	}
+
+	switch len(list) {
+	case 0:
+		parent[name] = nil
+	case 1:
+		parent[name] = list[0]
+	default:
+		return fmt.Errorf("unable to unwrap %s: the list has %d elements", path, len(list))
+	}
+
+	return nil
+}
+
+// parent returns the object containing the attribute and the name of the attribute.
+// The missing objects are created if create is true, otherwise the object is nil.
+func (s State) parent(path string, create bool) (map[string]any, string) {
+	names := strings.Split(path, ".")
+	object := map[string]any(s)
+	for _, name := range names[:len(names)-1] {
+		child, ok := object[name].(map[string]any)
+		if !ok {
+			if !create || (object[name] != nil) {
+				return nil, ""
+			}
+			child = map[string]any{}
+			object[name] = child
+		}
+		object = child
+	}
+
+	return object, names[len(names)-1]
+}
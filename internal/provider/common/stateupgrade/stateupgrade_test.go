@@ -0,0 +1,209 @@
+package stateupgrade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+var testSchema = schemaR.Schema{
+	Version: 2,
+	Attributes: map[string]schemaR.Attribute{
+		"id":     schemaR.StringAttribute{Computed: true},
+		"memory": schemaR.Int64Attribute{Optional: true},
+		"settings": schemaR.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schemaR.Attribute{
+				"os_type": schemaR.StringAttribute{Optional: true},
+			},
+		},
+	},
+}
+
+var testSteps = []Step{
+	// 0 to 1: os_type is moved under settings.
+	func(s State) error {
+		return s.Move("os_type", "settings.os_type")
+	},
+	// 1 to 2: legacy is removed.
+	func(s State) error {
+		s.Remove("legacy")
+		return nil
+	},
+}
+
+type testModel struct {
+	ID       types.String `tfsdk:"id"`
+	Memory   types.Int64  `tfsdk:"memory"`
+	Settings types.Object `tfsdk:"settings"`
+}
+
+func upgrade(t *testing.T, steps []Step, version int64, rawState string) *resource.UpgradeStateResponse {
+	t.Helper()
+
+	upgrader, ok := Upgraders(steps)[version]
+	if !ok {
+		t.Fatalf("expected an upgrader for version %d", version)
+	}
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: testSchema}}
+	upgrader.StateUpgrader(context.Background(), resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	}, resp)
+
+	return resp
+}
+
+func TestUpgraders(t *testing.T) {
+	t.Parallel()
+
+	if v := Version(testSteps); v != testSchema.Version {
+		t.Fatalf("expected version %d, got %d", testSchema.Version, v)
+	}
+
+	tests := map[string]struct {
+		version  int64
+		rawState string
+		osType   string
+	}{
+		"version 0": {
+			version:  0,
+			rawState: `{"id": "vm-1", "memory": 9007199254740993, "os_type": "debian10_64Guest", "legacy": true}`,
+			osType:   "debian10_64Guest",
+		},
+		"version 1": {
+			version:  1,
+			rawState: `{"id": "vm-1", "memory": 9007199254740993, "settings": {"os_type": "debian10_64Guest"}, "legacy": true}`,
+			osType:   "debian10_64Guest",
+		},
+		"version 0 without os_type": {
+			version:  0,
+			rawState: `{"id": "vm-1", "memory": 9007199254740993}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := upgrade(t, testSteps, tt.version, tt.rawState)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got %v", resp.Diagnostics)
+			}
+
+			var got testModel
+			if diags := resp.State.Get(context.Background(), &got); diags.HasError() {
+				t.Fatalf("expected a valid state, got %v", diags)
+			}
+
+			if got.ID.ValueString() != "vm-1" {
+				t.Errorf("expected id vm-1, got %s", got.ID)
+			}
+			if got.Memory.ValueInt64() != 9007199254740993 {
+				t.Errorf("expected memory 9007199254740993, got %s", got.Memory)
+			}
+
+			var osType attr.Value = types.StringNull()
+			if !got.Settings.IsNull() {
+				osType = got.Settings.Attributes()["os_type"]
+			}
+			if tt.osType == "" && !osType.IsNull() {
+				t.Errorf("expected a null os_type, got %s", osType)
+			}
+			if tt.osType != "" && osType.(types.String).ValueString() != tt.osType {
+				t.Errorf("expected os_type %s, got %s", tt.osType, osType)
+			}
+		})
+	}
+}
+
+func TestUpgradersErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		steps    []Step
+		rawState string
+	}{
+		"invalid JSON": {
+			steps:    testSteps,
+			rawState: `{`,
+		},
+		"step error": {
+			steps: []Step{
+				func(State) error { return errors.New("step error") },
+			},
+			rawState: `{"id": "vm-1"}`,
+		},
+		"state not matching the schema": {
+			steps:    testSteps,
+			rawState: `{"id": "vm-1", "memory": "large"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if resp := upgrade(t, tt.steps, 0, tt.rawState); !resp.Diagnostics.HasError() {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestStateMove(t *testing.T) {
+	t.Parallel()
+
+	s := State{"cpus": 2, "settings": nil, "resource": "not an object"}
+
+	if err := s.Move("cpus", "settings.resource.cpus"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if s.Has("cpus") || !s.Has("settings.resource.cpus") {
+		t.Fatalf("expected cpus moved to settings.resource.cpus, got %v", s)
+	}
+
+	if err := s.Move("missing", "settings.missing"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if s.Has("settings.missing") {
+		t.Fatal("expected settings.missing not set")
+	}
+
+	if err := s.Move("settings.resource.cpus", "resource.cpus"); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestStateUnwrap(t *testing.T) {
+	t.Parallel()
+
+	s := State{
+		"customization": []any{map[string]any{"force": true}},
+		"guest":         []any{},
+		"networks":      []any{map[string]any{}, map[string]any{}},
+	}
+
+	for _, path := range []string{"customization", "guest", "missing", "missing.child"} {
+		if err := s.Unwrap(path); err != nil {
+			t.Fatalf("%s: expected no error, got %s", path, err)
+		}
+	}
+	if !s.Has("customization.force") {
+		t.Errorf("expected customization unwrapped, got %v", s["customization"])
+	}
+	if s.Has("guest") {
+		t.Errorf("expected guest null, got %v", s["guest"])
+	}
+
+	if err := s.Unwrap("networks"); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
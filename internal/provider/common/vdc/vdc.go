@@ -12,6 +12,7 @@ import (
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 type VDC struct {
@@ -116,3 +117,18 @@ func Init(c *client.CloudAvenue, vdc types.String) (VDC, diag.Diagnostics) {
 
 	return v, nil
 }
+
+// NameOf returns the name of the VDC identified by its name or its URN.
+// A name is returned as is.
+func NameOf(c *client.CloudAvenue, nameOrURN string) (string, diag.Diagnostics) {
+	if !uuid.IsVDC(nameOrURN) {
+		return nameOrURN, nil
+	}
+
+	v, d := Init(c, types.StringValue(nameOrURN))
+	if d.HasError() {
+		return "", d
+	}
+
+	return v.GetName(), nil
+}
@@ -6,6 +6,9 @@ import (
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
@@ -139,3 +142,61 @@ func (s *VMResourceModelSettingsCustomization) GetCustomizationSection(vmName st
 		MachineObjectOU:    s.JoinDomainAccountOU.ValueString(),
 	}
 }
+
+// * Write-only
+
+// WriteOnlyFromConfig sets the write-only secrets of the customization from the config. Terraform never sends them in the plan.
+func (rm *VMResourceModel) WriteOnlyFromConfig(ctx context.Context, config tfsdk.Config) (diags diag.Diagnostics) {
+	diags.Append(config.GetAttribute(ctx, path.Root("admin_password_wo"), &rm.AdminPasswordWO)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("join_domain_password_wo"), &rm.JoinDomainPasswordWO)...)
+	return
+}
+
+// WriteOnlyVersionChanged returns true if the version of a write-only secret of the customization changed.
+func (rm *VMResourceModel) WriteOnlyVersionChanged(state *VMResourceModel) bool {
+	return !rm.AdminPasswordWOVersion.Equal(state.AdminPasswordWOVersion) ||
+		!rm.JoinDomainPasswordWOVersion.Equal(state.JoinDomainPasswordWOVersion)
+}
+
+// GetCustomizationSection returns the customization section of the VM with the write-only secrets, if set.
+func (rm *VMResourceModel) GetCustomizationSection(customization *VMResourceModelSettingsCustomization) *govcdtypes.GuestCustomizationSection {
+	section := customization.GetCustomizationSection(rm.Name.ValueString())
+	if !rm.AdminPasswordWO.IsNull() {
+		section.AdminPassword = rm.AdminPasswordWO.ValueString()
+	}
+	if !rm.JoinDomainPasswordWO.IsNull() {
+		section.DomainUserPassword = rm.JoinDomainPasswordWO.ValueString()
+	}
+	return section
+}
+
+// RemoveWriteOnlySecrets removes from the customization the secrets set with a write-only attribute.
+// The API returns them but they must not be stored in the state.
+func (rm *VMResourceModel) RemoveWriteOnlySecrets(ctx context.Context) (diags diag.Diagnostics) {
+	if rm.AdminPasswordWOVersion.IsNull() && rm.JoinDomainPasswordWOVersion.IsNull() {
+		return
+	}
+
+	settings, d := rm.SettingsFromPlan(ctx)
+	diags.Append(d...)
+	if diags.HasError() || settings.Customization.IsNull() || settings.Customization.IsUnknown() {
+		return
+	}
+
+	customization, d := settings.CustomizationFromPlan(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if !rm.AdminPasswordWOVersion.IsNull() {
+		customization.AdminPassword = types.StringNull()
+	}
+	if !rm.JoinDomainPasswordWOVersion.IsNull() {
+		customization.JoinDomainPassword = types.StringNull()
+	}
+
+	settings.Customization = customization.ToPlan(ctx)
+	rm.Settings = settings.ToPlan(ctx)
+	return
+}
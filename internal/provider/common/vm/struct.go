@@ -21,6 +21,12 @@ type VMResourceModel struct { //nolint:revive
 	State       types.Object `tfsdk:"state"`
 	Resource    types.Object `tfsdk:"resource"`
 	Settings    types.Object `tfsdk:"settings"`
+
+	// Write-only variants of the customization secrets
+	AdminPasswordWO             types.String `tfsdk:"admin_password_wo"`
+	AdminPasswordWOVersion      types.Int64  `tfsdk:"admin_password_wo_version"`
+	JoinDomainPasswordWO        types.String `tfsdk:"join_domain_password_wo"`
+	JoinDomainPasswordWOVersion types.Int64  `tfsdk:"join_domain_password_wo_version"`
 }
 
 type VMResourceModelAllStructs struct { //nolint:revive
@@ -0,0 +1,45 @@
+// Package writeonly provides helpers for the write-only variants of secret attributes.
+//
+// A secret attribute `<name>` gets a write-only variant `<name>_wo` and a `<name>_wo_version`
+// companion. Terraform never stores the write-only value in the plan or the state, so the
+// resource only sends the secret to the API when it is created or when the version changes.
+package writeonly
+
+import (
+	"fmt"
+	"strings"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const (
+	// Suffix is the suffix of the name of a write-only attribute.
+	Suffix = "_wo"
+	// VersionSuffix is the suffix of the name of the version attribute of a write-only attribute.
+	VersionSuffix = "_wo_version"
+)
+
+// Description returns the description of the write-only attribute name, variant of the secret attribute.
+func Description(secret, name string) string {
+	return fmt.Sprintf("Write-only variant of `%s`. The value is never stored in the plan or the state and is only sent on creation or when `%s` changes. Requires Terraform 1.11 or later.", secret, strings.TrimSuffix(name, Suffix)+VersionSuffix)
+}
+
+// VersionDescription returns the description of the version attribute of the write-only attribute name.
+func VersionDescription(name string) string {
+	return fmt.Sprintf("The version of `%s`. Change it to update the secret.", name)
+}
+
+// Set marks the root string attributes named with the `_wo` suffix as write-only.
+// superschema does not support write-only attributes, so they are set on the generated resource schema.
+func Set(s schemaR.Schema) schemaR.Schema {
+	for name, attribute := range s.Attributes {
+		a, ok := attribute.(schemaR.StringAttribute)
+		if !ok || !strings.HasSuffix(name, Suffix) {
+			continue
+		}
+		a.WriteOnly = true
+		s.Attributes[name] = a
+	}
+
+	return s
+}
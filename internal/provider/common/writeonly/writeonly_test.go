@@ -0,0 +1,38 @@
+package writeonly
+
+import (
+	"testing"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	s := Set(schemaR.Schema{
+		Attributes: map[string]schemaR.Attribute{
+			"password":            schemaR.StringAttribute{Optional: true, Sensitive: true},
+			"password_wo":         schemaR.StringAttribute{Optional: true, Sensitive: true},
+			"password_wo_version": schemaR.Int64Attribute{Optional: true},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		writeOnly bool
+	}{
+		{name: "password", writeOnly: false},
+		{name: "password_wo", writeOnly: true},
+		{name: "password_wo_version", writeOnly: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := s.Attributes[tt.name].IsWriteOnly(); got != tt.writeOnly {
+				t.Fatalf("expected write-only %t, got %t", tt.writeOnly, got)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package edgegw
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -18,9 +17,10 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &portProfilesResource{}
 	_ resource.ResourceWithConfigure   = &portProfilesResource{}
 	_ resource.ResourceWithImportState = &portProfilesResource{}
+	_ resource.ResourceWithMoveState   = &portProfilesResource{}
 )
 
 const (
@@ -157,7 +158,7 @@ func (r *portProfilesResource) Configure(ctx context.Context, req resource.Confi
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *portProfilesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &portProfilesResourceModel{}
 
@@ -235,7 +236,7 @@ func (r *portProfilesResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read refreshes the Terraform state with the latest data.
 func (r *portProfilesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &portProfilesResourceModel{}
 
@@ -292,7 +293,7 @@ func (r *portProfilesResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *portProfilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &portProfilesResourceModel{}
@@ -385,7 +386,7 @@ func (r *portProfilesResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *portProfilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &portProfilesResourceModel{}
 
@@ -424,18 +425,32 @@ func (r *portProfilesResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *portProfilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ".")
+	defer metrics.New("cloudavenue_edgegateway_app_port_profile", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: VDCOrVDCGroupID.appPortProfileName. Got: %q", req.ID),
-		)
+	id, d := importid.Parse(req.ID, importid.Format{importid.VDCOrVDCGroup, importid.Name("app_port_profile", "application port profile")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
-	vdcID := uuid.Normalize(uuid.VDC, idParts[0]).String()
+	// The vdc attribute is the ID of the VDC or VDC Group.
+	vdcID := id.Get("vdc").ID()
+	if vdcID == "" {
+		vdcOrVDCGroup, err := r.client.GetVDCOrVDCGroup(id.Get("vdc").Name())
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving VDC or VDC Group", err.Error())
+			return
+		}
+		vdcID = vdcOrVDCGroup.GetID()
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), vdcID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("app_port_profile").Name())...)
+}
+
+// MoveState moves the state of the vcd_nsxt_app_port_profile resource of the vcd provider to the resource.
+func (r *portProfilesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_app_port_profile", nil),
+	}
 }
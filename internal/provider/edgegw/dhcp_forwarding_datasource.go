@@ -78,7 +78,7 @@ func (d *dhcpForwardingDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *dhcpForwardingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway_dhcp_forwarding", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway_dhcp_forwarding", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &DhcpForwardingModel{}
 
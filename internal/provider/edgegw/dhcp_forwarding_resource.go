@@ -17,9 +17,10 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -28,6 +29,7 @@ var (
 	_ resource.ResourceWithConfigure   = &dhcpForwardingResource{}
 	_ resource.ResourceWithImportState = &dhcpForwardingResource{}
 	_ resource.ResourceWithModifyPlan  = &dhcpForwardingResource{}
+	_ resource.ResourceWithMoveState   = &dhcpForwardingResource{}
 )
 
 // NewDhcpForwardingResource is a helper function to simplify the provider implementation.
@@ -92,7 +94,7 @@ func (r *dhcpForwardingResource) Configure(ctx context.Context, req resource.Con
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *dhcpForwardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &DhcpForwardingModel{}
 
@@ -136,7 +138,7 @@ func (r *dhcpForwardingResource) Create(ctx context.Context, req resource.Create
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dhcpForwardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &DhcpForwardingModel{}
 
@@ -172,7 +174,7 @@ func (r *dhcpForwardingResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dhcpForwardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &DhcpForwardingModel{}
@@ -219,7 +221,7 @@ func (r *dhcpForwardingResource) Update(ctx context.Context, req resource.Update
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *dhcpForwardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &DhcpForwardingModel{}
 
@@ -261,29 +263,25 @@ func (r *dhcpForwardingResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *dhcpForwardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway_dhcp_forwarding", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	var (
-		edgegwID, edgegwName string
-		d                    diag.Diagnostics
-		err                  error
-	)
-
-	r.org, d = org.Init(r.client)
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway})
 	if d.HasError() {
 		resp.Diagnostics.Append(d...)
 		return
 	}
 
-	if uuid.IsEdgeGateway(req.ID) {
-		edgegwID = req.ID
-	} else {
-		edgegwName = req.ID
+	var err error
+
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
 	}
 
 	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgegwID),
-		Name: types.StringValue(edgegwName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import DHCP Forwarding.", err.Error())
@@ -295,6 +293,13 @@ func (r *dhcpForwardingResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
 }
 
+// MoveState moves the state of the vcd_nsxt_edgegateway_dhcp_forwarding resource of the vcd provider to the resource.
+func (r *dhcpForwardingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_edgegateway_dhcp_forwarding", nil),
+	}
+}
+
 // ModifyPlan Check if DHCP servers can be edited.
 func (r *dhcpForwardingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	dPlan := &DhcpForwardingModel{}
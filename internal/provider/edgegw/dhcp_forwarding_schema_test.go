@@ -4,21 +4,24 @@ import (
 	"context"
 	"testing"
 
+	// The fwresource import alias is so there is no collision
+	// with the more typical acceptance testing import:
+	// "github.com/hashicorp/terraform-plugin-testing/helper/resource".
 	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/network"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/edgegw"
 )
 
+// Unit test for the schema of the resource cloudavenue_edgegateway_dhcp_forwarding.
 func TestDhcpForwardingResourceSchema(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	schemaRequest := fwresource.SchemaRequest{}
 	schemaResponse := &fwresource.SchemaResponse{}
 
 	// Instantiate the resource.Resource and call its Schema method
-	network.NewDhcpBindingResource().Schema(ctx, schemaRequest, schemaResponse)
+	edgegw.NewDhcpForwardingResource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
 
 	if schemaResponse.Diagnostics.HasError() {
 		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
@@ -32,15 +35,15 @@ func TestDhcpForwardingResourceSchema(t *testing.T) {
 	}
 }
 
+// Unit test for the schema of the data source cloudavenue_edgegateway_dhcp_forwarding.
 func TestDhcpForwardingDataSourceSchema(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	schemaRequest := fwdatasource.SchemaRequest{}
 	schemaResponse := &fwdatasource.SchemaResponse{}
 
-	// Instantiate the resource.Resource and call its Schema method
-	network.NewDhcpBindingDataSource().Schema(ctx, schemaRequest, schemaResponse)
+	// Instantiate the datasource.DataSource and call its Schema method
+	edgegw.NewDhcpForwardingDataSource().Schema(ctx, fwdatasource.SchemaRequest{}, schemaResponse)
 
 	if schemaResponse.Diagnostics.HasError() {
 		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
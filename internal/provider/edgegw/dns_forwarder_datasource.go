@@ -0,0 +1,116 @@
+// Package edgegw provides a Terraform datasource.
+package edgegw //nolint:dupl // This is a datasource, it is normal to have similar code to the other datasource.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+var (
+	_ datasource.DataSource              = &dnsForwarderDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsForwarderDataSource{}
+)
+
+func NewDNSForwarderDataSource() datasource.DataSource {
+	return &dnsForwarderDataSource{}
+}
+
+type dnsForwarderDataSource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the data source.
+func (d *dnsForwarderDataSource) Init(ctx context.Context, dm *DNSForwarderModel) (diags diag.Diagnostics) {
+	var err error
+
+	d.org, diags = org.Init(d.client)
+	if diags.HasError() {
+		return
+	}
+
+	d.edgegw, err = d.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(dm.EdgeGatewayID.Get()),
+		Name: types.StringValue(dm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+func (d *dnsForwarderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_dns_forwarder"
+}
+
+func (d *dnsForwarderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = dnsForwarderSchema(ctx).GetDataSource(ctx)
+}
+
+func (d *dnsForwarderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *dnsForwarderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer metrics.New("data.cloudavenue_edgegateway_dns_forwarder", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	config := NewDNSForwarder(req.Config)
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(d.Init(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the data source read logic here.
+	*/
+
+	s := &dnsForwarderResource{
+		client: d.client,
+		org:    d.org,
+		edgegw: d.edgegw,
+	}
+
+	// Read data from the API
+	data, _, diags := s.read(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,379 @@
+// Package edgegw provides a Terraform resource.
+package edgegw
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsForwarderResource{}
+	_ resource.ResourceWithConfigure   = &dnsForwarderResource{}
+	_ resource.ResourceWithImportState = &dnsForwarderResource{}
+	_ resource.ResourceWithMoveState   = &dnsForwarderResource{}
+)
+
+// NewDNSForwarderResource is a helper function to simplify the provider implementation.
+func NewDNSForwarderResource() resource.Resource {
+	return &dnsForwarderResource{}
+}
+
+// dnsForwarderResource is the resource implementation.
+type dnsForwarderResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the resource.
+func (r *dnsForwarderResource) Init(ctx context.Context, rm *DNSForwarderModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.org, diags = org.Init(r.client)
+	if diags.HasError() {
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(rm.EdgeGatewayID.Get()),
+		Name: types.StringValue(rm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *dnsForwarderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_dns_forwarder"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsForwarderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dnsForwarderSchema(ctx).GetResource(ctx)
+}
+
+func (r *dnsForwarderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsForwarderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer metrics.New("cloudavenue_edgegateway_dns_forwarder", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
+
+	plan := NewDNSForwarder(req.Plan)
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource creation logic here.
+	*/
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID.Set(r.edgegw.GetID())
+	state, _, d := r.read(ctx, plan)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsForwarderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer metrics.New("cloudavenue_edgegateway_dns_forwarder", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	state := NewDNSForwarder(req.State)
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource read here
+	*/
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsForwarderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer metrics.New("cloudavenue_edgegateway_dns_forwarder", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
+
+	var (
+		plan  = NewDNSForwarder(req.Plan)
+		state = NewDNSForwarder(req.State)
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource update here
+	*/
+
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateRefreshed, _, d := r.read(ctx, plan)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsForwarderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer metrics.New("cloudavenue_edgegateway_dns_forwarder", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
+
+	state := NewDNSForwarder(req.State)
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource deletion here
+	*/
+
+	vdcOrVDCGroup, err := r.edgegw.GetParent()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway parent", err.Error())
+		return
+	}
+
+	if vdcOrVDCGroup.IsVDCGroup() {
+		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
+	} else {
+		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
+	}
+
+	// The DNS forwarder always exists, the deletion resets its configuration (disabled and without zones).
+	if err := r.edgegw.DeleteDNSForwarder(); err != nil {
+		resp.Diagnostics.AddError("Error deleting DNS forwarder", err.Error())
+		return
+	}
+}
+
+func (r *dnsForwarderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_edgegateway_dns_forwarder", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	var err error
+
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import DNS Forwarder.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.edgegw.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), r.edgegw.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
+}
+
+// MoveState moves the state of the vcd_nsxt_edgegateway_dns resource of the vcd provider to the resource.
+func (r *dnsForwarderResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_edgegateway_dns", nil),
+	}
+}
+
+// * CustomFuncs
+
+func (r *dnsForwarderResource) read(ctx context.Context, planOrState *DNSForwarderModel) (stateRefreshed *DNSForwarderModel, found bool, diags diag.Diagnostics) {
+	stateRefreshed = planOrState.Copy()
+
+	dnsForwarderConfig, err := r.edgegw.GetDNSForwarder()
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil, false, nil
+		}
+		diags.AddError("Error retrieving NSX-T Edge Gateway DNS forwarder", err.Error())
+		return nil, true, diags
+	}
+
+	if !stateRefreshed.ID.IsKnown() {
+		stateRefreshed.ID.Set(r.edgegw.GetID())
+	}
+
+	stateRefreshed.Enabled.Set(dnsForwarderConfig.Enabled)
+	stateRefreshed.EdgeGatewayID.Set(r.edgegw.GetID())
+	stateRefreshed.EdgeGatewayName.Set(r.edgegw.GetName())
+	stateRefreshed.ListenerIP.Set(dnsForwarderConfig.ListenerIP)
+
+	if dnsForwarderConfig.DefaultForwarderZone != nil {
+		defaultZone := DNSForwarderModelDefaultForwarderZone{
+			Name:            supertypes.NewStringNull(),
+			UpstreamServers: supertypes.NewSetNull(supertypes.StringType{}),
+		}
+		defaultZone.Name.Set(dnsForwarderConfig.DefaultForwarderZone.DisplayName)
+		diags.Append(defaultZone.UpstreamServers.Set(ctx, dnsForwarderConfig.DefaultForwarderZone.UpstreamServers)...)
+		diags.Append(stateRefreshed.DefaultForwarderZone.Set(ctx, defaultZone)...)
+	} else {
+		stateRefreshed.DefaultForwarderZone.SetNull(ctx)
+	}
+
+	if len(dnsForwarderConfig.ConditionalForwarderZones) > 0 {
+		conditionalZones := make(DNSForwarderModelConditionalForwarderZones, 0)
+		for _, zone := range dnsForwarderConfig.ConditionalForwarderZones {
+			conditionalZone := DNSForwarderModelConditionalForwarderZone{
+				DomainNames:     supertypes.NewSetNull(supertypes.StringType{}),
+				Name:            supertypes.NewStringNull(),
+				UpstreamServers: supertypes.NewSetNull(supertypes.StringType{}),
+			}
+			conditionalZone.Name.Set(zone.DisplayName)
+			diags.Append(conditionalZone.DomainNames.Set(ctx, zone.DNSDomainNames)...)
+			diags.Append(conditionalZone.UpstreamServers.Set(ctx, zone.UpstreamServers)...)
+
+			conditionalZones = append(conditionalZones, conditionalZone)
+		}
+		diags.Append(stateRefreshed.ConditionalForwarderZones.Set(ctx, conditionalZones)...)
+	} else {
+		stateRefreshed.ConditionalForwarderZones.SetNull(ctx)
+	}
+
+	if diags.HasError() {
+		return nil, true, diags
+	}
+
+	return stateRefreshed, true, nil
+}
+
+func (r *dnsForwarderResource) createOrUpdate(ctx context.Context, plan *DNSForwarderModel) (diags diag.Diagnostics) {
+	vdcOrVDCGroup, err := r.edgegw.GetParent()
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway parent", err.Error())
+		return
+	}
+
+	if vdcOrVDCGroup.IsVDCGroup() {
+		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
+	} else {
+		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
+	}
+
+	dnsForwarderConfig, d := plan.ToDNSForwarder(ctx)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+
+	if _, err := r.edgegw.UpdateDNSForwarder(dnsForwarderConfig); err != nil {
+		diags.AddError("Error on change DNS forwarder configuration", err.Error())
+		return
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	schemaD "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func dnsForwarderSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_edgegateway_dns_forwarder` resource allows you to manage the DNS Forwarder of an Edge Gateway.",
+		},
+		DataSource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_edgegateway_dns_forwarder` data source allows you to retrieve the DNS Forwarder of an Edge Gateway.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The ID of the DNS Forwarder.",
+				},
+				Resource: &schemaR.StringAttribute{
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"enabled": superschema.SuperBoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					Computed: true,
+				},
+				Resource: &schemaR.BoolAttribute{
+					MarkdownDescription: "Enable or disable the DNS Forwarder for the Edge Gateway.",
+					Optional:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+				DataSource: &schemaD.BoolAttribute{
+					MarkdownDescription: "Status of the DNS Forwarder for the Edge Gateway.",
+				},
+			},
+			"listener_ip": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The IP address on which the DNS Forwarder listens.",
+					Computed:            true,
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: "If not set, an IP address is allocated automatically.",
+					Optional:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						fstringvalidator.IsIP(),
+					},
+				},
+			},
+			"default_forwarder_zone": superschema.SuperSingleNestedAttribute{
+				Common: &schemaR.SingleNestedAttribute{
+					MarkdownDescription: "The default forwarder zone used to resolve the domain names which do not match a conditional forwarder zone.",
+				},
+				Resource: &schemaR.SingleNestedAttribute{
+					Required: true,
+				},
+				DataSource: &schemaD.SingleNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"name": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The name of the default forwarder zone.",
+						},
+						Resource: &schemaR.StringAttribute{
+							Required: true,
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
+						},
+					},
+					"upstream_servers": superschema.SuperSetAttribute{
+						Common: &schemaR.SetAttribute{
+							MarkdownDescription: "IP addresses of the upstream DNS servers.",
+							ElementType:         supertypes.StringType{},
+						},
+						Resource: &schemaR.SetAttribute{
+							Required: true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.SizeAtMost(3),
+								setvalidator.ValueStringsAre(fstringvalidator.IsIP()),
+							},
+						},
+						DataSource: &schemaD.SetAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"conditional_forwarder_zones": superschema.SuperSetNestedAttribute{
+				Common: &schemaR.SetNestedAttribute{
+					MarkdownDescription: "The conditional forwarder zones used to resolve specific domain names with other upstream servers.",
+				},
+				Resource: &schemaR.SetNestedAttribute{
+					Optional: true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtMost(5),
+					},
+				},
+				DataSource: &schemaD.SetNestedAttribute{
+					Computed: true,
+				},
+				Attributes: map[string]superschema.Attribute{
+					"name": superschema.SuperStringAttribute{
+						Common: &schemaR.StringAttribute{
+							MarkdownDescription: "The name of the conditional forwarder zone.",
+						},
+						Resource: &schemaR.StringAttribute{
+							Required: true,
+						},
+						DataSource: &schemaD.StringAttribute{
+							Computed: true,
+						},
+					},
+					"domain_names": superschema.SuperSetAttribute{
+						Common: &schemaR.SetAttribute{
+							MarkdownDescription: "The domain names resolved by the conditional forwarder zone.",
+							ElementType:         supertypes.StringType{},
+						},
+						Resource: &schemaR.SetAttribute{
+							Required: true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						DataSource: &schemaD.SetAttribute{
+							Computed: true,
+						},
+					},
+					"upstream_servers": superschema.SuperSetAttribute{
+						Common: &schemaR.SetAttribute{
+							MarkdownDescription: "IP addresses of the upstream DNS servers.",
+							ElementType:         supertypes.StringType{},
+						},
+						Resource: &schemaR.SetAttribute{
+							Required: true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.SizeAtMost(3),
+								setvalidator.ValueStringsAre(fstringvalidator.IsIP()),
+							},
+						},
+						DataSource: &schemaD.SetAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,153 @@
+package edgegw
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+type DNSForwarderModel struct {
+	ConditionalForwarderZones supertypes.SetNestedValue    `tfsdk:"conditional_forwarder_zones"`
+	DefaultForwarderZone      supertypes.SingleNestedValue `tfsdk:"default_forwarder_zone"`
+	EdgeGatewayID             supertypes.StringValue       `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName           supertypes.StringValue       `tfsdk:"edge_gateway_name"`
+	Enabled                   supertypes.BoolValue         `tfsdk:"enabled"`
+	ID                        supertypes.StringValue       `tfsdk:"id"`
+	ListenerIP                supertypes.StringValue       `tfsdk:"listener_ip"`
+}
+
+// * DefaultForwarderZone.
+type DNSForwarderModelDefaultForwarderZone struct {
+	Name            supertypes.StringValue `tfsdk:"name"`
+	UpstreamServers supertypes.SetValue    `tfsdk:"upstream_servers"`
+}
+
+// * ConditionalForwarderZones.
+type DNSForwarderModelConditionalForwarderZones []DNSForwarderModelConditionalForwarderZone
+
+// * ConditionalForwarderZone.
+type DNSForwarderModelConditionalForwarderZone struct {
+	DomainNames     supertypes.SetValue    `tfsdk:"domain_names"`
+	Name            supertypes.StringValue `tfsdk:"name"`
+	UpstreamServers supertypes.SetValue    `tfsdk:"upstream_servers"`
+}
+
+func NewDNSForwarder(t any) *DNSForwarderModel {
+	switch x := t.(type) {
+	case tfsdk.State:
+		return &DNSForwarderModel{
+			ConditionalForwarderZones: supertypes.NewSetNestedNull(x.Schema.GetAttributes()["conditional_forwarder_zones"].GetType().(supertypes.SetNestedType).ElementType()),
+			DefaultForwarderZone:      supertypes.NewSingleNestedNull(x.Schema.GetAttributes()["default_forwarder_zone"].GetType().(supertypes.SingleNestedType).AttributeTypes()),
+			EdgeGatewayID:             supertypes.NewStringUnknown(),
+			EdgeGatewayName:           supertypes.NewStringUnknown(),
+			Enabled:                   supertypes.NewBoolUnknown(),
+			ID:                        supertypes.NewStringUnknown(),
+			ListenerIP:                supertypes.NewStringUnknown(),
+		}
+
+	case tfsdk.Plan:
+		return &DNSForwarderModel{
+			ConditionalForwarderZones: supertypes.NewSetNestedNull(x.Schema.GetAttributes()["conditional_forwarder_zones"].GetType().(supertypes.SetNestedType).ElementType()),
+			DefaultForwarderZone:      supertypes.NewSingleNestedNull(x.Schema.GetAttributes()["default_forwarder_zone"].GetType().(supertypes.SingleNestedType).AttributeTypes()),
+			EdgeGatewayID:             supertypes.NewStringUnknown(),
+			EdgeGatewayName:           supertypes.NewStringUnknown(),
+			Enabled:                   supertypes.NewBoolUnknown(),
+			ID:                        supertypes.NewStringUnknown(),
+			ListenerIP:                supertypes.NewStringUnknown(),
+		}
+
+	case tfsdk.Config:
+		return &DNSForwarderModel{
+			ConditionalForwarderZones: supertypes.NewSetNestedNull(x.Schema.GetAttributes()["conditional_forwarder_zones"].GetType().(supertypes.SetNestedType).ElementType()),
+			DefaultForwarderZone:      supertypes.NewSingleNestedNull(x.Schema.GetAttributes()["default_forwarder_zone"].GetType().(supertypes.SingleNestedType).AttributeTypes()),
+			EdgeGatewayID:             supertypes.NewStringUnknown(),
+			EdgeGatewayName:           supertypes.NewStringUnknown(),
+			Enabled:                   supertypes.NewBoolUnknown(),
+			ID:                        supertypes.NewStringUnknown(),
+			ListenerIP:                supertypes.NewStringUnknown(),
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (rm *DNSForwarderModel) Copy() *DNSForwarderModel {
+	x := &DNSForwarderModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// GetDefaultForwarderZone returns the value of the DefaultForwarderZone field.
+func (rm *DNSForwarderModel) GetDefaultForwarderZone(ctx context.Context) (values DNSForwarderModelDefaultForwarderZone, diags diag.Diagnostics) {
+	values = DNSForwarderModelDefaultForwarderZone{}
+	d := rm.DefaultForwarderZone.Get(ctx, &values, basetypes.ObjectAsOptions{})
+	return values, d
+}
+
+// GetConditionalForwarderZones returns the value of the ConditionalForwarderZones field.
+func (rm *DNSForwarderModel) GetConditionalForwarderZones(ctx context.Context) (values DNSForwarderModelConditionalForwarderZones, diags diag.Diagnostics) {
+	values = make(DNSForwarderModelConditionalForwarderZones, 0)
+	d := rm.ConditionalForwarderZones.Get(ctx, &values, false)
+	return values, d
+}
+
+// ToDNSForwarder returns the NSX-T Edge Gateway DNS Forwarder representation of the model.
+func (rm *DNSForwarderModel) ToDNSForwarder(ctx context.Context) (dnsForwarder *edgegw.DNSForwarder, diags diag.Diagnostics) {
+	dnsForwarder = &edgegw.DNSForwarder{
+		Enabled:                   rm.Enabled.Get(),
+		ListenerIP:                rm.ListenerIP.Get(),
+		ConditionalForwarderZones: make([]*edgegw.DNSForwarderZone, 0),
+	}
+
+	defaultZone, d := rm.GetDefaultForwarderZone(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	defaultUpstreamServers := make([]string, 0)
+	diags.Append(defaultZone.UpstreamServers.Get(ctx, &defaultUpstreamServers, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dnsForwarder.DefaultForwarderZone = &edgegw.DNSForwarderZone{
+		DisplayName:     defaultZone.Name.Get(),
+		UpstreamServers: defaultUpstreamServers,
+	}
+
+	conditionalZones, d := rm.GetConditionalForwarderZones(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, conditionalZone := range conditionalZones {
+		domainNames := make([]string, 0)
+		diags.Append(conditionalZone.DomainNames.Get(ctx, &domainNames, false)...)
+
+		upstreamServers := make([]string, 0)
+		diags.Append(conditionalZone.UpstreamServers.Get(ctx, &upstreamServers, false)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		dnsForwarder.ConditionalForwarderZones = append(dnsForwarder.ConditionalForwarderZones, &edgegw.DNSForwarderZone{
+			DisplayName:     conditionalZone.Name.Get(),
+			DNSDomainNames:  domainNames,
+			UpstreamServers: upstreamServers,
+		})
+	}
+
+	return dnsForwarder, diags
+}
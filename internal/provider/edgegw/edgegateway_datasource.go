@@ -55,7 +55,7 @@ func (d *edgeGatewayDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *edgeGatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &edgeGatewayDatasourceModel{}
 
@@ -67,8 +67,14 @@ func (d *edgeGatewayDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	data := config.Copy()
 
+	cavSDK, err := d.client.CAVSDK()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return
+	}
+
 	// Read data from the API
-	edgegw, err := d.client.CAVSDK.V1.EdgeGateway.GetByName(config.Name.Get())
+	edgegw, err := cavSDK.V1.EdgeGateway.GetByName(config.Name.Get())
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving edge gateway", err.Error())
 		return
@@ -19,6 +19,9 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
@@ -28,10 +31,23 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &edgeGatewayResource{}
-	_ resource.ResourceWithConfigure   = &edgeGatewayResource{}
-	_ resource.ResourceWithImportState = &edgeGatewayResource{}
-	_ resource.ResourceWithModifyPlan  = &edgeGatewayResource{}
+	_ resource.Resource                 = &edgeGatewayResource{}
+	_ resource.ResourceWithConfigure    = &edgeGatewayResource{}
+	_ resource.ResourceWithImportState  = &edgeGatewayResource{}
+	_ resource.ResourceWithModifyPlan   = &edgeGatewayResource{}
+	_ resource.ResourceWithMoveState    = &edgeGatewayResource{}
+	_ resource.ResourceWithUpgradeState = &edgeGatewayResource{}
+
+	// edgeGatewayUpgradeSteps are the steps upgrading the state of the resource, the schema version is their number.
+	edgeGatewayUpgradeSteps = []stateupgrade.Step{
+		// 0 to 1: the deprecated lb_enabled attribute is set to false when it is missing, as done by the read.
+		func(s stateupgrade.State) error {
+			if !s.Has("lb_enabled") {
+				s["lb_enabled"] = false
+			}
+			return nil
+		},
+	}
 
 	// ConfigEdgeGateway is the default configuration for edge gateway.
 	ConfigEdgeGateway setDefaultEdgeGateway = func() EdgeGatewayConfig {
@@ -72,8 +88,14 @@ func (r *edgeGatewayResource) ModifyPlan(ctx context.Context, req resource.Modif
 		return
 	}
 
+	cavSDK, err := r.client.CAVSDK()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return
+	}
+
 	loadRemainingBandwidth := func() (int, error) {
-		edgegws, err := r.client.CAVSDK.V1.EdgeGateway.List()
+		edgegws, err := cavSDK.V1.EdgeGateway.List()
 		if err != nil {
 			return 0, err
 		}
@@ -82,7 +104,7 @@ func (r *edgeGatewayResource) ModifyPlan(ctx context.Context, req resource.Modif
 	}
 
 	allowedValuesFunc := func() {
-		allowedValues, err := r.client.CAVSDK.V1.EdgeGateway.GetAllowedBandwidthValues(plan.Tier0VrfID.Get())
+		allowedValues, err := cavSDK.V1.EdgeGateway.GetAllowedBandwidthValues(plan.Tier0VrfID.Get())
 		if err != nil {
 			resp.Diagnostics.AddError("Error on calculating allowed Bandwidth values", err.Error())
 			return
@@ -141,7 +163,7 @@ func (r *edgeGatewayResource) ModifyPlan(ctx context.Context, req resource.Modif
 			return
 		}
 
-		allowedValues, err := r.client.CAVSDK.V1.EdgeGateway.GetAllowedBandwidthValues(plan.Tier0VrfID.Get())
+		allowedValues, err := cavSDK.V1.EdgeGateway.GetAllowedBandwidthValues(plan.Tier0VrfID.Get())
 		if err != nil {
 			resp.Diagnostics.AddError("Error on calculating allowed Bandwidth values", err.Error())
 			return
@@ -155,7 +177,7 @@ func (r *edgeGatewayResource) ModifyPlan(ctx context.Context, req resource.Modif
 
 	// Update case
 	case !plan.Bandwidth.Equal(state.Bandwidth):
-		allowedValues, err := r.client.CAVSDK.V1.EdgeGateway.GetAllowedBandwidthValues(plan.Tier0VrfID.Get())
+		allowedValues, err := cavSDK.V1.EdgeGateway.GetAllowedBandwidthValues(plan.Tier0VrfID.Get())
 		if err != nil {
 			resp.Diagnostics.AddError("Error on calculating allowed Bandwidth values", err.Error())
 			return
@@ -188,6 +210,7 @@ func (r *edgeGatewayResource) Metadata(_ context.Context, req resource.MetadataR
 // Schema defines the schema for the resource.
 func (r *edgeGatewayResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = edgegwSchema().GetResource(ctx)
+	resp.Schema.Version = stateupgrade.Version(edgeGatewayUpgradeSteps)
 }
 
 func (r *edgeGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -216,7 +239,7 @@ func (r *edgeGatewayResource) Configure(ctx context.Context, req resource.Config
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *edgeGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &edgeGatewayResourceModel{}
 
@@ -239,8 +262,14 @@ func (r *edgeGatewayResource) Create(ctx context.Context, req resource.CreateReq
 	ctx, cancel = context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
+	cavSDK, err := r.client.CAVSDK()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return
+	}
+
 	// List all edge gateways for determining the ID of the new edge gateway
-	edgegws, err := r.client.CAVSDK.V1.EdgeGateway.List()
+	edgegws, err := cavSDK.V1.EdgeGateway.List()
 	if err != nil {
 		resp.Diagnostics.AddError("Error listing edge gateways", err.Error())
 		return
@@ -253,9 +282,9 @@ func (r *edgeGatewayResource) Create(ctx context.Context, req resource.CreateReq
 
 	switch plan.OwnerType.ValueString() {
 	case "vdc":
-		job, err = r.client.CAVSDK.V1.EdgeGateway.New(plan.OwnerName.Get(), plan.Tier0VrfID.Get())
+		job, err = cavSDK.V1.EdgeGateway.New(plan.OwnerName.Get(), plan.Tier0VrfID.Get())
 	case "vdc-group":
-		job, err = r.client.CAVSDK.V1.EdgeGateway.NewFromVDCGroup(plan.OwnerName.Get(), plan.Tier0VrfID.Get())
+		job, err = cavSDK.V1.EdgeGateway.NewFromVDCGroup(plan.OwnerName.Get(), plan.Tier0VrfID.Get())
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating edge gateway", err.Error())
@@ -267,7 +296,7 @@ func (r *edgeGatewayResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Find the new edge gateway
-	edgegwsRefreshed, err := r.client.CAVSDK.V1.EdgeGateway.List()
+	edgegwsRefreshed, err := cavSDK.V1.EdgeGateway.List()
 	if err != nil {
 		resp.Diagnostics.AddError("Error listing edge gateways", err.Error())
 		return
@@ -322,7 +351,7 @@ func (r *edgeGatewayResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *edgeGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &edgeGatewayResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -362,7 +391,7 @@ func (r *edgeGatewayResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *edgeGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	plan := &edgeGatewayResourceModel{}
 
@@ -391,7 +420,13 @@ func (r *edgeGatewayResource) Update(ctx context.Context, req resource.UpdateReq
 	ctx, cancel = context.WithTimeout(ctx, updateTimeout)
 	defer cancel()
 
-	edgegw, err := r.client.CAVSDK.V1.EdgeGateway.GetByID(plan.ID.Get())
+	cavSDK, err := r.client.CAVSDK()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return
+	}
+
+	edgegw, err := cavSDK.V1.EdgeGateway.GetByID(plan.ID.Get())
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving edge gateway", err.Error())
 		return
@@ -421,7 +456,7 @@ func (r *edgeGatewayResource) Update(ctx context.Context, req resource.UpdateReq
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *edgeGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &edgeGatewayResourceModel{}
 
@@ -443,7 +478,13 @@ func (r *edgeGatewayResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	edgegw, err := r.client.CAVSDK.V1.EdgeGateway.GetByID(state.ID.Get())
+	cavSDK, err := r.client.CAVSDK()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return
+	}
+
+	edgegw, err := cavSDK.V1.EdgeGateway.GetByID(state.ID.Get())
 	if err != nil {
 		if commoncloudavenue.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -466,24 +507,49 @@ func (r *edgeGatewayResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *edgeGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	// Retrieve import Name and save to name attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// The edge gateway is read by ID if the ID is known, by name otherwise.
+	if edgeGateway := id.Get("edge_gateway"); edgeGateway.IsID() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), edgeGateway.ID())...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), edgeGateway.Name())...)
+	}
+}
+
+// UpgradeState upgrades the state written with the prior schema versions of the resource.
+func (r *edgeGatewayResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateupgrade.Upgraders(edgeGatewayUpgradeSteps)
+}
+
+// MoveState moves the state of the vcd_nsxt_edgegateway resource of the vcd provider to the resource.
+func (r *edgeGatewayResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_edgegateway", nil),
+	}
 }
 
 // * Custom funcs.
 func (r *edgeGatewayResource) read(_ context.Context, planOrState *edgeGatewayResourceModel) (stateRefreshed *edgeGatewayResourceModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
-	var (
-		edgegw *v1.EdgeGw
-		err    error
-	)
+	cavSDK, err := r.client.CAVSDK()
+	if err != nil {
+		diags.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return nil, true, diags
+	}
+
+	var edgegw *v1.EdgeGw
 
 	switch {
 	case planOrState.ID.IsKnown():
-		edgegw, err = r.client.CAVSDK.V1.EdgeGateway.GetByID(planOrState.ID.Get())
+		edgegw, err = cavSDK.V1.EdgeGateway.GetByID(planOrState.ID.Get())
 		if err != nil {
 			if commoncloudavenue.IsNotFound(err) {
 				return nil, false, nil
@@ -492,7 +558,7 @@ func (r *edgeGatewayResource) read(_ context.Context, planOrState *edgeGatewayRe
 			return nil, true, diags
 		}
 	case planOrState.Name.IsKnown():
-		edgegw, err = r.client.CAVSDK.V1.EdgeGateway.GetByName(planOrState.Name.Get())
+		edgegw, err = cavSDK.V1.EdgeGateway.GetByName(planOrState.Name.Get())
 		if err != nil {
 			if commoncloudavenue.IsNotFound(err) {
 				return nil, false, nil
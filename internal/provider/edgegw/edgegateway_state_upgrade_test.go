@@ -0,0 +1,79 @@
+package edgegw_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/edgegw"
+)
+
+// Unit test for the upgrade of the states written with the prior schema versions of the resource cloudavenue_edgegateway.
+func TestEdgeGatewayResourceUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := edgegw.NewEdgeGatewayResource()
+
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	upgrader, ok := r.(fwresource.ResourceWithUpgradeState).UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("expected an upgrader for version 0")
+	}
+
+	tests := []struct {
+		name    string
+		fixture string
+	}{
+		{
+			// State written by the release 0.15.0 with the deprecated lb_enabled attribute.
+			name:    "LbEnabled",
+			fixture: "testdata/edgegateway_state_v0_15.json",
+		},
+		{
+			name:    "LbEnabledNull",
+			fixture: "testdata/edgegateway_state_v0_lb_null.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rawState, err := os.ReadFile(tt.fixture)
+			if err != nil {
+				t.Fatalf("error reading the fixture: %s", err)
+			}
+
+			resp := &fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+			upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: rawState}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got %v", resp.Diagnostics)
+			}
+
+			var ownerName supertypes.StringValue
+			if diags := resp.State.GetAttribute(ctx, path.Root("owner_name"), &ownerName); diags.HasError() || ownerName.ValueString() != "my-vdc" {
+				t.Errorf("owner_name: expected my-vdc, got %s", ownerName)
+			}
+
+			var bandwidth supertypes.Int64Value
+			if diags := resp.State.GetAttribute(ctx, path.Root("bandwidth"), &bandwidth); diags.HasError() || bandwidth.ValueInt64() != 25 {
+				t.Errorf("bandwidth: expected 25, got %s", bandwidth)
+			}
+
+			var lbEnabled supertypes.BoolValue
+			if diags := resp.State.GetAttribute(ctx, path.Root("lb_enabled"), &lbEnabled); diags.HasError() || lbEnabled.IsNull() || lbEnabled.ValueBool() {
+				t.Errorf("lb_enabled: expected false, got %s", lbEnabled)
+			}
+		})
+	}
+}
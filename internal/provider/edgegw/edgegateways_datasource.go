@@ -56,7 +56,7 @@ func (d *edgeGatewaysDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *edgeGatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateways", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateways", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 	var (
 		data  = new(edgeGatewaysDataSourceModel)
 		names = make([]string, 0)
@@ -68,7 +68,13 @@ func (d *edgeGatewaysDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	gateways, err := d.client.CAVSDK.V1.EdgeGateway.List()
+	cavSDK, err := d.client.CAVSDK()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return
+	}
+
+	gateways, err := cavSDK.V1.EdgeGateway.List()
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to list edge gateways", err.Error())
 		return
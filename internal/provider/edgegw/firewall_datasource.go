@@ -80,7 +80,7 @@ func (d *firewallDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *firewallDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway_firewall", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway_firewall", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &firewallModel{}
 
@@ -21,9 +21,10 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -31,6 +32,7 @@ var (
 	_ resource.Resource                = &firewallResource{}
 	_ resource.ResourceWithConfigure   = &firewallResource{}
 	_ resource.ResourceWithImportState = &firewallResource{}
+	_ resource.ResourceWithMoveState   = &firewallResource{}
 )
 
 // NewFirewallResource is a helper function to simplify the provider implementation.
@@ -236,7 +238,7 @@ func (r *firewallResource) Configure(ctx context.Context, req resource.Configure
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *firewallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { //nolint:dupl
-	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &firewallModel{}
 
@@ -299,7 +301,7 @@ func (r *firewallResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &firewallModel{}
 
@@ -331,7 +333,7 @@ func (r *firewallResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *firewallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:dupl
-	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	plan := &firewallModel{}
 
@@ -392,7 +394,7 @@ func (r *firewallResource) Update(ctx context.Context, req resource.UpdateReques
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &firewallModel{}
 
@@ -434,29 +436,23 @@ func (r *firewallResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *firewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway_firewall", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	var (
-		edgegwID   string
-		edgegwName string
-		d          diag.Diagnostics
-	)
-
-	r.org, d = org.Init(r.client)
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway})
 	if d.HasError() {
 		resp.Diagnostics.Append(d...)
 		return
 	}
 
-	if uuid.IsValid(req.ID) {
-		edgegwID = uuid.Normalize(uuid.Gateway, req.ID).String()
-	} else {
-		edgegwName = req.ID
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
 	}
 
 	edgegw, err := r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgegwID),
-		Name: types.StringValue(edgegwName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import firewall.", err.Error())
@@ -471,6 +467,13 @@ func (r *firewallResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), edgegw.GetName())...)
 }
 
+// MoveState moves the state of the vcd_nsxt_firewall resource of the vcd provider to the resource.
+func (r *firewallResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_firewall", nil),
+	}
+}
+
 func (r *firewallResource) read(ctx context.Context) (plan *firewallModel, diags diag.Diagnostics) {
 	fwRules, err := r.edgegw.GetNsxtFirewall()
 	if err != nil {
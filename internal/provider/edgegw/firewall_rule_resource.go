@@ -0,0 +1,486 @@
+// Package edgegw provides a Terraform resource.
+package edgegw
+
+import (
+	"context"
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &firewallRuleResource{}
+	_ resource.ResourceWithConfigure   = &firewallRuleResource{}
+	_ resource.ResourceWithImportState = &firewallRuleResource{}
+)
+
+// NewFirewallRuleResource is a helper function to simplify the provider implementation.
+func NewFirewallRuleResource() resource.Resource {
+	return &firewallRuleResource{}
+}
+
+// firewallRuleResource is the resource implementation.
+type firewallRuleResource struct {
+	client *client.CloudAvenue
+	org    org.Org
+	edgegw edgegw.EdgeGateway
+}
+
+// Init Initializes the resource.
+func (r *firewallRuleResource) Init(ctx context.Context, rm *FirewallRuleModel) (diags diag.Diagnostics) {
+	var err error
+
+	r.org, diags = org.Init(r.client)
+	if diags.HasError() {
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(rm.EdgeGatewayID.Get()),
+		Name: types.StringValue(rm.EdgeGatewayName.Get()),
+	})
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway", err.Error())
+		return
+	}
+
+	return
+}
+
+// Metadata returns the resource type name.
+func (r *firewallRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_firewall_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *firewallRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = firewallRuleSchema(ctx).GetResource(ctx)
+}
+
+func (r *firewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer metrics.New("cloudavenue_edgegateway_firewall_rule", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
+
+	plan := &FirewallRuleModel{}
+
+	// Retrieve values from plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource creation logic here.
+	*/
+
+	// The API has no endpoint to create a single rule, all the rules of the Edge Gateway are written at once.
+	vdcOrVDCGroup, err := r.edgegw.GetParent()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway parent", err.Error())
+		return
+	}
+	if vdcOrVDCGroup.IsVDCGroup() {
+		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
+	} else {
+		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
+	}
+
+	fwRules, err := r.edgegw.GetNsxtFirewall()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return
+	}
+
+	rule, d := plan.ToNsxtFirewallRule(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := placeFirewallRule(fwRules.NsxtFirewallRuleContainer.UserDefinedRules, rule, plan.Position.Get(), plan.PositionRuleID.Get())
+	if err != nil {
+		resp.Diagnostics.AddError("Error placing the firewall rule", err.Error())
+		return
+	}
+
+	updated, err := r.edgegw.UpdateNsxtFirewall(&govcdtypes.NsxtFirewallRuleContainer{
+		UserDefinedRules: rules,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating firewall rule", err.Error())
+		return
+	}
+
+	// The created rule is the only rule whose ID was unknown before the update.
+	existingIDs := make(map[string]bool)
+	for _, existing := range fwRules.NsxtFirewallRuleContainer.UserDefinedRules {
+		existingIDs[existing.ID] = true
+	}
+	for _, created := range updated.NsxtFirewallRuleContainer.UserDefinedRules {
+		if !existingIDs[created.ID] {
+			plan.ID.Set(created.ID)
+			break
+		}
+	}
+	if !plan.ID.IsKnown() {
+		resp.Diagnostics.AddError("Error creating firewall rule", "The created rule is not in the rules of the Edge Gateway")
+		return
+	}
+
+	state, found, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError("Error creating firewall rule", fmt.Sprintf("firewall rule %s not found after create", plan.Name.Get()))
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer metrics.New("cloudavenue_edgegateway_firewall_rule", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
+
+	state := &FirewallRuleModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource read here
+	*/
+
+	stateRefreshed, found, d := r.read(ctx, state)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer metrics.New("cloudavenue_edgegateway_firewall_rule", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
+
+	var (
+		plan  = &FirewallRuleModel{}
+		state = &FirewallRuleModel{}
+	)
+
+	// Get current plan and state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource update here
+	*/
+
+	// Lock object EdgeGateway
+	vdcOrVDCGroup, err := r.edgegw.GetParent()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway parent", err.Error())
+		return
+	}
+	if vdcOrVDCGroup.IsVDCGroup() {
+		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
+	} else {
+		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
+	}
+
+	fwRules, err := r.edgegw.GetNsxtFirewall()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return
+	}
+
+	rule, d := plan.ToNsxtFirewallRule(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules := fwRules.NsxtFirewallRuleContainer.UserDefinedRules
+	index := findFirewallRule(rules, state.ID.Get())
+	if index < 0 {
+		resp.Diagnostics.AddError("Error updating firewall rule", fmt.Sprintf("The rule %s is not in the rules of the Edge Gateway", state.ID.Get()))
+		return
+	}
+	rule.Version = rules[index].Version
+
+	// The rule is moved only if its position changes, otherwise it stays where it is.
+	if plan.Position.Equal(state.Position) && plan.PositionRuleID.Equal(state.PositionRuleID) {
+		rules[index] = rule
+	} else {
+		rules, err = placeFirewallRule(rules, rule, plan.Position.Get(), plan.PositionRuleID.Get())
+		if err != nil {
+			resp.Diagnostics.AddError("Error placing the firewall rule", err.Error())
+			return
+		}
+	}
+
+	if _, err := r.edgegw.UpdateNsxtFirewall(&govcdtypes.NsxtFirewallRuleContainer{
+		UserDefinedRules: rules,
+	}); err != nil {
+		resp.Diagnostics.AddError("Error updating firewall rule", err.Error())
+		return
+	}
+
+	stateRefreshed, found, d := r.read(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError("Error updating firewall rule", fmt.Sprintf("firewall rule %s not found after update", plan.Name.Get()))
+		return
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, stateRefreshed)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer metrics.New("cloudavenue_edgegateway_firewall_rule", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
+
+	state := &FirewallRuleModel{}
+
+	// Get current state
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Init the resource
+	resp.Diagnostics.Append(r.Init(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+		Implement the resource deletion here
+	*/
+
+	// Lock object EdgeGateway
+	vdcOrVDCGroup, err := r.edgegw.GetParent()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway parent", err.Error())
+		return
+	}
+	if vdcOrVDCGroup.IsVDCGroup() {
+		mutex.GlobalMutex.KvLock(ctx, vdcOrVDCGroup.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, vdcOrVDCGroup.GetID())
+	} else {
+		mutex.GlobalMutex.KvLock(ctx, r.edgegw.GetID())
+		defer mutex.GlobalMutex.KvUnlock(ctx, r.edgegw.GetID())
+	}
+
+	fwRules, err := r.edgegw.GetNsxtFirewall()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return
+	}
+
+	// The rule is already deleted
+	if findFirewallRule(fwRules.NsxtFirewallRuleContainer.UserDefinedRules, state.ID.Get()) < 0 {
+		return
+	}
+
+	if err := fwRules.DeleteRuleById(state.ID.Get()); err != nil {
+		resp.Diagnostics.AddError("Error deleting firewall rule", err.Error())
+		return
+	}
+}
+
+func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_edgegateway_firewall_rule", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	var err error
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.NameOrID("firewall_rule", "firewall rule")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	r.org, d = org.Init(r.client)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import firewall rule.", err.Error())
+		return
+	}
+
+	fwRules, err := r.edgegw.GetNsxtFirewall()
+	if err != nil {
+		resp.Diagnostics.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return
+	}
+
+	// The API does not enforce the uniqueness of the names.
+	var (
+		rule   *govcdtypes.NsxtFirewallRule
+		ruleID = id.Get("firewall_rule")
+	)
+	for _, existing := range fwRules.NsxtFirewallRuleContainer.UserDefinedRules {
+		if (ruleID.IsID() && existing.ID == ruleID.ID()) || (!ruleID.IsID() && existing.Name == ruleID.Name()) {
+			if rule != nil {
+				resp.Diagnostics.AddError("Failed to import firewall rule.", fmt.Sprintf("Several rules are named %s, import the rule with its ID", existing.Name))
+				return
+			}
+			rule = existing
+		}
+	}
+	if rule == nil {
+		resp.Diagnostics.AddError("Failed to import firewall rule.", fmt.Sprintf("The rule %s is not in the rules of the Edge Gateway", ruleID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rule.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_id"), r.edgegw.GetID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
+}
+
+// * CustomFuncs
+
+func (r *firewallRuleResource) read(ctx context.Context, planOrState *FirewallRuleModel) (stateRefreshed *FirewallRuleModel, found bool, diags diag.Diagnostics) {
+	stateRefreshed = planOrState.Copy()
+
+	fwRules, err := r.edgegw.GetNsxtFirewall()
+	if err != nil {
+		diags.AddError("Error retrieving Edge Gateway Firewall", err.Error())
+		return nil, true, diags
+	}
+
+	rules := fwRules.NsxtFirewallRuleContainer.UserDefinedRules
+	index := findFirewallRule(rules, planOrState.ID.Get())
+	if index < 0 {
+		return nil, false, nil
+	}
+
+	diags.Append(stateRefreshed.FromNsxtFirewallRule(ctx, rules[index])...)
+	stateRefreshed.EdgeGatewayID.Set(r.edgegw.GetID())
+	stateRefreshed.EdgeGatewayName.Set(r.edgegw.GetName())
+
+	return stateRefreshed, true, diags
+}
+
+// findFirewallRule returns the index of the rule with the ID, -1 if there is no such rule.
+func findFirewallRule(rules []*govcdtypes.NsxtFirewallRule, id string) int {
+	for i, rule := range rules {
+		if rule.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// placeFirewallRule returns the rules with the rule placed at the position.
+// The rule is moved if it is already in the rules.
+func placeFirewallRule(rules []*govcdtypes.NsxtFirewallRule, rule *govcdtypes.NsxtFirewallRule, position, positionRuleID string) ([]*govcdtypes.NsxtFirewallRule, error) {
+	placed := make([]*govcdtypes.NsxtFirewallRule, 0, len(rules)+1)
+	for _, r := range rules {
+		if rule.ID == "" || r.ID != rule.ID {
+			placed = append(placed, r)
+		}
+	}
+
+	switch position {
+	case firewallRulePositionFirst:
+		return append([]*govcdtypes.NsxtFirewallRule{rule}, placed...), nil
+	case firewallRulePositionBefore, firewallRulePositionAfter:
+		if rule.ID != "" && positionRuleID == rule.ID {
+			return nil, fmt.Errorf("the rule %s cannot be placed relative to itself", positionRuleID)
+		}
+
+		index := findFirewallRule(placed, positionRuleID)
+		if index < 0 {
+			return nil, fmt.Errorf("the rule %s is not in the rules of the Edge Gateway", positionRuleID)
+		}
+		if position == firewallRulePositionAfter {
+			index++
+		}
+		return append(placed[:index], append([]*govcdtypes.NsxtFirewallRule{rule}, placed[index:]...)...), nil
+	default:
+		return append(placed, rule), nil
+	}
+}
@@ -0,0 +1,187 @@
+package edgegw
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+)
+
+const (
+	firewallRulePositionFirst  = "FIRST"
+	firewallRulePositionLast   = "LAST"
+	firewallRulePositionBefore = "BEFORE"
+	firewallRulePositionAfter  = "AFTER"
+)
+
+func firewallRuleSchema(_ context.Context) superschema.Schema {
+	return superschema.Schema{
+		Resource: superschema.SchemaDetails{
+			MarkdownDescription: "The `cloudavenue_edgegateway_firewall_rule` resource allows you to manage a single firewall rule of an Edge Gateway. The rules not managed by the resource are kept, so several configurations can add rules to the same Edge Gateway.\n\n" +
+				"~> **Warning:** Do not use this resource with the `cloudavenue_edgegateway_firewall` resource on the same Edge Gateway, the latter manages all the rules and removes the rules it does not know.",
+		},
+		Attributes: map[string]superschema.Attribute{
+			"id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The ID of the firewall rule.",
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			"edge_gateway_name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"edge_gateway_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the Edge Gateway.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplaceIfConfigured(),
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("edge_gateway_name"), path.MatchRoot("edge_gateway_id")),
+					},
+				},
+			},
+			"name": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The name of the rule.",
+					Required:            true,
+				},
+			},
+			"direction": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The direction of the rule.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("IN", "OUT", "IN_OUT"),
+					},
+				},
+			},
+			"ip_protocol": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The IP protocol of the rule.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("IPV4"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("IPV4", "IPV6", "IPV4_IPV6"),
+					},
+				},
+			},
+			"action": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "Defines if the rule should `ALLOW` or `DROP` matching traffic.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("ALLOW", "DROP"),
+					},
+				},
+			},
+			"enabled": superschema.SuperBoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Defines if the rule is enabled or not.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+			},
+			"logging": superschema.SuperBoolAttribute{
+				Common: &schemaR.BoolAttribute{
+					MarkdownDescription: "Defines if the rule should log matching traffic.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+			"source_ids": superschema.SuperSetAttribute{
+				Common: &schemaR.SetAttribute{
+					MarkdownDescription: "A set of Source Firewall Group IDs (`IP Sets` or `Security Groups`). Leaving it empty means `Any` (all).",
+					ElementType:         supertypes.StringType{},
+					Optional:            true,
+				},
+			},
+			"destination_ids": superschema.SuperSetAttribute{
+				Common: &schemaR.SetAttribute{
+					MarkdownDescription: "A set of Destination Firewall Group IDs (`IP Sets` or `Security Groups`). Leaving it empty means `Any` (all).",
+					ElementType:         supertypes.StringType{},
+					Optional:            true,
+				},
+			},
+			"app_port_profile_ids": superschema.SuperSetAttribute{
+				Common: &schemaR.SetAttribute{
+					MarkdownDescription: "A set of Application Port Profile IDs. Leaving it empty means `Any` (all).",
+					ElementType:         supertypes.StringType{},
+					Optional:            true,
+				},
+			},
+			"position": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The position of the rule in the rules of the Edge Gateway. The rule is placed when it is created and moved when the position changes, the rules moved outside of Terraform are not detected. If not set, the rule is added after the existing rules.",
+					Optional:            true,
+					Validators: []validator.String{
+						fstringvalidator.OneOfWithDescription(
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       firewallRulePositionFirst,
+								Description: "The rule is placed before all the other rules.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       firewallRulePositionLast,
+								Description: "The rule is placed after all the other rules.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       firewallRulePositionBefore,
+								Description: "The rule is placed just before the rule `position_rule_id`.",
+							},
+							fstringvalidator.OneOfWithDescriptionValues{
+								Value:       firewallRulePositionAfter,
+								Description: "The rule is placed just after the rule `position_rule_id`.",
+							},
+						),
+					},
+				},
+			},
+			"position_rule_id": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					MarkdownDescription: "The ID of the rule the rule is placed before or after.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRoot("position")),
+						fstringvalidator.RequireIfAttributeIsOneOf(path.MatchRoot("position"), []attr.Value{types.StringValue(firewallRulePositionBefore), types.StringValue(firewallRulePositionAfter)}),
+						fstringvalidator.NullIfAttributeIsOneOf(path.MatchRoot("position"), []attr.Value{types.StringValue(firewallRulePositionFirst), types.StringValue(firewallRulePositionLast)}),
+					},
+				},
+			},
+		},
+	}
+}
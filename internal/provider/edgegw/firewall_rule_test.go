@@ -0,0 +1,65 @@
+package edgegw
+
+import (
+	"strings"
+	"testing"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+)
+
+func TestPlaceFirewallRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		ruleID         string
+		position       string
+		positionRuleID string
+		expected       string
+		expectedError  bool
+	}{
+		{name: "Default", position: "", expected: "a,b,c,new"},
+		{name: "First", position: firewallRulePositionFirst, expected: "new,a,b,c"},
+		{name: "Last", position: firewallRulePositionLast, expected: "a,b,c,new"},
+		{name: "Before", position: firewallRulePositionBefore, positionRuleID: "b", expected: "a,new,b,c"},
+		{name: "After", position: firewallRulePositionAfter, positionRuleID: "b", expected: "a,b,new,c"},
+		{name: "AfterLast", position: firewallRulePositionAfter, positionRuleID: "c", expected: "a,b,c,new"},
+		{name: "MoveFirst", ruleID: "c", position: firewallRulePositionFirst, expected: "c,a,b"},
+		{name: "MoveBefore", ruleID: "a", position: firewallRulePositionBefore, positionRuleID: "c", expected: "b,a,c"},
+		{name: "MoveAfter", ruleID: "a", position: firewallRulePositionAfter, positionRuleID: "b", expected: "b,a,c"},
+		{name: "UnknownRule", position: firewallRulePositionBefore, positionRuleID: "unknown", expectedError: true},
+		{name: "ItSelf", ruleID: "b", position: firewallRulePositionAfter, positionRuleID: "b", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules := []*govcdtypes.NsxtFirewallRule{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+			rule := &govcdtypes.NsxtFirewallRule{ID: tt.ruleID, Name: "new"}
+
+			placed, err := placeFirewallRule(rules, rule, tt.position, tt.positionRuleID)
+			if tt.expectedError {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			ids := make([]string, 0, len(placed))
+			for _, r := range placed {
+				if r.ID == "" {
+					ids = append(ids, r.Name)
+					continue
+				}
+				ids = append(ids, r.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package edgegw
+
+import (
+	"context"
+	"fmt"
+
+	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+)
+
+type FirewallRuleModel struct {
+	Action            supertypes.StringValue `tfsdk:"action"`
+	AppPortProfileIDs supertypes.SetValue    `tfsdk:"app_port_profile_ids"`
+	DestinationIDs    supertypes.SetValue    `tfsdk:"destination_ids"`
+	Direction         supertypes.StringValue `tfsdk:"direction"`
+	EdgeGatewayID     supertypes.StringValue `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName   supertypes.StringValue `tfsdk:"edge_gateway_name"`
+	Enabled           supertypes.BoolValue   `tfsdk:"enabled"`
+	ID                supertypes.StringValue `tfsdk:"id"`
+	IPProtocol        supertypes.StringValue `tfsdk:"ip_protocol"`
+	Logging           supertypes.BoolValue   `tfsdk:"logging"`
+	Name              supertypes.StringValue `tfsdk:"name"`
+	Position          supertypes.StringValue `tfsdk:"position"`
+	PositionRuleID    supertypes.StringValue `tfsdk:"position_rule_id"`
+	SourceIDs         supertypes.SetValue    `tfsdk:"source_ids"`
+}
+
+type FirewallRuleModelIDs []supertypes.StringValue
+
+func NewFirewallRule(t any) *FirewallRuleModel {
+	switch x := t.(type) {
+	case tfsdk.State:
+		return &FirewallRuleModel{
+			Action:            supertypes.NewStringNull(),
+			AppPortProfileIDs: supertypes.NewSetNull(x.Schema.GetAttributes()["app_port_profile_ids"].GetType().(supertypes.SetType).ElementType()),
+			DestinationIDs:    supertypes.NewSetNull(x.Schema.GetAttributes()["destination_ids"].GetType().(supertypes.SetType).ElementType()),
+			Direction:         supertypes.NewStringNull(),
+			EdgeGatewayID:     supertypes.NewStringUnknown(),
+			EdgeGatewayName:   supertypes.NewStringUnknown(),
+			Enabled:           supertypes.NewBoolUnknown(),
+			ID:                supertypes.NewStringUnknown(),
+			IPProtocol:        supertypes.NewStringUnknown(),
+			Logging:           supertypes.NewBoolUnknown(),
+			Name:              supertypes.NewStringNull(),
+			Position:          supertypes.NewStringNull(),
+			PositionRuleID:    supertypes.NewStringNull(),
+			SourceIDs:         supertypes.NewSetNull(x.Schema.GetAttributes()["source_ids"].GetType().(supertypes.SetType).ElementType()),
+		}
+
+	case tfsdk.Plan:
+		return &FirewallRuleModel{
+			Action:            supertypes.NewStringNull(),
+			AppPortProfileIDs: supertypes.NewSetNull(x.Schema.GetAttributes()["app_port_profile_ids"].GetType().(supertypes.SetType).ElementType()),
+			DestinationIDs:    supertypes.NewSetNull(x.Schema.GetAttributes()["destination_ids"].GetType().(supertypes.SetType).ElementType()),
+			Direction:         supertypes.NewStringNull(),
+			EdgeGatewayID:     supertypes.NewStringUnknown(),
+			EdgeGatewayName:   supertypes.NewStringUnknown(),
+			Enabled:           supertypes.NewBoolUnknown(),
+			ID:                supertypes.NewStringUnknown(),
+			IPProtocol:        supertypes.NewStringUnknown(),
+			Logging:           supertypes.NewBoolUnknown(),
+			Name:              supertypes.NewStringNull(),
+			Position:          supertypes.NewStringNull(),
+			PositionRuleID:    supertypes.NewStringNull(),
+			SourceIDs:         supertypes.NewSetNull(x.Schema.GetAttributes()["source_ids"].GetType().(supertypes.SetType).ElementType()),
+		}
+
+	case tfsdk.Config:
+		return &FirewallRuleModel{
+			Action:            supertypes.NewStringNull(),
+			AppPortProfileIDs: supertypes.NewSetNull(x.Schema.GetAttributes()["app_port_profile_ids"].GetType().(supertypes.SetType).ElementType()),
+			DestinationIDs:    supertypes.NewSetNull(x.Schema.GetAttributes()["destination_ids"].GetType().(supertypes.SetType).ElementType()),
+			Direction:         supertypes.NewStringNull(),
+			EdgeGatewayID:     supertypes.NewStringUnknown(),
+			EdgeGatewayName:   supertypes.NewStringUnknown(),
+			Enabled:           supertypes.NewBoolUnknown(),
+			ID:                supertypes.NewStringUnknown(),
+			IPProtocol:        supertypes.NewStringUnknown(),
+			Logging:           supertypes.NewBoolUnknown(),
+			Name:              supertypes.NewStringNull(),
+			Position:          supertypes.NewStringNull(),
+			PositionRuleID:    supertypes.NewStringNull(),
+			SourceIDs:         supertypes.NewSetNull(x.Schema.GetAttributes()["source_ids"].GetType().(supertypes.SetType).ElementType()),
+		}
+
+	default:
+		panic(fmt.Sprintf("unexpected type %T", t))
+	}
+}
+
+func (rm *FirewallRuleModel) Copy() *FirewallRuleModel {
+	x := &FirewallRuleModel{}
+	utils.ModelCopy(rm, x)
+	return x
+}
+
+// GetSourceIDs returns the value of the SourceIDs field.
+func (rm *FirewallRuleModel) GetSourceIDs(ctx context.Context) (values FirewallRuleModelIDs, diags diag.Diagnostics) {
+	values = make(FirewallRuleModelIDs, 0)
+	d := rm.SourceIDs.Get(ctx, &values, false)
+	return values, d
+}
+
+// GetDestinationIDs returns the value of the DestinationIDs field.
+func (rm *FirewallRuleModel) GetDestinationIDs(ctx context.Context) (values FirewallRuleModelIDs, diags diag.Diagnostics) {
+	values = make(FirewallRuleModelIDs, 0)
+	d := rm.DestinationIDs.Get(ctx, &values, false)
+	return values, d
+}
+
+// GetAppPortProfileIDs returns the value of the AppPortProfileIDs field.
+func (rm *FirewallRuleModel) GetAppPortProfileIDs(ctx context.Context) (values FirewallRuleModelIDs, diags diag.Diagnostics) {
+	values = make(FirewallRuleModelIDs, 0)
+	d := rm.AppPortProfileIDs.Get(ctx, &values, false)
+	return values, d
+}
+
+// ToOpenAPIReferences returns the IDs as OpenAPI references, nil if there is no ID (Any).
+func (r FirewallRuleModelIDs) ToOpenAPIReferences() []govcdtypes.OpenApiReference {
+	if len(r) == 0 {
+		return nil
+	}
+
+	refs := make([]govcdtypes.OpenApiReference, 0, len(r))
+	for _, id := range r {
+		refs = append(refs, govcdtypes.OpenApiReference{ID: id.Get()})
+	}
+	return refs
+}
+
+// ToNsxtFirewallRule returns the NSX-T firewall rule representation of the model.
+func (rm *FirewallRuleModel) ToNsxtFirewallRule(ctx context.Context) (*govcdtypes.NsxtFirewallRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourceIDs, d := rm.GetSourceIDs(ctx)
+	diags.Append(d...)
+	destinationIDs, d := rm.GetDestinationIDs(ctx)
+	diags.Append(d...)
+	appPortProfileIDs, d := rm.GetAppPortProfileIDs(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &govcdtypes.NsxtFirewallRule{
+		ID:                        rm.ID.Get(),
+		Name:                      rm.Name.Get(),
+		Action:                    rm.Action.Get(),
+		Enabled:                   rm.Enabled.Get(),
+		IpProtocol:                rm.IPProtocol.Get(),
+		Logging:                   rm.Logging.Get(),
+		Direction:                 rm.Direction.Get(),
+		SourceFirewallGroups:      sourceIDs.ToOpenAPIReferences(),
+		DestinationFirewallGroups: destinationIDs.ToOpenAPIReferences(),
+		ApplicationPortProfiles:   appPortProfileIDs.ToOpenAPIReferences(),
+	}, nil
+}
+
+// FromNsxtFirewallRule sets the model from the NSX-T firewall rule representation.
+func (rm *FirewallRuleModel) FromNsxtFirewallRule(ctx context.Context, rule *govcdtypes.NsxtFirewallRule) (diags diag.Diagnostics) {
+	rm.ID.Set(rule.ID)
+	rm.Name.Set(rule.Name)
+	rm.Action.Set(rule.Action)
+	rm.Enabled.Set(rule.Enabled)
+	rm.IPProtocol.Set(rule.IpProtocol)
+	rm.Logging.Set(rule.Logging)
+	rm.Direction.Set(rule.Direction)
+
+	for _, ids := range []struct {
+		value *supertypes.SetValue
+		refs  []govcdtypes.OpenApiReference
+	}{
+		{&rm.SourceIDs, rule.SourceFirewallGroups},
+		{&rm.DestinationIDs, rule.DestinationFirewallGroups},
+		{&rm.AppPortProfileIDs, rule.ApplicationPortProfiles},
+	} {
+		// An empty list means Any, it is not set in the configuration.
+		if len(ids.refs) == 0 {
+			ids.value.SetNull(ctx)
+			continue
+		}
+		diags.Append(ids.value.Set(ctx, utils.OpenAPIReferenceToSliceID(ids.refs))...)
+	}
+
+	return diags
+}
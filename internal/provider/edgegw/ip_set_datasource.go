@@ -78,7 +78,7 @@ func (d *ipSetDataSource) Configure(ctx context.Context, req datasource.Configur
 }
 
 func (d *ipSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway_ip_set", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway_ip_set", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &IPSetModel{}
 
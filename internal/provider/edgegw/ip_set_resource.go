@@ -4,7 +4,6 @@ package edgegw
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -18,9 +17,10 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &ipSetResource{}
 	_ resource.ResourceWithConfigure   = &ipSetResource{}
 	_ resource.ResourceWithImportState = &ipSetResource{}
+	_ resource.ResourceWithMoveState   = &ipSetResource{}
 	// _ resource.ResourceWithModifyPlan     = &ipSetResource{}
 	// _ resource.ResourceWithUpgradeState   = &ipSetResource{}
 	// _ resource.ResourceWithValidateConfig = &ipSetResource{}.
@@ -95,7 +96,7 @@ func (r *ipSetResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *ipSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &IPSetModel{}
 
@@ -160,7 +161,7 @@ func (r *ipSetResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *ipSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &IPSetModel{}
 
@@ -201,7 +202,7 @@ func (r *ipSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		state = &IPSetModel{}
 	)
 
-	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	// Get current plan and state
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
@@ -273,7 +274,7 @@ func (r *ipSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *ipSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &IPSetModel{}
 
@@ -322,24 +323,17 @@ func (r *ipSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ipSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway_ip_set", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	// id format is edgeGatewayIDOrName.ipSetName
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: edgeGatewayIDOrName.ipSetName. Got: %q", req.ID),
-		)
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.Name("ip_set", "IP set")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
 	var (
-		edgegwID, edgegwName string
-		d                    diag.Diagnostics
-		err                  error
-		ipSet                *govcd.NsxtFirewallGroup
+		err   error
+		ipSet *govcd.NsxtFirewallGroup
 	)
 
 	r.org, d = org.Init(r.client)
@@ -348,15 +342,9 @@ func (r *ipSetResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	if uuid.IsEdgeGateway(idParts[0]) {
-		edgegwID = idParts[0]
-	} else {
-		edgegwName = idParts[0]
-	}
-
 	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgegwID),
-		Name: types.StringValue(edgegwName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Security Group.", err.Error())
@@ -370,9 +358,9 @@ func (r *ipSetResource) ImportState(ctx context.Context, req resource.ImportStat
 	}
 
 	if vdcOrVDCGroup.IsVDCGroup() {
-		ipSet, err = vdcOrVDCGroup.GetIPSetByName(idParts[1])
+		ipSet, err = vdcOrVDCGroup.GetIPSetByName(id.Get("ip_set").Name())
 	} else {
-		ipSet, err = r.edgegw.GetIPSetByName(idParts[1])
+		ipSet, err = r.edgegw.GetIPSetByName(id.Get("ip_set").Name())
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving IP Set", err.Error())
@@ -385,6 +373,13 @@ func (r *ipSetResource) ImportState(ctx context.Context, req resource.ImportStat
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), ipSet.NsxtFirewallGroup.EdgeGatewayRef.Name)...)
 }
 
+// MoveState moves the state of the vcd_nsxt_ip_set resource of the vcd provider to the resource.
+func (r *ipSetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_ip_set", nil),
+	}
+}
+
 func (r *ipSetResource) read(ctx context.Context, planOrState *IPSetModel) (stateRefreshed *IPSetModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
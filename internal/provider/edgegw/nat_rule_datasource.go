@@ -78,7 +78,7 @@ func (d *natRuleDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *natRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway_nat_rule", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway_nat_rule", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &NATRuleModel{}
 
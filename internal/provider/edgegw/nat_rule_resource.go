@@ -4,7 +4,6 @@ package edgegw
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
@@ -17,10 +16,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &natRuleResource{}
 	_ resource.ResourceWithConfigure   = &natRuleResource{}
 	_ resource.ResourceWithImportState = &natRuleResource{}
+	_ resource.ResourceWithMoveState   = &natRuleResource{}
 )
 
 // NewNATRuleResource is a helper function to simplify the provider implementation.
@@ -92,7 +93,7 @@ func (r *natRuleResource) Configure(ctx context.Context, req resource.ConfigureR
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *natRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &NATRuleModel{}
 
@@ -156,7 +157,7 @@ func (r *natRuleResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *natRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &NATRuleModel{}
 
@@ -193,7 +194,7 @@ func (r *natRuleResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *natRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &NATRuleModel{}
@@ -265,7 +266,7 @@ func (r *natRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *natRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &NATRuleModel{}
 
@@ -314,19 +315,16 @@ func (r *natRuleResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 func (r *natRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var (
-		edgegwID, edgegwName string
-		d                    diag.Diagnostics
-		err                  error
-		natRule              *govcd.NsxtNatRule
+		d       diag.Diagnostics
+		err     error
+		natRule *govcd.NsxtNatRule
 	)
 
-	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway_nat_rule", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	// Split req.ID with dot. ID format is EdgeGatewayIDOrName.NATRuleNameOrID
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError("Invalid ID format", "ID format is EdgeGatewayIDOrName.NATRuleIDOrName")
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.NameOrID("nat_rule", "NAT rule")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
@@ -337,16 +335,9 @@ func (r *natRuleResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	// Get EdgeGW is ID or Name
-	if uuid.IsEdgeGateway(idParts[0]) {
-		edgegwID = idParts[0]
-	} else {
-		edgegwName = idParts[0]
-	}
-
 	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgegwID),
-		Name: types.StringValue(edgegwName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import NAT Rule.", err.Error())
@@ -354,10 +345,10 @@ func (r *natRuleResource) ImportState(ctx context.Context, req resource.ImportSt
 	}
 
 	// NATRule ID is not a URN
-	if uuid.IsUUIDV4(idParts[1]) {
-		natRule, err = r.edgegw.GetNatRuleById(idParts[1])
+	if rule := id.Get("nat_rule"); rule.IsID() {
+		natRule, err = r.edgegw.GetNatRuleById(rule.ID())
 	} else {
-		natRule, err = r.edgegw.GetNatRuleByName(idParts[1])
+		natRule, err = r.edgegw.GetNatRuleByName(rule.Name())
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to Get NAT Rule.", err.Error())
@@ -370,6 +361,13 @@ func (r *natRuleResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
 }
 
+// MoveState moves the state of the vcd_nsxt_nat_rule resource of the vcd provider to the resource.
+func (r *natRuleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_nat_rule", nil),
+	}
+}
+
 func (r *natRuleResource) read(planOrState *NATRuleModel) (stateRefreshed *NATRuleModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
@@ -77,7 +77,7 @@ func (d *securityGroupDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *securityGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway_security_group", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway_security_group", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &securityGroupModel{}
 
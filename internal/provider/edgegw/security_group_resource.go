@@ -4,7 +4,6 @@ package edgegw
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -18,6 +17,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                = &securityGroupResource{}
 	_ resource.ResourceWithConfigure   = &securityGroupResource{}
 	_ resource.ResourceWithImportState = &securityGroupResource{}
+	_ resource.ResourceWithMoveState   = &securityGroupResource{}
 )
 
 // NewSecurityGroupResource is a helper function to simplify the provider implementation.
@@ -93,7 +95,7 @@ func (r *securityGroupResource) Configure(ctx context.Context, req resource.Conf
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &securityGroupModel{}
 
@@ -146,7 +148,7 @@ func (r *securityGroupResource) Create(ctx context.Context, req resource.CreateR
 
 // Read refreshes the Terraform state with the latest data.
 func (r *securityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &securityGroupModel{}
 
@@ -188,7 +190,7 @@ func (r *securityGroupResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &securityGroupModel{}
@@ -257,7 +259,7 @@ func (r *securityGroupResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *securityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &securityGroupModel{}
 
@@ -300,24 +302,15 @@ func (r *securityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *securityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway_security_group", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	// id format is edgeGatewayIDOrName.securityGroupIDOrName
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: edgeGatewayIDOrName.securityGroupIDOrName. Got: %q", req.ID),
-		)
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.NameOrURN("security_group", "security group", uuid.SecurityGroup)})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
-	var (
-		id, name, edgegwID, edgegwName string
-		d                              diag.Diagnostics
-		err                            error
-	)
+	var err error
 
 	r.org, d = org.Init(r.client)
 	if d.HasError() {
@@ -325,21 +318,9 @@ func (r *securityGroupResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	if uuid.IsEdgeGateway(idParts[0]) {
-		edgegwID = idParts[0]
-	} else {
-		edgegwName = idParts[0]
-	}
-
-	if uuid.IsSecurityGroup(idParts[1]) {
-		id = idParts[1]
-	} else {
-		name = idParts[1]
-	}
-
 	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgegwID),
-		Name: types.StringValue(edgegwName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Security Group.", err.Error())
@@ -347,8 +328,8 @@ func (r *securityGroupResource) ImportState(ctx context.Context, req resource.Im
 	}
 
 	securityGroup, err := r.getSecurityGroup(ctx, &securityGroupModel{
-		ID:   utils.StringValueOrNull(id),
-		Name: utils.StringValueOrNull(name),
+		ID:   utils.StringValueOrNull(id.Get("security_group").ID()),
+		Name: utils.StringValueOrNull(id.Get("security_group").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import Security Group.", err.Error())
@@ -365,6 +346,13 @@ func (r *securityGroupResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
 }
 
+// MoveState moves the state of the vcd_nsxt_security_group resource of the vcd provider to the resource.
+func (r *securityGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_security_group", nil),
+	}
+}
+
 func (r *securityGroupResource) read(ctx context.Context, securityGroup *govcd.NsxtFirewallGroup) (plan *securityGroupModel, diags diag.Diagnostics) {
 	if securityGroup == nil || securityGroup.NsxtFirewallGroup == nil {
 		diags.AddError("Error retrieving Security Group", "Security Group not found")
@@ -78,7 +78,7 @@ func (d *staticRouteDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *staticRouteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway_static_route", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway_static_route", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &StaticRouteModel{}
 
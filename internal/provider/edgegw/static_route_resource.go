@@ -4,7 +4,6 @@ package edgegw
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
@@ -17,10 +16,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &staticRouteResource{}
 	_ resource.ResourceWithConfigure   = &staticRouteResource{}
 	_ resource.ResourceWithImportState = &staticRouteResource{}
+	_ resource.ResourceWithMoveState   = &staticRouteResource{}
 )
 
 // NewStaticRouteResource is a helper function to simplify the provider implementation.
@@ -92,7 +93,7 @@ func (r *staticRouteResource) Configure(ctx context.Context, req resource.Config
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &StaticRouteModel{}
 
@@ -151,7 +152,7 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *staticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &StaticRouteModel{}
 
@@ -188,7 +189,7 @@ func (r *staticRouteResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &StaticRouteModel{}
@@ -258,7 +259,7 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &StaticRouteModel{}
 
@@ -305,20 +306,16 @@ func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *staticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway_static_route", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
 	var (
-		edgegwID, edgegwName string
-		d                    diag.Diagnostics
-		err                  error
-		staticRoute          *govcd.NsxtEdgeGatewayStaticRoute
+		err         error
+		staticRoute *govcd.NsxtEdgeGatewayStaticRoute
 	)
 
-	// Split req.ID with dot. ID format is EdgeGatewayIDOrName.StaticRouteNameOrID
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError("Invalid ID format", "ID format is EdgeGatewayIDOrName.StaticRouteNameOrID")
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.NameOrID("static_route", "static route")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
@@ -328,29 +325,23 @@ func (r *staticRouteResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	if uuid.IsEdgeGateway(idParts[0]) {
-		edgegwID = idParts[0]
-	} else {
-		edgegwName = idParts[0]
-	}
-
 	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgegwID),
-		Name: types.StringValue(edgegwName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to import DHCP Forwarding.", err.Error())
+		resp.Diagnostics.AddError("Failed to import Static Route.", err.Error())
 		return
 	}
 
 	// Static Route ID is not a URN
-	if uuid.IsUUIDV4(idParts[1]) {
-		staticRoute, err = r.edgegw.GetStaticRouteById(idParts[1])
+	if route := id.Get("static_route"); route.IsID() {
+		staticRoute, err = r.edgegw.GetStaticRouteById(route.ID())
 	} else {
-		staticRoute, err = r.edgegw.GetStaticRouteByName(idParts[1])
+		staticRoute, err = r.edgegw.GetStaticRouteByName(route.Name())
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to Get DHCP Forwarding.", err.Error())
+		resp.Diagnostics.AddError("Failed to Get Static Route.", err.Error())
 		return
 	}
 
@@ -360,6 +351,13 @@ func (r *staticRouteResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
 }
 
+// MoveState moves the state of the vcd_nsxt_edgegateway_static_route resource of the vcd provider to the resource.
+func (r *staticRouteResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_edgegateway_static_route", nil),
+	}
+}
+
 // * CustomFuncs
 
 func (r *staticRouteResource) read(ctx context.Context, planOrState *StaticRouteModel) (stateRefreshed *StaticRouteModel, found bool, diags diag.Diagnostics) {
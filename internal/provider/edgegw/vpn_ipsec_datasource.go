@@ -77,7 +77,7 @@ func (d *vpnIpsecDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *vpnIpsecDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_edgegateway_vpn_ipsec", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_edgegateway_vpn_ipsec", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &VPNIPSecModel{}
 
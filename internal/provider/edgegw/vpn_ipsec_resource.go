@@ -19,9 +19,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/writeonly"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -29,6 +31,7 @@ var (
 	_ resource.Resource                = &vpnIPSecResource{}
 	_ resource.ResourceWithConfigure   = &vpnIPSecResource{}
 	_ resource.ResourceWithImportState = &vpnIPSecResource{}
+	_ resource.ResourceWithMoveState   = &vpnIPSecResource{}
 )
 
 // NewVpnIpsecResource is a helper function to simplify the provider implementation.
@@ -71,7 +74,7 @@ func (r *vpnIPSecResource) Metadata(_ context.Context, req resource.MetadataRequ
 
 // Schema defines the schema for the resource.
 func (r *vpnIPSecResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = vpnIPSecSchema(ctx).GetResource(ctx)
+	resp.Schema = writeonly.Set(vpnIPSecSchema(ctx).GetResource(ctx))
 }
 
 func (r *vpnIPSecResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -93,12 +96,14 @@ func (r *vpnIPSecResource) Configure(ctx context.Context, req resource.Configure
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *vpnIPSecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &VPNIPSecModel{}
 
 	// Retrieve values from plan
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	// Write-only attributes are only available in the config.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pre_shared_key_wo"), &plan.PreSharedKeyWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -179,7 +184,7 @@ func (r *vpnIPSecResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *vpnIPSecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &VPNIPSecModel{}
 
@@ -216,7 +221,7 @@ func (r *vpnIPSecResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &VPNIPSecModel{}
@@ -226,6 +231,8 @@ func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateReques
 	// Get current plan and state
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	// Write-only attributes are only available in the config.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pre_shared_key_wo"), &plan.PreSharedKeyWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -275,6 +282,11 @@ func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// The write-only pre-shared key is only sent when its version changes, the current key is kept otherwise.
+	if plan.PreSharedKeyWO.IsKnown() && plan.PreSharedKeyWOVersion.Equal(state.PreSharedKeyWOVersion) {
+		planVPNTunnel.PreSharedKey = existingIPSecVPNConfiguration.NsxtIpSecVpn.PreSharedKey
+	}
+
 	// Update VPN Tunnel
 	if _, err = existingIPSecVPNConfiguration.Update(planVPNTunnel); err != nil {
 		resp.Diagnostics.AddError("Error updating VPN Tunnel configuration", err.Error())
@@ -307,7 +319,7 @@ func (r *vpnIPSecResource) Update(ctx context.Context, req resource.UpdateReques
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *vpnIPSecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &VPNIPSecModel{}
 
@@ -355,20 +367,18 @@ func (r *vpnIPSecResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *vpnIPSecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_edgegateway_vpn_ipsec", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
 	var (
-		edgegwID, edgegwName string
-		d                    diag.Diagnostics
-		err                  error
-		vpnIPSec             *govcd.NsxtIpSecVpnTunnel
+		d        diag.Diagnostics
+		err      error
+		vpnIPSec *govcd.NsxtIpSecVpnTunnel
 	)
 
-	// Split req.ID with dot. ID format is EdgeGatewayIDOrName.VPNIPSecIDOrName
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 2 {
-		resp.Diagnostics.AddError("Invalid ID format", "ID format is EdgeGatewayIDOrName.VPNIPSecIDOrName. If Several Name are the same, please use ID instead")
+	// If several tunnels have the same name, the ID must be used instead.
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.NameOrID("vpn_ipsec", "IPSec VPN tunnel")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
@@ -379,16 +389,9 @@ func (r *vpnIPSecResource) ImportState(ctx context.Context, req resource.ImportS
 		return
 	}
 
-	// Get EdgeGW is ID or Name
-	if uuid.IsEdgeGateway(idParts[0]) {
-		edgegwID = idParts[0]
-	} else {
-		edgegwName = idParts[0]
-	}
-
 	r.edgegw, err = r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgegwID),
-		Name: types.StringValue(edgegwName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to import VPN IPSec.", err.Error())
@@ -396,9 +399,11 @@ func (r *vpnIPSecResource) ImportState(ctx context.Context, req resource.ImportS
 	}
 
 	// Get VPN IPSec
-	vpnIPSec, err = r.edgegw.GetIpSecVpnTunnelByName(idParts[1])
-	if govcd.ContainsNotFound(err) {
-		vpnIPSec, err = r.edgegw.GetIpSecVpnTunnelById(idParts[1])
+	tunnel := id.Get("vpn_ipsec")
+	if tunnel.IsID() {
+		vpnIPSec, err = r.edgegw.GetIpSecVpnTunnelById(tunnel.ID())
+	} else {
+		vpnIPSec, err = r.edgegw.GetIpSecVpnTunnelByName(tunnel.Name())
 	}
 
 	if err != nil {
@@ -407,7 +412,7 @@ func (r *vpnIPSecResource) ImportState(ctx context.Context, req resource.ImportS
 			resp.Diagnostics.AddError("Failed to Get ALL VPN IPSec.", err.Error())
 			return
 		}
-		listStr := getVPNIPSecTunnelsList(idParts[1], allRules)
+		listStr := getVPNIPSecTunnelsList(tunnel.String(), allRules)
 		resp.Diagnostics.AddError("Failed to Get VPN IPSec "+listStr, err.Error())
 		return
 	}
@@ -418,6 +423,13 @@ func (r *vpnIPSecResource) ImportState(ctx context.Context, req resource.ImportS
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("edge_gateway_name"), r.edgegw.GetName())...)
 }
 
+// MoveState moves the state of the vcd_nsxt_ipsec_vpn_tunnel resource of the vcd provider to the resource.
+func (r *vpnIPSecResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_ipsec_vpn_tunnel", nil),
+	}
+}
+
 func (r *vpnIPSecResource) read(ctx context.Context, planOrState *VPNIPSecModel) (stateRefreshed *VPNIPSecModel, found bool, diags diag.Diagnostics) {
 	stateRefreshed = planOrState.Copy()
 
@@ -448,7 +460,11 @@ func (r *vpnIPSecResource) read(ctx context.Context, planOrState *VPNIPSecModel)
 	stateRefreshed.LocalIPAddress.Set(vpnTunnel.NsxtIpSecVpn.LocalEndpoint.LocalAddress)
 	stateRefreshed.LocalNetworks.Set(ctx, vpnTunnel.NsxtIpSecVpn.LocalEndpoint.LocalNetworks)
 	stateRefreshed.Name.Set(vpnTunnel.NsxtIpSecVpn.Name)
-	stateRefreshed.PreSharedKey.Set(vpnTunnel.NsxtIpSecVpn.PreSharedKey)
+	// The pre-shared key set with the write-only attribute must not be stored in the state.
+	stateRefreshed.PreSharedKeyWO.SetNull()
+	if !stateRefreshed.PreSharedKeyWOVersion.IsKnown() {
+		stateRefreshed.PreSharedKey.Set(vpnTunnel.NsxtIpSecVpn.PreSharedKey)
+	}
 	stateRefreshed.RemoteIPAddress.Set(vpnTunnel.NsxtIpSecVpn.RemoteEndpoint.RemoteAddress)
 	stateRefreshed.RemoteNetworks.Set(ctx, vpnTunnel.NsxtIpSecVpn.RemoteEndpoint.RemoteNetworks)
 	stateRefreshed.SecurityType.Set(vpnTunnel.NsxtIpSecVpn.SecurityType)
@@ -23,6 +23,8 @@ import (
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
 	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
 	fstringvalidator "github.com/FrangipaneTeam/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/writeonly"
 )
 
 func vpnIPSecSchema(_ context.Context) superschema.Schema {
@@ -119,12 +121,44 @@ func vpnIPSecSchema(_ context.Context) superschema.Schema {
 					Sensitive:           true,
 				},
 				Resource: &schemaR.StringAttribute{
-					Required: true,
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("pre_shared_key"), path.MatchRoot("pre_shared_key_wo")),
+					},
 				},
 				DataSource: &schemaD.StringAttribute{
 					Computed: true,
 				},
 			},
+			"pre_shared_key_wo": superschema.SuperStringAttribute{
+				Common: &schemaR.StringAttribute{
+					Sensitive: true,
+				},
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: writeonly.Description("pre_shared_key", "pre_shared_key_wo"),
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRoot("pre_shared_key_wo_version")),
+					},
+				},
+				DataSource: &schemaD.StringAttribute{
+					MarkdownDescription: "This attribute is not set in the data source.",
+					Computed:            true,
+				},
+			},
+			"pre_shared_key_wo_version": superschema.SuperInt64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: writeonly.VersionDescription("pre_shared_key_wo"),
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AlsoRequires(path.MatchRoot("pre_shared_key_wo")),
+					},
+				},
+				DataSource: &schemaD.Int64Attribute{
+					MarkdownDescription: "This attribute is not set in the data source.",
+					Computed:            true,
+				},
+			},
 			"local_ip_address": superschema.SuperStringAttribute{
 				Common: &schemaR.StringAttribute{
 					MarkdownDescription: "An IPv4 Address for the local endpoint. This has to be a sub-allocated IP on the Edge Gateway. This endpoint must be reach by the remote endpoint.",
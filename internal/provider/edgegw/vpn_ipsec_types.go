@@ -14,19 +14,21 @@ import (
 )
 
 type VPNIPSecModel struct {
-	Description     supertypes.StringValue       `tfsdk:"description"`
-	EdgeGatewayID   supertypes.StringValue       `tfsdk:"edge_gateway_id"`
-	EdgeGatewayName supertypes.StringValue       `tfsdk:"edge_gateway_name"`
-	Enabled         supertypes.BoolValue         `tfsdk:"enabled"`
-	ID              supertypes.StringValue       `tfsdk:"id"`
-	LocalIPAddress  supertypes.StringValue       `tfsdk:"local_ip_address"`
-	LocalNetworks   supertypes.SetValue          `tfsdk:"local_networks"`
-	Name            supertypes.StringValue       `tfsdk:"name"`
-	PreSharedKey    supertypes.StringValue       `tfsdk:"pre_shared_key"`
-	RemoteIPAddress supertypes.StringValue       `tfsdk:"remote_ip_address"`
-	RemoteNetworks  supertypes.SetValue          `tfsdk:"remote_networks"`
-	SecurityProfile supertypes.SingleNestedValue `tfsdk:"security_profile"`
-	SecurityType    supertypes.StringValue       `tfsdk:"security_type"`
+	Description           supertypes.StringValue       `tfsdk:"description"`
+	EdgeGatewayID         supertypes.StringValue       `tfsdk:"edge_gateway_id"`
+	EdgeGatewayName       supertypes.StringValue       `tfsdk:"edge_gateway_name"`
+	Enabled               supertypes.BoolValue         `tfsdk:"enabled"`
+	ID                    supertypes.StringValue       `tfsdk:"id"`
+	LocalIPAddress        supertypes.StringValue       `tfsdk:"local_ip_address"`
+	LocalNetworks         supertypes.SetValue          `tfsdk:"local_networks"`
+	Name                  supertypes.StringValue       `tfsdk:"name"`
+	PreSharedKey          supertypes.StringValue       `tfsdk:"pre_shared_key"`
+	PreSharedKeyWO        supertypes.StringValue       `tfsdk:"pre_shared_key_wo"`
+	PreSharedKeyWOVersion supertypes.Int64Value        `tfsdk:"pre_shared_key_wo_version"`
+	RemoteIPAddress       supertypes.StringValue       `tfsdk:"remote_ip_address"`
+	RemoteNetworks        supertypes.SetValue          `tfsdk:"remote_networks"`
+	SecurityProfile       supertypes.SingleNestedValue `tfsdk:"security_profile"`
+	SecurityType          supertypes.StringValue       `tfsdk:"security_type"`
 }
 
 type VPNIPSecModelLocalNetworks []supertypes.StringValue
@@ -142,12 +144,20 @@ func (rm VPNIPSecModelRemoteNetworks) Get() []string {
 	return utils.SuperSliceTypesStringToSliceString(rm)
 }
 
+// GetPreSharedKey returns the value of the PreSharedKeyWO field if set, the value of the PreSharedKey field otherwise.
+func (rm *VPNIPSecModel) GetPreSharedKey() string {
+	if rm.PreSharedKeyWO.IsKnown() {
+		return rm.PreSharedKeyWO.Get()
+	}
+	return rm.PreSharedKey.Get()
+}
+
 func (rm *VPNIPSecModel) ToNsxtIPSecVPNTunnel(ctx context.Context) (values *govcdtypes.NsxtIpSecVpnTunnel, diags diag.Diagnostics) {
 	values = &govcdtypes.NsxtIpSecVpnTunnel{
 		Name:                    rm.Name.Get(),
 		Description:             rm.Description.Get(),
 		Enabled:                 rm.Enabled.Get(),
-		PreSharedKey:            rm.PreSharedKey.Get(),
+		PreSharedKey:            rm.GetPreSharedKey(),
 		Logging:                 false,             // not available on cloudavenue
 		AuthenticationMode:      vpnAuthentication, // Only PSK is supported on cloudavenue
 		ConnectorInitiationMode: vpnModeInit,
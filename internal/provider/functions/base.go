@@ -0,0 +1,29 @@
+// Package functions provides the provider-defined functions of the provider.
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// urnTypes returns the sorted list of the known URN types.
+func urnTypes() string {
+	types := make([]string, 0, len(uuid.Prefixes()))
+	for _, prefix := range uuid.Prefixes() {
+		types = append(types, prefix.Type())
+	}
+	sort.Strings(types)
+	return strings.Join(types, ", ")
+}
+
+// prefixFromType returns the prefix of the URN type or an error listing the known types.
+func prefixFromType(urnType string) (uuid.VcloudUUID, error) {
+	prefix := uuid.PrefixFromType(urnType)
+	if prefix == "" {
+		return "", fmt.Errorf("unknown URN type %q, must be one of: %s", urnType, urnTypes())
+	}
+	return prefix, nil
+}
@@ -0,0 +1,70 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+var _ function.Function = &buildURNFunction{}
+
+// NewBuildURNFunction is a helper function to simplify the provider implementation.
+func NewBuildURNFunction() function.Function {
+	return &buildURNFunction{}
+}
+
+type buildURNFunction struct{}
+
+// Metadata returns the function name.
+func (f *buildURNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_urn"
+}
+
+// Definition defines the parameters and the return of the function.
+func (f *buildURNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a URN from a type and a UUID.",
+		MarkdownDescription: fmt.Sprintf("Builds the URN of the type from the UUID (`vm` and `<uuid>` give `urn:vcloud:vm:<uuid>`). A URN of the same type is returned unchanged. The type must be one of: %s.", urnTypes()),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "The type of the URN (`vm`, `vdc`, `gateway`, `vdcGroup`, `vcda`...).",
+			},
+			function.StringParameter{
+				Name:                "uuid",
+				MarkdownDescription: "The UUID of the object.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the URN.
+func (f *buildURNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var urnType, id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &urnType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := prefixFromType(urnType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	urn := uuid.VcloudUUID(id)
+	if !urn.IsType(prefix) {
+		if !uuid.IsUUIDV4(id) {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a valid UUID", id))
+			return
+		}
+		urn = prefix + urn
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, urn.String()))
+}
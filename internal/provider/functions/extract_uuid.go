@@ -0,0 +1,57 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common"
+)
+
+var _ function.Function = &extractUUIDFunction{}
+
+// NewExtractUUIDFunction is a helper function to simplify the provider implementation.
+func NewExtractUUIDFunction() function.Function {
+	return &extractUUIDFunction{}
+}
+
+type extractUUIDFunction struct{}
+
+// Metadata returns the function name.
+func (f *extractUUIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "extract_uuid"
+}
+
+// Definition defines the parameters and the return of the function.
+func (f *extractUUIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Extract the UUID of a value.",
+		MarkdownDescription: "Returns the last UUID found in the value, such as a URN (`urn:vcloud:vm:<uuid>`) or an API URL (`https://.../api/vApp/vm-<uuid>`). The function fails if the value does not contain any UUID.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The value containing the UUID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run extracts the UUID.
+func (f *extractUUIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	id := common.ExtractUUID(value)
+	if id == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("no UUID found in %q", value))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, id))
+}
@@ -0,0 +1,259 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/functions"
+)
+
+const validUUIDv4 = "12345678-1234-1234-1234-123456789012"
+
+// run calls the function with the arguments and returns the result and the error.
+func run(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseURN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		urn       string
+		namespace string
+		urnType   string
+		expectErr bool
+	}{
+		{
+			name:      "VM",
+			urn:       "urn:vcloud:vm:" + validUUIDv4,
+			namespace: "vcloud",
+			urnType:   "vm",
+		},
+		{
+			name:      "VCDA",
+			urn:       "urn:cloudavenue:vcda:" + validUUIDv4,
+			namespace: "cloudavenue",
+			urnType:   "vcda",
+		},
+		{
+			name:      "UnknownType",
+			urn:       "urn:vcloud:unknown:" + validUUIDv4,
+			expectErr: true,
+		},
+		{
+			name:      "InvalidUUID",
+			urn:       "urn:vcloud:vm:1234",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := run(t, functions.NewParseURNFunction(), types.ObjectUnknown(map[string]attr.Type{
+				"namespace": types.StringType,
+				"type":      types.StringType,
+				"uuid":      types.StringType,
+			}), types.StringValue(tt.urn))
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			attributes := result.(types.Object).Attributes()
+			if got := attributes["namespace"].(types.String).ValueString(); got != tt.namespace {
+				t.Fatalf("expected namespace %s, got %s", tt.namespace, got)
+			}
+			if got := attributes["type"].(types.String).ValueString(); got != tt.urnType {
+				t.Fatalf("expected type %s, got %s", tt.urnType, got)
+			}
+			if got := attributes["uuid"].(types.String).ValueString(); got != validUUIDv4 {
+				t.Fatalf("expected uuid %s, got %s", validUUIDv4, got)
+			}
+		})
+	}
+}
+
+func TestBuildURN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		urnType   string
+		uuid      string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "VDCGroup",
+			urnType:  "vdcGroup",
+			uuid:     validUUIDv4,
+			expected: "urn:vcloud:vdcGroup:" + validUUIDv4,
+		},
+		{
+			name:     "AlreadyURN",
+			urnType:  "gateway",
+			uuid:     "urn:vcloud:gateway:" + validUUIDv4,
+			expected: "urn:vcloud:gateway:" + validUUIDv4,
+		},
+		{
+			name:      "URNOfAnotherType",
+			urnType:   "vm",
+			uuid:      "urn:vcloud:gateway:" + validUUIDv4,
+			expectErr: true,
+		},
+		{
+			name:      "UnknownType",
+			urnType:   "unknown",
+			uuid:      validUUIDv4,
+			expectErr: true,
+		},
+		{
+			name:      "InvalidUUID",
+			urnType:   "vm",
+			uuid:      "1234",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := run(t, functions.NewBuildURNFunction(), types.StringUnknown(), types.StringValue(tt.urnType), types.StringValue(tt.uuid))
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := result.(types.String).ValueString(); got != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsURNOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		urnType   string
+		value     string
+		expected  bool
+		expectErr bool
+	}{
+		{
+			name:     "Match",
+			urnType:  "vdc",
+			value:    "urn:vcloud:vdc:" + validUUIDv4,
+			expected: true,
+		},
+		{
+			name:     "OtherType",
+			urnType:  "vdc",
+			value:    "urn:vcloud:vdcGroup:" + validUUIDv4,
+			expected: false,
+		},
+		{
+			name:     "NotAURN",
+			urnType:  "vdc",
+			value:    validUUIDv4,
+			expected: false,
+		},
+		{
+			name:      "UnknownType",
+			urnType:   "unknown",
+			value:     validUUIDv4,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := run(t, functions.NewIsURNOfFunction(), types.BoolUnknown(), types.StringValue(tt.urnType), types.StringValue(tt.value))
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := result.(types.Bool).ValueBool(); got != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestExtractUUID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "URN",
+			value:    "urn:vcloud:vm:" + validUUIDv4,
+			expected: validUUIDv4,
+		},
+		{
+			name:     "HREF",
+			value:    "https://console1.cloudavenue.orange-business.com/api/vApp/vm-" + validUUIDv4,
+			expected: validUUIDv4,
+		},
+		{
+			name:      "NoUUID",
+			value:     "vm01",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := run(t, functions.NewExtractUUIDFunction(), types.StringUnknown(), types.StringValue(tt.value))
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := result.(types.String).ValueString(); got != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
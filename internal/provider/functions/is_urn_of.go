@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+var _ function.Function = &isURNOfFunction{}
+
+// NewIsURNOfFunction is a helper function to simplify the provider implementation.
+func NewIsURNOfFunction() function.Function {
+	return &isURNOfFunction{}
+}
+
+type isURNOfFunction struct{}
+
+// Metadata returns the function name.
+func (f *isURNOfFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_urn_of"
+}
+
+// Definition defines the parameters and the return of the function.
+func (f *isURNOfFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Check if a value is a URN of a type.",
+		MarkdownDescription: "Returns `true` if the value is a valid URN of the type (`urn:vcloud:vm:<uuid>` is a URN of type `vm`), `false` otherwise. The function fails if the type is unknown.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "The type of the URN (`vm`, `vdc`, `gateway`, `vdcGroup`, `vcda`...).",
+			},
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The value to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run checks the type of the URN.
+func (f *isURNOfFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var urnType, value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &urnType, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := prefixFromType(urnType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, uuid.VcloudUUID(value).IsType(prefix)))
+}
@@ -0,0 +1,78 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+var _ function.Function = &parseURNFunction{}
+
+// parseURNAttrTypes are the attributes of the object returned by the parse_urn function.
+var parseURNAttrTypes = map[string]attr.Type{
+	"namespace": types.StringType,
+	"type":      types.StringType,
+	"uuid":      types.StringType,
+}
+
+// NewParseURNFunction is a helper function to simplify the provider implementation.
+func NewParseURNFunction() function.Function {
+	return &parseURNFunction{}
+}
+
+type parseURNFunction struct{}
+
+// Metadata returns the function name.
+func (f *parseURNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_urn"
+}
+
+// Definition defines the parameters and the return of the function.
+func (f *parseURNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a URN into its namespace, type and UUID.",
+		MarkdownDescription: "Parses a vCloud or Cloud Avenue URN (`urn:vcloud:vm:<uuid>`) and returns an object with the `namespace`, the `type` and the `uuid` of the URN. The function fails if the type is unknown or if the UUID is not valid.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "urn",
+				MarkdownDescription: "The URN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseURNAttrTypes,
+		},
+	}
+}
+
+// Run parses the URN.
+func (f *parseURNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var urn string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &urn))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix := uuid.PrefixOf(urn)
+	if prefix == "" || !uuid.VcloudUUID(urn).IsType(prefix) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid URN", urn))
+		return
+	}
+
+	result, diags := types.ObjectValue(parseURNAttrTypes, map[string]attr.Value{
+		"namespace": types.StringValue(prefix.Namespace()),
+		"type":      types.StringValue(prefix.Type()),
+		"uuid":      types.StringValue(urn[len(prefix):]),
+	})
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
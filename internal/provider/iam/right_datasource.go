@@ -55,7 +55,7 @@ func (d *iamRightDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *iamRightDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_iam_right", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_iam_right", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data RightModel
 
@@ -65,7 +65,13 @@ func (d *iamRightDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	right, err := d.client.Vmware.Client.GetRightByName(data.Name.Get())
+	vmware, err := d.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
+	right, err := vmware.Client.GetRightByName(data.Name.Get())
 	if err != nil {
 		resp.Diagnostics.AddError("This right does not exist", data.Name.Get())
 		return
@@ -74,7 +74,7 @@ func (d *roleDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_iam_role", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_iam_role", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data *roleDataSourceModel
 
@@ -20,6 +20,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -27,6 +29,7 @@ var (
 	_ resource.Resource                = &roleResource{}
 	_ resource.ResourceWithConfigure   = &roleResource{}
 	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.ResourceWithMoveState   = &roleResource{}
 	_ role                             = &roleResource{}
 )
 
@@ -84,7 +87,7 @@ func (r *roleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	plan := &roleResourceModel{}
@@ -100,6 +103,12 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	// Check rights are valid
 	rights := make([]govcdtypes.OpenApiReference, 0)
 	for _, right := range plan.Rights.Elements() {
@@ -113,7 +122,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Add implied rights
-	missingImpliedRights, err := govcd.FindMissingImpliedRights(&r.client.Vmware.Client, rights)
+	missingImpliedRights, err := govcd.FindMissingImpliedRights(&vmware.Client, rights)
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving implied rights", err.Error())
 		return
@@ -162,7 +171,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var state *roleResourceModel
 
@@ -221,7 +230,7 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var (
 		state *roleResourceModel
@@ -259,7 +268,7 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan, state *roleResourceModel
@@ -279,6 +288,12 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	// Get the role
 	role, err = r.GetRole()
 	if err != nil {
@@ -309,7 +324,7 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		rights = append(rights, govcdtypes.OpenApiReference{Name: rg, ID: x.ID})
 	}
 	// Add implied rights
-	missingImpliedRights, err := govcd.FindMissingImpliedRights(&r.client.Vmware.Client, rights)
+	missingImpliedRights, err := govcd.FindMissingImpliedRights(&vmware.Client, rights)
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving implied rights", err.Error())
 		return
@@ -363,9 +378,22 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 //go:generate tf-doc-extractor -filename $GOFILE -example-dir ../../../examples -resource
 func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_iam_role", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.Name("role", "role")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("role").Name())...)
+}
+
+// MoveState moves the state of the vcd_role resource of the vcd provider to the resource.
+func (r *roleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_role", nil),
+	}
 }
 
 func (r *roleResource) GetRole() (*govcd.Role, error) {
@@ -0,0 +1,158 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/vmware/go-vcloud-director/v2/govcd"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &tokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &tokenEphemeralResource{}
+)
+
+// tokenPrivateKey is the key of the private data storing the ID of the token to revoke on close.
+const tokenPrivateKey = "token"
+
+// NewTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &tokenEphemeralResource{}
+}
+
+// tokenEphemeralResource is the ephemeral resource implementation.
+type tokenEphemeralResource struct {
+	client *client.CloudAvenue
+}
+
+type tokenPrivateData struct {
+	ID string `json:"id"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *tokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *tokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = tokenEphemeralSchema(ctx)
+}
+
+func (r *tokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Open creates an API token for the run. The token is only returned in the result and never stored in the state.
+func (r *tokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Open, &resp.Diagnostics)()
+
+	config := &TokenEphemeralModel{}
+
+	// Retrieve values from config
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
+	// The name of a token is unique for a user, generate one if not set.
+	if config.Name.IsNull() || config.Name.ValueString() == "" {
+		config.Name = types.StringValue("terraform-ephemeral-" + uuid.NewString())
+	}
+
+	token, err := vmware.CreateToken(r.client.GetOrgName(), config.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating token", err.Error())
+		return
+	}
+
+	tokenString, err := token.GetInitialApiToken()
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting token", err.Error())
+		// Do not leave an unused token behind.
+		if errDelete := token.Delete(); errDelete != nil {
+			resp.Diagnostics.AddWarning("Error deleting token", errDelete.Error())
+		}
+		return
+	}
+
+	// Keep the ID of the token to revoke it on close.
+	private, err := json.Marshal(tokenPrivateData{ID: token.Token.ID})
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshalling private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, tokenPrivateKey, private)...)
+
+	config.ID = types.StringValue(token.Token.ID)
+	config.Token = types.StringValue(tokenString.RefreshToken)
+	config.AccessToken = types.StringValue(tokenString.AccessToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+// Close revokes the API token created by Open.
+func (r *tokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Close, &resp.Diagnostics)()
+
+	data, diags := req.Private.GetKey(ctx, tokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	private := tokenPrivateData{}
+	if err := json.Unmarshal(data, &private); err != nil {
+		resp.Diagnostics.AddError("Error unmarshalling private data", err.Error())
+		return
+	}
+
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
+	token, err := vmware.GetTokenById(private.ID)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			// Already revoked
+			return
+		}
+		resp.Diagnostics.AddError("Error getting token", err.Error())
+		return
+	}
+
+	if err := token.Delete(); err != nil {
+		resp.Diagnostics.AddError("Error revoking token", err.Error())
+	}
+}
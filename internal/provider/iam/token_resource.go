@@ -7,19 +7,24 @@ import (
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &tokenResource{}
-	_ resource.ResourceWithConfigure = &tokenResource{}
+	_ resource.Resource                = &tokenResource{}
+	_ resource.ResourceWithConfigure   = &tokenResource{}
+	_ resource.ResourceWithImportState = &tokenResource{}
 )
 
 // NewTokenResource is a helper function to simplify the provider implementation.
@@ -68,7 +73,7 @@ func (r *tokenResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &TokenModel{}
 
@@ -84,11 +89,17 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	/*
 		Implement the resource creation logic here.
 	*/
 
-	token, err := r.client.Vmware.CreateToken(r.org.GetName(), plan.Name.Get())
+	token, err := vmware.CreateToken(r.org.GetName(), plan.Name.Get())
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating token", err.Error())
 		return
@@ -115,7 +126,7 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	if plan.SaveInFile.Get() {
-		if err := govcd.SaveApiTokenToFile(plan.FileName.Get(), r.client.Vmware.Client.UserAgent, tokenString); err != nil {
+		if err := govcd.SaveApiTokenToFile(plan.FileName.Get(), vmware.Client.UserAgent, tokenString); err != nil {
 			resp.Diagnostics.AddError("Error saving token", err.Error())
 			return
 		}
@@ -133,7 +144,7 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &TokenModel{}
 
@@ -149,11 +160,17 @@ func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	/*
 		Implement the resource read here
 	*/
 
-	token, err := r.client.Vmware.GetTokenById(state.ID.Get())
+	token, err := vmware.GetTokenById(state.ID.Get())
 	if err != nil {
 		if govcd.ContainsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -176,7 +193,7 @@ func (r *tokenResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &TokenModel{}
 
@@ -192,11 +209,17 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	/*
 		Implement the resource deletion here
 	*/
 
-	token, err := r.client.Vmware.GetTokenById(state.ID.Get())
+	token, err := vmware.GetTokenById(state.ID.Get())
 	if err != nil {
 		if govcd.ContainsNotFound(err) {
 			resp.State.RemoveResource(ctx)
@@ -211,3 +234,44 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+func (r *tokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_iam_token", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.NameOrURN("token", "API token", uuid.Token)})
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vmware, err := r.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
+	var token *govcd.Token
+	if id.Get("token").IsID() {
+		token, err = vmware.GetTokenById(id.Get("token").ID())
+	} else {
+		// The tokens are owned by the user of the provider.
+		token, err = vmware.GetTokenByNameAndUsername(id.Get("token").Name(), r.client.GetUserName())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting token", err.Error())
+		return
+	}
+
+	// The token value is only known at creation.
+	state := &TokenModel{
+		FileName:      supertypes.NewStringValue("token.json"),
+		ID:            supertypes.NewStringValue(token.Token.ID),
+		Name:          supertypes.NewStringValue(token.Token.Name),
+		PrintToken:    supertypes.NewBoolValue(false),
+		SaveInFile:    supertypes.NewBoolValue(false),
+		SaveInTfstate: supertypes.NewBoolValue(false),
+		Token:         supertypes.NewStringValue(""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
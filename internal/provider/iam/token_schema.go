@@ -3,6 +3,7 @@ package iam
 import (
 	"context"
 
+	schemaE "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -96,3 +97,32 @@ func tokenSchema(_ context.Context) superschema.Schema {
 		},
 	}
 }
+
+// tokenEphemeralSchema is the schema of the ephemeral resource.
+// superschema does not support the ephemeral resources, the schema is declared with the framework.
+func tokenEphemeralSchema(_ context.Context) schemaE.Schema {
+	return schemaE.Schema{
+		MarkdownDescription: "The `cloudavenue_iam_token` ephemeral resource creates an API token for the user of the provider for the duration of a Terraform run. The token is never stored in the plan or the state and is revoked when the run ends.",
+		Attributes: map[string]schemaE.Attribute{
+			"id": schemaE.StringAttribute{
+				MarkdownDescription: "The unique ID of the API token.",
+				Computed:            true,
+			},
+			"name": schemaE.StringAttribute{
+				MarkdownDescription: "The unique name of the API token. A name prefixed with `terraform-ephemeral-` is generated if not set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"token": schemaE.StringAttribute{
+				MarkdownDescription: "The API token. It can be used as the `token` of the provider.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"access_token": schemaE.StringAttribute{
+				MarkdownDescription: "The short-lived access token obtained with the API token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
+)
+
+// Unit test for the schema of the ephemeral resource cloudavenue_iam_token.
+func TestTokenEphemeralResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	// Instantiate the ephemeral.EphemeralResource and call its Schema method
+	iam.NewTokenEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	// Validate the schema
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
@@ -3,6 +3,8 @@ package iam
 import (
 	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -21,3 +23,11 @@ func (rm *TokenModel) Copy() *TokenModel {
 	utils.ModelCopy(rm, x)
 	return x
 }
+
+// TokenEphemeralModel is the model of the ephemeral resource cloudavenue_iam_token.
+type TokenEphemeralModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Token       types.String `tfsdk:"token"`
+	AccessToken types.String `tfsdk:"access_token"`
+}
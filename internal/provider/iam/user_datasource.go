@@ -78,7 +78,7 @@ func (d *userDataSource) Configure(ctx context.Context, req datasource.Configure
 
 // Read reads the data source.
 func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_iam_user", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_iam_user", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &userDataSourceModel{}
 
@@ -16,7 +16,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/writeonly"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -24,6 +28,7 @@ var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithMoveState   = &userResource{}
 	_ user                             = &userResource{}
 )
 
@@ -46,7 +51,7 @@ func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest,
 
 // Schema defines the schema for the resource.
 func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = userSchema().GetResource(ctx)
+	resp.Schema = writeonly.Set(userSchema().GetResource(ctx))
 }
 
 func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -82,11 +87,13 @@ func (r *userResource) Init(_ context.Context, rm *userResourceModel) (diags dia
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &userResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	// Write-only attributes are only available in the config.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -96,6 +103,11 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	password := plan.Password.ValueString()
+	if !plan.PasswordWO.IsNull() {
+		password = plan.PasswordWO.ValueString()
+	}
+
 	userData := govcd.OrgUserConfiguration{
 		Name:            plan.Name.ValueString(),
 		RoleName:        plan.RoleName.ValueString(),
@@ -103,7 +115,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		EmailAddress:    plan.Email.ValueString(),
 		Telephone:       plan.Telephone.ValueString(),
 		IsEnabled:       plan.Enabled.ValueBool(),
-		Password:        plan.Password.ValueString(),
+		Password:        password,
 		DeployedVmQuota: int(plan.DeployedVMQuota.ValueInt64()),
 		StoredVmQuota:   int(plan.StoredVMQuota.ValueInt64()),
 	}
@@ -126,7 +138,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &userResourceModel{}
 
@@ -152,17 +164,18 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	plan := &userResourceModel{
-		ID:              types.StringValue(user.User.ID),
-		Name:            types.StringValue(user.User.Name),
-		RoleName:        types.StringValue(user.User.Role.Name),
-		FullName:        utils.StringValueOrNull(user.User.FullName),
-		Email:           utils.StringValueOrNull(user.User.EmailAddress),
-		Telephone:       utils.StringValueOrNull(user.User.Telephone),
-		Enabled:         types.BoolValue(user.User.IsEnabled),
-		DeployedVMQuota: types.Int64Value(int64(user.User.DeployedVmQuota)),
-		StoredVMQuota:   types.Int64Value(int64(user.User.StoredVmQuota)),
-		TakeOwnership:   state.TakeOwnership,
-		Password:        state.Password,
+		ID:                types.StringValue(user.User.ID),
+		Name:              types.StringValue(user.User.Name),
+		RoleName:          types.StringValue(user.User.Role.Name),
+		FullName:          utils.StringValueOrNull(user.User.FullName),
+		Email:             utils.StringValueOrNull(user.User.EmailAddress),
+		Telephone:         utils.StringValueOrNull(user.User.Telephone),
+		Enabled:           types.BoolValue(user.User.IsEnabled),
+		DeployedVMQuota:   types.Int64Value(int64(user.User.DeployedVmQuota)),
+		StoredVMQuota:     types.Int64Value(int64(user.User.StoredVmQuota)),
+		TakeOwnership:     state.TakeOwnership,
+		Password:          state.Password,
+		PasswordWOVersion: state.PasswordWOVersion,
 	}
 
 	// Set refreshed state
@@ -174,12 +187,18 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
-	plan := &userResourceModel{}
+	var (
+		plan  = &userResourceModel{}
+		state = &userResourceModel{}
+	)
 
-	// Get current state
+	// Get current plan and state
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	// Write-only attributes are only available in the config.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &plan.PasswordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -195,6 +214,15 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// An empty password is not updated. The write-only password is only sent when its version changes.
+	password := plan.Password.ValueString()
+	if !plan.PasswordWO.IsNull() {
+		password = ""
+		if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+			password = plan.PasswordWO.ValueString()
+		}
+	}
+
 	userData := govcd.OrgUserConfiguration{
 		Name:            plan.Name.ValueString(),
 		RoleName:        plan.RoleName.ValueString(),
@@ -202,7 +230,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		EmailAddress:    plan.Email.ValueString(),
 		Telephone:       plan.Telephone.ValueString(),
 		IsEnabled:       plan.Enabled.ValueBool(),
-		Password:        plan.Password.ValueString(),
+		Password:        password,
 		DeployedVmQuota: int(plan.DeployedVMQuota.ValueInt64()),
 		StoredVmQuota:   int(plan.StoredVMQuota.ValueInt64()),
 	}
@@ -220,7 +248,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &userResourceModel{}
 
@@ -251,8 +279,30 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Import)()
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	defer metrics.New("cloudavenue_iam_user", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.NameOrURN("user", "user", uuid.User)})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// The user is read by ID if the ID is known, by name otherwise.
+	if user := id.Get("user"); user.IsID() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), user.ID())...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), user.Name())...)
+	}
+}
+
+// MoveState moves the state of the vcd_org_user resource of the vcd provider to the resource.
+func (r *userResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_org_user", map[string]string{
+			"role":          "role_name",
+			"email_address": "email",
+		}),
+	}
 }
 
 func (r *userResource) GetUser(refresh bool) (*govcd.OrgUser, error) {
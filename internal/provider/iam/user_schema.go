@@ -12,9 +12,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	superschema "github.com/FrangipaneTeam/terraform-plugin-framework-superschema"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/writeonly"
 )
 
 /*
@@ -156,8 +159,30 @@ func userSchema() superschema.Schema {
 			"password": superschema.StringAttribute{
 				Resource: &schemaR.StringAttribute{
 					MarkdownDescription: "The user's password. This value is never returned on read.",
-					Required:            true,
+					Optional:            true,
+					Sensitive:           true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(path.MatchRoot("password"), path.MatchRoot("password_wo")),
+					},
+				},
+			},
+			"password_wo": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: writeonly.Description("password", "password_wo"),
+					Optional:            true,
 					Sensitive:           true,
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+					},
+				},
+			},
+			"password_wo_version": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: writeonly.VersionDescription("password_wo"),
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+					},
 				},
 			},
 			"take_ownership": superschema.BoolAttribute{
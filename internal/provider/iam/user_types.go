@@ -15,8 +15,10 @@ type userResourceModel struct {
 	StoredVMQuota   types.Int64  `tfsdk:"stored_vm_quota"`
 
 	// Specific
-	Password      types.String `tfsdk:"password"`
-	TakeOwnership types.Bool   `tfsdk:"take_ownership"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	TakeOwnership     types.Bool   `tfsdk:"take_ownership"`
 }
 
 type userDataSourceModel struct {
@@ -59,7 +59,7 @@ func (d *dhcpBindingDataSource) Configure(ctx context.Context, req datasource.Co
 }
 
 func (d *dhcpBindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_network_dhcp_binding", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_network_dhcp_binding", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &DHCPBindingModel{}
 
@@ -4,7 +4,6 @@ package network
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 
@@ -15,8 +14,11 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -24,6 +26,7 @@ var (
 	_ resource.Resource                = &dhcpBindingResource{}
 	_ resource.ResourceWithConfigure   = &dhcpBindingResource{}
 	_ resource.ResourceWithImportState = &dhcpBindingResource{}
+	_ resource.ResourceWithMoveState   = &dhcpBindingResource{}
 )
 
 // NewDhcpBindingResource is a helper function to simplify the provider implementation.
@@ -73,7 +76,7 @@ func (r *dhcpBindingResource) Configure(ctx context.Context, req resource.Config
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *dhcpBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &DHCPBindingModel{}
 
@@ -133,7 +136,7 @@ func (r *dhcpBindingResource) Create(ctx context.Context, req resource.CreateReq
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dhcpBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &DHCPBindingModel{}
 
@@ -170,7 +173,7 @@ func (r *dhcpBindingResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dhcpBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &DHCPBindingModel{}
@@ -232,7 +235,7 @@ func (r *dhcpBindingResource) Update(ctx context.Context, req resource.UpdateReq
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *dhcpBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &DHCPBindingModel{}
 
@@ -323,9 +326,9 @@ func (r *dhcpBindingResource) read(ctx context.Context, planOrState *DHCPBinding
 	return refreshed, true, diags
 }
 
-// ImportState imports a resource from orgNetworkID.DhcpBindingName.
+// ImportState imports a resource from org_network.dhcp_binding.
 func (r *dhcpBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_network_dhcp_binding", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
 	// Init the resource
 	resp.Diagnostics.Append(r.Init(ctx, nil)...)
@@ -333,13 +336,12 @@ func (r *dhcpBindingResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	// Get URI from import ID
-	resourceURI := strings.Split(req.ID, ".")
-	if len(resourceURI) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID format.", "The import ID should be in the format orgNetworkID.DhcpBindingName")
+	id, d := importid.Parse(req.ID, importid.Format{importid.URN("org_network", "org network", uuid.Network), importid.Name("dhcp_binding", "DHCP binding")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
-	orgNetworkID, bindingName := resourceURI[0], resourceURI[1]
+	orgNetworkID, bindingName := id.Get("org_network").ID(), id.Get("dhcp_binding").Name()
 
 	orgNetwork, err := r.org.GetOpenApiOrgVdcNetworkById(orgNetworkID)
 	if err != nil {
@@ -357,3 +359,10 @@ func (r *dhcpBindingResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_network_id"), orgNetworkID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), dhcpBinding.OpenApiOrgVdcNetworkDhcpBinding.Name)...)
 }
+
+// MoveState moves the state of the vcd_nsxt_network_dhcp_binding resource of the vcd provider to the resource.
+func (r *dhcpBindingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_network_dhcp_binding", nil),
+	}
+}
@@ -65,7 +65,7 @@ func (d *dhcpDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *dhcpDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_network_dhcp", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_network_dhcp", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &dhcpModel{}
 
@@ -16,9 +16,12 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -26,6 +29,7 @@ var (
 	_ resource.Resource                = &dhcpResource{}
 	_ resource.ResourceWithConfigure   = &dhcpResource{}
 	_ resource.ResourceWithImportState = &dhcpResource{}
+	_ resource.ResourceWithMoveState   = &dhcpResource{}
 )
 
 // NewDhcpResource is a helper function to simplify the provider implementation.
@@ -74,7 +78,7 @@ func (r *dhcpResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *dhcpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &dhcpModel{}
 
@@ -119,7 +123,7 @@ func (r *dhcpResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dhcpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &dhcpModel{}
 
@@ -159,7 +163,7 @@ func (r *dhcpResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dhcpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &dhcpModel{}
@@ -206,7 +210,7 @@ func (r *dhcpResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *dhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &dhcpModel{}
 
@@ -235,10 +239,23 @@ func (r *dhcpResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *dhcpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_network_dhcp", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_network_id"), req.ID)...)
+	id, d := importid.Parse(req.ID, importid.Format{importid.URN("org_network", "org network", uuid.Network)})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.Get("org_network").ID())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_network_id"), id.Get("org_network").ID())...)
+}
+
+// MoveState moves the state of the vcd_nsxt_network_dhcp resource of the vcd provider to the resource.
+func (r *dhcpResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_nsxt_network_dhcp", nil),
+	}
 }
 
 // createUpdateDhcp The dhcp has no create method in the API, so we use the update method.
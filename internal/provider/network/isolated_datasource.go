@@ -57,7 +57,7 @@ func (d *networkIsolatedDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *networkIsolatedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_network_isolated", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_network_isolated", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data networkIsolatedModel
 
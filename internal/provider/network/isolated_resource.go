@@ -4,7 +4,6 @@ package network
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -17,6 +16,8 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/mutex"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
@@ -29,6 +30,7 @@ var (
 	_ resource.ResourceWithConfigure   = &networkIsolatedResource{}
 	_ resource.ResourceWithImportState = &networkIsolatedResource{}
 	_ resource.ResourceWithModifyPlan  = &networkIsolatedResource{}
+	_ resource.ResourceWithMoveState   = &networkIsolatedResource{}
 	_ network.Network                  = &networkIsolatedResource{}
 )
 
@@ -107,7 +109,7 @@ func (r *networkIsolatedResource) Configure(ctx context.Context, req resource.Co
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkIsolatedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	plan := &networkIsolatedModel{}
@@ -161,7 +163,7 @@ func (r *networkIsolatedResource) Create(ctx context.Context, req resource.Creat
 
 // Read refreshes the Terraform state with the latest data.
 func (r *networkIsolatedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	// Get current state
 	state := &networkIsolatedModel{}
@@ -236,7 +238,7 @@ func (r *networkIsolatedResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkIsolatedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	// Get current state
 	plan := &networkIsolatedModel{}
@@ -300,7 +302,7 @@ func (r *networkIsolatedResource) Update(ctx context.Context, req resource.Updat
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *networkIsolatedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	// Get current state
 	state := &networkIsolatedModel{}
@@ -348,25 +350,23 @@ func (r *networkIsolatedResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *networkIsolatedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_network_isolated", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	// Get URI from import ID
-	resourceURI := strings.Split(req.ID, ".")
-	if len(resourceURI) != 2 {
-		resp.Diagnostics.AddError("Error importing network_routed", "Resource name must be specified as vdc-name.network-name or vdc-group-name.network-name")
+	id, d := importid.Parse(req.ID, importid.Format{importid.VDCOrVDCGroup, importid.Name("network", "isolated network")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
-	vdcOrVDCGroupName, networkName := resourceURI[0], resourceURI[1]
 
 	// Get VDC or VDCGroup
-	vdcOrVDCGroup, err := r.client.GetVDCOrVDCGroup(vdcOrVDCGroupName)
+	vdcOrVDCGroup, err := r.client.GetVDCOrVDCGroup(id.Get("vdc").String())
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving VDC or VDCGroup", err.Error())
 		return
 	}
 
 	// Get network
-	orgNetwork, err := vdcOrVDCGroup.GetOpenApiOrgVdcNetworkByName(networkName)
+	orgNetwork, err := vdcOrVDCGroup.GetOpenApiOrgVdcNetworkByName(id.Get("network").Name())
 	if err != nil { // If network is not found, return error
 		resp.Diagnostics.AddError("Error retrieving org network by name", err.Error())
 		return
@@ -417,6 +417,13 @@ func (r *networkIsolatedResource) ImportState(ctx context.Context, req resource.
 	}
 }
 
+// MoveState moves the state of the vcd_network_isolated_v2 resource of the vcd provider to the resource.
+func (r *networkIsolatedResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_network_isolated_v2", nil),
+	}
+}
+
 func (r *networkIsolatedResource) SetNetworkAPIObject(ctx context.Context, plan any) (*govcdtypes.OpenApiOrgVdcNetwork, diag.Diagnostics) {
 	d := diag.Diagnostics{}
 
@@ -71,7 +71,7 @@ func (d *networkRoutedDataSource) Configure(ctx context.Context, req datasource.
 }
 
 func (d *networkRoutedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_network_routed", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_network_routed", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data networkRoutedModel
 
@@ -4,7 +4,6 @@ package network
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -20,9 +19,10 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
-	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -30,6 +30,7 @@ var (
 	_ resource.Resource                = &networkRoutedResource{}
 	_ resource.ResourceWithConfigure   = &networkRoutedResource{}
 	_ resource.ResourceWithImportState = &networkRoutedResource{}
+	_ resource.ResourceWithMoveState   = &networkRoutedResource{}
 	_ network.Network                  = &networkRoutedResource{}
 )
 
@@ -86,7 +87,7 @@ func (r *networkRoutedResource) Configure(ctx context.Context, req resource.Conf
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *networkRoutedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	plan := &networkRoutedModel{}
@@ -146,7 +147,7 @@ func (r *networkRoutedResource) Create(ctx context.Context, req resource.CreateR
 
 // Read refreshes the Terraform state with the latest data.
 func (r *networkRoutedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &networkRoutedModel{}
 	// Get current state
@@ -197,7 +198,7 @@ func (r *networkRoutedResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *networkRoutedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	plan := &networkRoutedModel{}
 
@@ -263,7 +264,7 @@ func (r *networkRoutedResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *networkRoutedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &networkRoutedModel{}
 
@@ -317,12 +318,11 @@ func (r *networkRoutedResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *networkRoutedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_network_routed", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	resourceURI := strings.Split(req.ID, ".")
-
-	if len(resourceURI) != 2 {
-		resp.Diagnostics.AddError("Error importing network_routed", "Resource name must be specified as vdc-name.network-name or vdc-group-name.network-name")
+	id, d := importid.Parse(req.ID, importid.Format{importid.EdgeGateway, importid.Name("network", "routed network")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
@@ -332,21 +332,12 @@ func (r *networkRoutedResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	edgeGatewayNameOrEdgeGatewayID, networkName := resourceURI[0], resourceURI[1]
+	networkName := id.Get("network").Name()
 
 	// Get Edge Gateway
-	var (
-		edgeGWName string
-		edgeGWID   string
-	)
-	if uuid.IsEdgeGateway(edgeGatewayNameOrEdgeGatewayID) {
-		edgeGWID = edgeGatewayNameOrEdgeGatewayID
-	} else {
-		edgeGWName = edgeGatewayNameOrEdgeGatewayID
-	}
 	edgegw, err := r.org.GetEdgeGateway(edgegw.BaseEdgeGW{
-		ID:   types.StringValue(edgeGWID),
-		Name: types.StringValue(edgeGWName),
+		ID:   types.StringValue(id.Get("edge_gateway").ID()),
+		Name: types.StringValue(id.Get("edge_gateway").Name()),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving Edge Gateway", err.Error())
@@ -374,6 +365,13 @@ func (r *networkRoutedResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), orgNetwork.OpenApiOrgVdcNetwork.ID)...)
 }
 
+// MoveState moves the state of the vcd_network_routed_v2 resource of the vcd provider to the resource.
+func (r *networkRoutedResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_network_routed_v2", nil),
+	}
+}
+
 func (r *networkRoutedResource) SetNetworkAPIObject(ctx context.Context, plan any) (*govcdtypes.OpenApiOrgVdcNetwork, diag.Diagnostics) {
 	d := diag.Diagnostics{}
 
@@ -4,7 +4,10 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -14,12 +17,17 @@ import (
 	clientcloudavenue "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/cloudavenue"
 	clientnetbackup "github.com/orange-cloudavenue/cloudavenue-sdk-go/pkg/clients/netbackup"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 )
 
 const VCDVersion = "37.2"
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ provider.Provider = &cloudavenueProvider{}
+var (
+	_ provider.Provider                       = &cloudavenueProvider{}
+	_ provider.ProviderWithEphemeralResources = &cloudavenueProvider{}
+	_ provider.ProviderWithFunctions          = &cloudavenueProvider{}
+)
 
 // cloudavenueProvider is the provider implementation.
 type cloudavenueProvider struct {
@@ -57,44 +65,118 @@ func (p *cloudavenueProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	// Load the settings of the named profile if any.
+	// Values defined in the provider configuration or in environment variables take precedence.
+	profile := providerProfile{}
+	if profileName := findValue(config.Profile, "CLOUDAVENUE_PROFILE", ""); profileName != "" {
+		configFile, err := getProfileConfigFile()
+		if err == nil {
+			profile, err = loadProfile(configFile, profileName)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to Load Cloud Avenue Profile",
+				"The provider cannot load the profile "+profileName+". "+
+					"Ensure the profile exists in the config file ~/"+profileConfigFile+" or in the file set with the CLOUDAVENUE_CONFIG_FILE environment variable: "+
+					err.Error(),
+			)
+			return
+		}
+	}
+
+	url := findValue(config.URL, "CLOUDAVENUE_URL", profile.URL)
+	if url == "" {
+		url = "https://console1.cloudavenue.orange-business.com"
+	}
+
 	cloudAvenue := client.CloudAvenue{
 		CloudAvenueVersion: p.version,
 		// Legacy SDK Cloudavenue
-		URL: func() string {
-			url := findValue(config.URL, "CLOUDAVENUE_URL")
-			if url == "" {
-				url = "https://console1.cloudavenue.orange-business.com"
-			}
-			return url
-		}(),
-		User:             findValue(config.User, "CLOUDAVENUE_USER"),
-		Password:         findValue(config.Password, "CLOUDAVENUE_PASSWORD"),
-		Org:              findValue(config.Org, "CLOUDAVENUE_ORG"),
-		VDC:              findValue(config.VDC, "CLOUDAVENUE_VDC"),
+		URL:              url,
+		User:             findValue(config.User, "CLOUDAVENUE_USER", profile.User),
+		Password:         findValue(config.Password, "CLOUDAVENUE_PASSWORD", profile.Password),
+		Token:            findValue(config.Token, "CLOUDAVENUE_TOKEN", profile.Token),
+		Org:              findValue(config.Org, "CLOUDAVENUE_ORG", profile.Org),
+		VDC:              findValue(config.VDC, "CLOUDAVENUE_VDC", profile.VDC),
 		TerraformVersion: req.TerraformVersion,
 		VCDVersion:       VCDVersion,
+	}
+
+	// Retry policy for transient API failures
+	cloudAvenue.RetryPolicy = client.DefaultRetryPolicy()
+	if v, err := findInt64Value(config.MaxRetries, "CLOUDAVENUE_MAX_RETRIES", 0); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid Max Retries", err.Error())
+	} else if v != nil {
+		cloudAvenue.RetryPolicy.MaxRetries = int(*v)
+	}
+	if v, err := findInt64Value(config.RetryMaxWait, "CLOUDAVENUE_RETRY_MAX_WAIT", 1); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "Invalid Retry Max Wait", err.Error())
+	} else if v != nil {
+		cloudAvenue.RetryPolicy.MaxWait = time.Duration(*v) * time.Second
+	}
 
-		// This is a new SDK Cloudavenue
-		CAVSDKOpts: &casdk.ClientOpts{
-			Netbackup: &clientnetbackup.Opts{
-				Endpoint: findValue(config.NetBackupURL, "NETBACKUP_URL"),
-				Username: findValue(config.NetBackupUser, "NETBACKUP_USER"),
-				Password: findValue(config.NetBackupPassword, "NETBACKUP_PASSWORD"),
-			},
-			CloudAvenue: &clientcloudavenue.Opts{
-				Endpoint: func() string {
-					url := findValue(config.URL, "CLOUDAVENUE_URL")
-					if url == "" {
-						url = "https://console1.cloudavenue.orange-business.com"
-					}
-					return url
-				}(),
-				Username:   findValue(config.User, "CLOUDAVENUE_USER"),
-				Password:   findValue(config.Password, "CLOUDAVENUE_PASSWORD"),
-				Org:        findValue(config.Org, "CLOUDAVENUE_ORG"),
-				VDC:        findValue(config.VDC, "CLOUDAVENUE_VDC"),
-				VCDVersion: VCDVersion,
-			},
+	// Limit of the requests sent to the APIs
+	var maxConcurrentRequests, maxRequestsPerSecond int
+	if v, err := findInt64Value(config.MaxConcurrentRequests, "CLOUDAVENUE_MAX_CONCURRENT_REQUESTS", 0); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid Max Concurrent Requests", err.Error())
+	} else if v != nil {
+		maxConcurrentRequests = int(*v)
+	}
+	if v, err := findInt64Value(config.MaxRequestsPerSecond, "CLOUDAVENUE_MAX_REQUESTS_PER_SECOND", 0); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("max_requests_per_second"), "Invalid Max Requests Per Second", err.Error())
+	} else if v != nil {
+		maxRequestsPerSecond = int(*v)
+	}
+	cloudAvenue.Limiter = client.NewRequestLimiter(maxConcurrentRequests, maxRequestsPerSecond)
+
+	// TLS and proxy settings of the HTTP clients
+	cloudAvenue.TransportOpts = client.TransportOpts{
+		CAFile:   findValue(config.CAFile, "CLOUDAVENUE_CA_FILE", ""),
+		ProxyURL: findValue(config.ProxyURL, "CLOUDAVENUE_PROXY_URL", ""),
+	}
+	if v, err := findBoolValue(config.Insecure, "CLOUDAVENUE_INSECURE"); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("insecure"), "Invalid Insecure", err.Error())
+	} else {
+		cloudAvenue.TransportOpts.Insecure = v
+	}
+
+	// Record/replay transport of the acceptance tests, only configurable with environment variables
+	cloudAvenue.VCR = client.VCROpts{
+		Mode:     client.VCRMode(os.Getenv("CLOUDAVENUE_VCR_MODE")),
+		Cassette: os.Getenv("CLOUDAVENUE_VCR_CASSETTE"),
+	}
+
+	// Telemetry of the CRUD operations
+	telemetry := providerTelemetryModel{}
+	if config.Telemetry != nil {
+		telemetry = *config.Telemetry
+	}
+	telemetryConfig := metrics.Config{
+		Mode:         metrics.Mode(findValue(telemetry.Mode, "CLOUDAVENUE_TELEMETRY", string(metrics.ModeVendor))),
+		OTLPEndpoint: findValue(telemetry.OTLPEndpoint, "CLOUDAVENUE_TELEMETRY_OTLP_ENDPOINT", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")),
+	}
+	if !telemetry.OTLPHeaders.IsNull() && !telemetry.OTLPHeaders.IsUnknown() {
+		resp.Diagnostics.Append(telemetry.OTLPHeaders.ElementsAs(ctx, &telemetryConfig.OTLPHeaders, false)...)
+	}
+	if err := metrics.Configure(telemetryConfig); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("telemetry"), "Invalid Telemetry Configuration", err.Error())
+	}
+
+	// This is a new SDK Cloudavenue
+	cloudAvenue.CAVSDKOpts = &casdk.ClientOpts{
+		Netbackup: &clientnetbackup.Opts{
+			Endpoint: findValue(config.NetBackupURL, "NETBACKUP_URL", profile.NetBackupURL),
+			Username: findValue(config.NetBackupUser, "NETBACKUP_USER", profile.NetBackupUser),
+			Password: findValue(config.NetBackupPassword, "NETBACKUP_PASSWORD", profile.NetBackupPassword),
+		},
+		CloudAvenue: &clientcloudavenue.Opts{
+			Endpoint:   cloudAvenue.URL,
+			Username:   cloudAvenue.User,
+			Password:   cloudAvenue.Password,
+			Org:        cloudAvenue.Org,
+			VDC:        cloudAvenue.VDC,
+			VCDVersion: VCDVersion,
 		},
 	}
 
@@ -102,23 +184,36 @@ func (p *cloudavenueProvider) Configure(ctx context.Context, req provider.Config
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
-	if cloudAvenue.User == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("user"),
-			"Missing Cloud Avenue API User",
-			"The provider cannot create the Cloud Avenue API client as there is a missing or empty value for the Cloud Avenue API user. "+
-				"Set the host value in the configuration or use the CLOUDAVENUE_USER environment variable. "+
-				valueNotEmpty,
-		)
-	}
-	if cloudAvenue.Password == "" {
+	switch {
+	case cloudAvenue.Token != "" && (cloudAvenue.User != "" || cloudAvenue.Password != ""):
 		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Missing Cloud Avenue API Password",
-			"The provider cannot create the Cloud Avenue API client as there is a missing or empty value for the Cloud Avenue API password. "+
-				"Set the host value in the configuration or use the CLOUDAVENUE_PASSWORD environment variable. "+
-				valueNotEmpty,
+			path.Root("token"),
+			"Conflicting Cloud Avenue API Authentication",
+			"The provider cannot create the Cloud Avenue API client as both an API token and a user/password are set. "+
+				"Use either the token value (or the CLOUDAVENUE_TOKEN environment variable) or the user and password values "+
+				"(or the CLOUDAVENUE_USER and CLOUDAVENUE_PASSWORD environment variables), but not both.",
 		)
+	case cloudAvenue.Token == "":
+		if cloudAvenue.User == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("user"),
+				"Missing Cloud Avenue API User",
+				"The provider cannot create the Cloud Avenue API client as there is a missing or empty value for the Cloud Avenue API user. "+
+					"Set the host value in the configuration or use the CLOUDAVENUE_USER environment variable. "+
+					"Alternatively, authenticate with an API token using the token value or the CLOUDAVENUE_TOKEN environment variable. "+
+					valueNotEmpty,
+			)
+		}
+		if cloudAvenue.Password == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing Cloud Avenue API Password",
+				"The provider cannot create the Cloud Avenue API client as there is a missing or empty value for the Cloud Avenue API password. "+
+					"Set the host value in the configuration or use the CLOUDAVENUE_PASSWORD environment variable. "+
+					"Alternatively, authenticate with an API token using the token value or the CLOUDAVENUE_TOKEN environment variable. "+
+					valueNotEmpty,
+			)
+		}
 	}
 	if cloudAvenue.Org == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -161,20 +256,17 @@ func (p *cloudavenueProvider) Configure(ctx context.Context, req provider.Config
 	cA, err := cloudAvenue.New()
 	if err != nil {
 		switch {
-		case errors.Is(err, client.ErrAuthFailed):
-			resp.Diagnostics.AddError(summaryErrorAPICAV, err.Error())
-			return
-		case errors.Is(err, client.ErrTokenEmpty):
-			resp.Diagnostics.AddError(summaryErrorAPICAV, "Cloud Avenue Client Error: empty token")
-			return
 		case errors.Is(err, client.ErrConfigureVmware):
 			resp.Diagnostics.AddError(summaryErrorVCD, "VMWare VCD Client Error: "+err.Error())
 			return
 		case errors.Is(err, client.ErrVCDVersionEmpty):
 			resp.Diagnostics.AddError(summaryErrorVCD, "VMWare VCD version is empty")
 			return
-		case errors.Is(err, client.ErrConfigureNetBackup):
-			resp.Diagnostics.AddError("Unable to Configure NetBackup Client", err.Error())
+		case errors.Is(err, client.ErrConfigureTransport):
+			resp.Diagnostics.AddError("Unable to Configure HTTP Transport", "Check the ca_file and proxy_url values: "+err.Error())
+			return
+		case errors.Is(err, client.ErrConfigureVCR):
+			resp.Diagnostics.AddError("Unable to Configure Record/Replay Transport", "Check the CLOUDAVENUE_VCR_MODE and CLOUDAVENUE_VCR_CASSETTE environment variables: "+err.Error())
 			return
 		default:
 			resp.Diagnostics.AddError(summaryErrorAPICAV, "unknown error: "+err.Error())
@@ -186,11 +278,52 @@ func (p *cloudavenueProvider) Configure(ctx context.Context, req provider.Config
 	// type Configure methods.
 	resp.DataSourceData = cA
 	resp.ResourceData = cA
+	resp.EphemeralResourceData = cA
 }
 
-func findValue(tfValue basetypes.StringValue, envName string) string {
-	if tfValue.IsNull() {
-		return os.Getenv(envName)
+// findValue returns the value of the provider configuration if set,
+// otherwise the value of the environment variable if set,
+// otherwise the value of the profile.
+func findValue(tfValue basetypes.StringValue, envName, profileValue string) string {
+	if !tfValue.IsNull() {
+		return tfValue.ValueString()
+	}
+	if v := os.Getenv(envName); v != "" {
+		return v
+	}
+	return profileValue
+}
+
+// findInt64Value returns the value of the provider configuration if set,
+// otherwise the value of the environment variable if set, otherwise nil.
+// The environment variable must be at least minValue, like the attribute of the schema.
+func findInt64Value(tfValue basetypes.Int64Value, envName string, minValue int64) (*int64, error) {
+	if !tfValue.IsNull() {
+		v := tfValue.ValueInt64()
+		return &v, nil
+	}
+	if env := os.Getenv(envName); env != "" {
+		v, err := strconv.ParseInt(env, 10, 64)
+		if err != nil || v < minValue {
+			return nil, fmt.Errorf("the environment variable %s must be an integer greater than or equal to %d, got %q", envName, minValue, env)
+		}
+		return &v, nil
+	}
+	return nil, nil //nolint:nilnil // nil means not set
+}
+
+// findBoolValue returns the value of the provider configuration if set,
+// otherwise the value of the environment variable if set, otherwise false.
+func findBoolValue(tfValue basetypes.BoolValue, envName string) (bool, error) {
+	if !tfValue.IsNull() {
+		return tfValue.ValueBool(), nil
+	}
+	if env := os.Getenv(envName); env != "" {
+		v, err := strconv.ParseBool(env)
+		if err != nil {
+			return false, fmt.Errorf("the environment variable %s must be a boolean, got %q", envName, env)
+		}
+		return v, nil
 	}
-	return tfValue.ValueString()
+	return false, nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/alb"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/backup"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/catalog"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/certificate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/edgegw"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/network"
@@ -25,6 +26,9 @@ func (p *cloudavenueProvider) DataSources(_ context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		// * ALB
 		alb.NewAlbPoolDataSource,
+		alb.NewVirtualServiceDataSource,
+		alb.NewEdgeGatewaySettingsDataSource,
+		alb.NewServiceEngineGroupsDataSource,
 
 		// * TIER0
 		vrf.NewTier0VrfsDataSource,
@@ -40,6 +44,7 @@ func (p *cloudavenueProvider) DataSources(_ context.Context) []func() datasource
 		edgegw.NewSecurityGroupDataSource,
 		edgegw.NewIPSetDataSource,
 		edgegw.NewDhcpForwardingDataSource,
+		edgegw.NewDNSForwarderDataSource,
 		edgegw.NewStaticRouteDataSource,
 		edgegw.NewNATRuleDataSource,
 		edgegw.NewVPNIPSecDataSource,
@@ -62,6 +67,9 @@ func (p *cloudavenueProvider) DataSources(_ context.Context) []func() datasource
 		catalog.NewCatalogMediasDataSource,
 		catalog.NewACLDataSource,
 
+		// * CERTIFICATE
+		certificate.NewCertificateDataSource,
+
 		// * IAM
 		iam.NewUserDataSource,
 		iam.NewRoleDataSource,
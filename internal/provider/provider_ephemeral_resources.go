@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/s3"
+)
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *cloudavenueProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		// * IAM
+		iam.NewTokenEphemeralResource,
+
+		// * S3
+		s3.NewCredentialEphemeralResource,
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/functions"
+)
+
+// Functions defines the functions implemented in the provider.
+func (p *cloudavenueProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		// * URN
+		functions.NewBuildURNFunction,
+		functions.NewExtractUUIDFunction,
+		functions.NewIsURNOfFunction,
+		functions.NewParseURNFunction,
+	}
+}
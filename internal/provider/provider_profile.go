@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrProfileNotFound is returned when the profile does not exist in the config file.
+	ErrProfileNotFound = errors.New("profile not found")
+	// ErrProfileConfigFile is returned when the config file cannot be read or parsed.
+	ErrProfileConfigFile = errors.New("unable to load the profile config file")
+)
+
+// profileConfigFile is the default location of the profile config file
+// relative to the user home directory.
+const profileConfigFile = ".cloudavenue/config.yaml"
+
+// providerProfiles is the content of the profile config file.
+//
+//	profiles:
+//	  my-profile:
+//	    org: cav01ev01ocb0001234
+//	    user: my-user
+//	    password: my-password
+type providerProfiles struct {
+	Profiles map[string]providerProfile `yaml:"profiles"`
+}
+
+// providerProfile contains the settings of a named profile.
+type providerProfile struct {
+	URL               string `yaml:"url"`
+	User              string `yaml:"user"`
+	Password          string `yaml:"password"`
+	Token             string `yaml:"token"`
+	Org               string `yaml:"org"`
+	VDC               string `yaml:"vdc"`
+	NetBackupURL      string `yaml:"netbackup_url"`
+	NetBackupUser     string `yaml:"netbackup_user"`
+	NetBackupPassword string `yaml:"netbackup_password"`
+}
+
+// getProfileConfigFile returns the path of the profile config file.
+// The CLOUDAVENUE_CONFIG_FILE environment variable overrides the default location.
+func getProfileConfigFile() (string, error) {
+	if file := os.Getenv("CLOUDAVENUE_CONFIG_FILE"); file != "" {
+		return file, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrProfileConfigFile, err)
+	}
+
+	return filepath.Join(home, profileConfigFile), nil
+}
+
+// loadProfile reads the profile config file and returns the named profile.
+func loadProfile(configFile, name string) (providerProfile, error) {
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return providerProfile{}, fmt.Errorf("%w %s: %w", ErrProfileConfigFile, configFile, err)
+	}
+
+	profiles := providerProfiles{}
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return providerProfile{}, fmt.Errorf("%w %s: %w", ErrProfileConfigFile, configFile, err)
+	}
+
+	profile, ok := profiles.Profiles[name]
+	if !ok {
+		return providerProfile{}, fmt.Errorf("%w: %s in %s", ErrProfileNotFound, name, configFile)
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testProfileConfig = `
+profiles:
+  acme:
+    org: cav01ev01ocb0001234
+    vdc: acme-vdc
+    user: dasilva
+    password: dasilva
+    netbackup_user: nbu
+    netbackup_password: nbu
+  ci:
+    org: cav01ev01ocb0005678
+    token: t0k3n
+`
+
+func TestLoadProfile(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configFile, []byte(testProfileConfig), 0o600); err != nil {
+		t.Fatalf("unable to write config file: %v", err)
+	}
+
+	t.Run("ProfileWithCredentials", func(t *testing.T) {
+		profile, err := loadProfile(configFile, "acme")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if profile.Org != "cav01ev01ocb0001234" {
+			t.Fatalf("expected org to be %q, got %q", "cav01ev01ocb0001234", profile.Org)
+		}
+
+		if profile.User != "dasilva" || profile.Password != "dasilva" {
+			t.Fatalf("expected user and password to be %q, got %q/%q", "dasilva", profile.User, profile.Password)
+		}
+
+		if profile.NetBackupUser != "nbu" {
+			t.Fatalf("expected netbackup user to be %q, got %q", "nbu", profile.NetBackupUser)
+		}
+	})
+
+	t.Run("ProfileWithToken", func(t *testing.T) {
+		profile, err := loadProfile(configFile, "ci")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if profile.Token != "t0k3n" {
+			t.Fatalf("expected token to be %q, got %q", "t0k3n", profile.Token)
+		}
+	})
+
+	t.Run("ProfileNotFound", func(t *testing.T) {
+		_, err := loadProfile(configFile, "unknown")
+		if !errors.Is(err, ErrProfileNotFound) {
+			t.Fatalf("expected error %v, got %v", ErrProfileNotFound, err)
+		}
+	})
+
+	t.Run("ConfigFileNotFound", func(t *testing.T) {
+		_, err := loadProfile(filepath.Join(t.TempDir(), "missing.yaml"), "acme")
+		if !errors.Is(err, ErrProfileConfigFile) {
+			t.Fatalf("expected error %v, got %v", ErrProfileConfigFile, err)
+		}
+	})
+
+	t.Run("ConfigFileFromEnv", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_CONFIG_FILE", configFile)
+
+		file, err := getProfileConfigFile()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if file != configFile {
+			t.Fatalf("expected config file to be %q, got %q", configFile, file)
+		}
+	})
+}
+
+func TestFindValue(t *testing.T) {
+	t.Run("Config", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_ORG", "env-org")
+
+		if v := findValue(types.StringValue("hcl-org"), "CLOUDAVENUE_ORG", "profile-org"); v != "hcl-org" {
+			t.Fatalf("expected value to be %q, got %q", "hcl-org", v)
+		}
+	})
+
+	t.Run("Env", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_ORG", "env-org")
+
+		if v := findValue(types.StringNull(), "CLOUDAVENUE_ORG", "profile-org"); v != "env-org" {
+			t.Fatalf("expected value to be %q, got %q", "env-org", v)
+		}
+	})
+
+	t.Run("Profile", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_ORG", "")
+
+		if v := findValue(types.StringNull(), "CLOUDAVENUE_ORG", "profile-org"); v != "profile-org" {
+			t.Fatalf("expected value to be %q, got %q", "profile-org", v)
+		}
+	})
+}
+
+func TestFindInt64Value(t *testing.T) {
+	t.Run("Config", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_RETRY_MAX_WAIT", "10")
+
+		if v, err := findInt64Value(types.Int64Value(20), "CLOUDAVENUE_RETRY_MAX_WAIT", 1); err != nil || v == nil || *v != 20 {
+			t.Fatalf("expected value to be 20, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("Env", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_RETRY_MAX_WAIT", "10")
+
+		if v, err := findInt64Value(types.Int64Null(), "CLOUDAVENUE_RETRY_MAX_WAIT", 1); err != nil || v == nil || *v != 10 {
+			t.Fatalf("expected value to be 10, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("NotSet", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_RETRY_MAX_WAIT", "")
+
+		if v, err := findInt64Value(types.Int64Null(), "CLOUDAVENUE_RETRY_MAX_WAIT", 1); err != nil || v != nil {
+			t.Fatalf("expected no value, got %v (%v)", v, err)
+		}
+	})
+
+	t.Run("EnvBelowMinimum", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_RETRY_MAX_WAIT", "0")
+
+		if _, err := findInt64Value(types.Int64Null(), "CLOUDAVENUE_RETRY_MAX_WAIT", 1); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+	})
+
+	t.Run("EnvInvalid", func(t *testing.T) {
+		t.Setenv("CLOUDAVENUE_MAX_RETRIES", "five")
+
+		if _, err := findInt64Value(types.Int64Null(), "CLOUDAVENUE_MAX_RETRIES", 0); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/alb"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/backup"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/catalog"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/certificate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/edgegw"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/iam"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/network"
@@ -24,14 +25,17 @@ func (p *cloudavenueProvider) Resources(_ context.Context) []func() resource.Res
 	return []func() resource.Resource{
 		// * ALB
 		alb.NewAlbPoolResource,
+		alb.NewVirtualServiceResource,
 
 		// * EDGE GATEWAY
 		edgegw.NewEdgeGatewayResource,
 		edgegw.NewFirewallResource,
+		edgegw.NewFirewallRuleResource,
 		edgegw.NewPortProfilesResource,
 		edgegw.NewSecurityGroupResource,
 		edgegw.NewIPSetResource,
 		edgegw.NewDhcpForwardingResource,
+		edgegw.NewDNSForwarderResource,
 		edgegw.NewStaticRouteResource,
 		edgegw.NewNATRuleResource,
 		edgegw.NewVPNIPSecResource,
@@ -57,6 +61,9 @@ func (p *cloudavenueProvider) Resources(_ context.Context) []func() resource.Res
 		catalog.NewCatalogResource,
 		catalog.NewACLResource,
 
+		// * CERTIFICATE
+		certificate.NewCertificateResource,
+
 		// * IAM
 		iam.NewIAMUserResource,
 		iam.NewRoleResource,
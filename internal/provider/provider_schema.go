@@ -4,10 +4,15 @@ import (
 	"context"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 )
 
 func providerSchema(_ context.Context) schema.Schema {
@@ -33,6 +38,17 @@ func providerSchema(_ context.Context) schema.Schema {
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The API token to use to connect to the Cloud Avenue API. The token is exchanged for an access token on first use. Can also be set with the `CLOUDAVENUE_TOKEN` environment variable. Conflicts with `user` and `password`.",
+				Sensitive:           true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("user"),
+						path.MatchRoot("password"),
+					),
+				},
+			},
 			"org": schema.StringAttribute{
 				MarkdownDescription: "The organization used on Cloud Avenue API. Can also be set with the `CLOUDAVENUE_ORG` environment variable.",
 				Optional:            true,
@@ -60,6 +76,86 @@ func providerSchema(_ context.Context) schema.Schema {
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of retries when the API returns a transient error (HTTP 429, 502, 503, 504 or busy entity). Requests that are not idempotent, such as `POST`, are only retried when the API did not process them. Set to `0` to disable retries. Can also be set with the `CLOUDAVENUE_MAX_RETRIES` environment variable. Defaults to `5`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "The maximum time in seconds to wait between two retries. The wait grows exponentially with a random jitter up to this value. A `Retry-After` header returned by the API is honored up to this value. Can also be set with the `CLOUDAVENUE_RETRY_MAX_WAIT` environment variable. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests sent concurrently to the APIs. Additional requests are queued. Set to `0` for no limit. Can also be set with the `CLOUDAVENUE_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `0`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of requests per second sent to the APIs. Additional requests are queued. Set to `0` for no limit. Can also be set with the `CLOUDAVENUE_MAX_REQUESTS_PER_SECOND` environment variable. Defaults to `0`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ca_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a PEM file containing the CA certificates to trust in addition to the system ones. Useful behind a TLS-intercepting proxy. Can also be set with the `CLOUDAVENUE_CA_FILE` environment variable.",
+				Optional:            true,
+			},
+			"insecure": schema.BoolAttribute{
+				MarkdownDescription: "Disable the verification of the TLS certificates of the APIs. Not recommended outside of test environments. Can also be set with the `CLOUDAVENUE_INSECURE` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the HTTP proxy used to reach the APIs. If not set, the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used. Can also be set with the `CLOUDAVENUE_PROXY_URL` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(https?|socks5):\/\/\S+$`),
+						"must be a valid proxy URL (http://, https:// or socks5://)",
+					),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The name of the profile to load from the config file `~/.cloudavenue/config.yaml`. The location of the config file can be overridden with the `CLOUDAVENUE_CONFIG_FILE` environment variable. Values set in the provider configuration or in environment variables take precedence over the profile. Can also be set with the `CLOUDAVENUE_PROFILE` environment variable.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"telemetry": schema.SingleNestedBlock{
+				MarkdownDescription: "The telemetry settings of the provider. The provider records the resource name, the organization, the action and the outcome of each CRUD operation.",
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "The telemetry mode. `off` disables the telemetry, `vendor` sends it to the provider maintainers and `otlp` exports OpenTelemetry spans to `otlp_endpoint`. Can also be set with the `CLOUDAVENUE_TELEMETRY` environment variable. Defaults to `vendor`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(metrics.Modes()...),
+						},
+					},
+					"otlp_endpoint": schema.StringAttribute{
+						MarkdownDescription: "The base URL of the OTLP/HTTP endpoint receiving the spans in `otlp` mode. The spans are sent to `<otlp_endpoint>/v1/traces`. Can also be set with the `CLOUDAVENUE_TELEMETRY_OTLP_ENDPOINT` or `OTEL_EXPORTER_OTLP_ENDPOINT` environment variables. Defaults to `" + metrics.DefaultOTLPEndpoint + "`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^https?:\/\/\S+$`),
+								"must be a valid URL (http:// or https://)",
+							),
+						},
+					},
+					"otlp_headers": schema.MapAttribute{
+						MarkdownDescription: "The headers added to the OTLP requests, for example to authenticate to the collector.",
+						Optional:            true,
+						Sensitive:           true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
 		},
 	}
 }
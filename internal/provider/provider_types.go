@@ -3,12 +3,28 @@ package provider
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type cloudavenueProviderModel struct {
-	URL               types.String `tfsdk:"url"`
-	User              types.String `tfsdk:"user"`
-	Password          types.String `tfsdk:"password"`
-	Org               types.String `tfsdk:"org"`
-	VDC               types.String `tfsdk:"vdc"`
-	NetBackupURL      types.String `tfsdk:"netbackup_url"`
-	NetBackupUser     types.String `tfsdk:"netbackup_user"`
-	NetBackupPassword types.String `tfsdk:"netbackup_password"`
+	URL                   types.String            `tfsdk:"url"`
+	User                  types.String            `tfsdk:"user"`
+	Password              types.String            `tfsdk:"password"`
+	Token                 types.String            `tfsdk:"token"`
+	Org                   types.String            `tfsdk:"org"`
+	VDC                   types.String            `tfsdk:"vdc"`
+	NetBackupURL          types.String            `tfsdk:"netbackup_url"`
+	NetBackupUser         types.String            `tfsdk:"netbackup_user"`
+	NetBackupPassword     types.String            `tfsdk:"netbackup_password"`
+	MaxRetries            types.Int64             `tfsdk:"max_retries"`
+	RetryMaxWait          types.Int64             `tfsdk:"retry_max_wait"`
+	MaxConcurrentRequests types.Int64             `tfsdk:"max_concurrent_requests"`
+	MaxRequestsPerSecond  types.Int64             `tfsdk:"max_requests_per_second"`
+	CAFile                types.String            `tfsdk:"ca_file"`
+	Insecure              types.Bool              `tfsdk:"insecure"`
+	ProxyURL              types.String            `tfsdk:"proxy_url"`
+	Profile               types.String            `tfsdk:"profile"`
+	Telemetry             *providerTelemetryModel `tfsdk:"telemetry"`
+}
+
+type providerTelemetryModel struct {
+	Mode         types.String `tfsdk:"mode"`
+	OTLPEndpoint types.String `tfsdk:"otlp_endpoint"`
+	OTLPHeaders  types.Map    `tfsdk:"otlp_headers"`
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/edgegw"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -83,7 +84,7 @@ func (r *publicIPResource) Configure(ctx context.Context, req resource.Configure
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *publicIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	plan := &publicIPResourceModel{}
@@ -117,6 +118,12 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 	ctxTO, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
 	auth, errCtx := helpers.GetAuthContextWithTO(r.client.Auth, ctxTO)
 	if errCtx != nil {
 		resp.Diagnostics.AddError(
@@ -129,7 +136,7 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 	cloudavenue.Lock(ctx)
 	defer cloudavenue.Unlock(ctx)
 
-	edgeGateway, err := r.adminOrg.GetEdgeGateway(edgegw.BaseEdgeGW{
+	edgeGateway, err = r.adminOrg.GetEdgeGateway(edgegw.BaseEdgeGW{
 		Name: plan.EdgeGatewayName,
 		ID:   plan.EdgeGatewayID,
 	})
@@ -154,7 +161,7 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Store existing Public IP
 	// Get Public IP
-	publicIPs, httpR, err := r.client.APIClient.PublicIPApi.GetPublicIPs(auth)
+	publicIPs, httpR, err := apiClient.PublicIPApi.GetPublicIPs(auth)
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
@@ -193,7 +200,7 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 		return apiclient.PublicIpsNetworkConfig{}, fmt.Errorf("no public ip found")
 	}
 
-	job, httpR, err = r.client.APIClient.PublicIPApi.CreatePublicIP(auth, &body)
+	job, httpR, err = apiClient.PublicIPApi.CreatePublicIP(auth, &body)
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
@@ -224,7 +231,7 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// get all Public IPs and find the new one
-	checkPublicIPs, httpRc, errGet := r.client.APIClient.PublicIPApi.GetPublicIPs(auth)
+	checkPublicIPs, httpRc, errGet := apiClient.PublicIPApi.GetPublicIPs(auth)
 	if httpRc != nil {
 		defer func() {
 			err = errors.Join(err, httpRc.Body.Close())
@@ -264,7 +271,7 @@ func (r *publicIPResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *publicIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &publicIPResourceModel{}
 
@@ -291,6 +298,12 @@ func (r *publicIPResource) Read(ctx context.Context, req resource.ReadRequest, r
 	ctxTO, cancel := context.WithTimeout(ctx, readTimeout)
 	defer cancel()
 
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
 	auth, errCtx := helpers.GetAuthContextWithTO(r.client.Auth, ctxTO)
 	if errCtx != nil {
 		resp.Diagnostics.AddError(
@@ -301,7 +314,7 @@ func (r *publicIPResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Get Public IP
-	publicIPs, httpR, err := r.client.APIClient.PublicIPApi.GetPublicIPs(auth)
+	publicIPs, httpR, err := apiClient.PublicIPApi.GetPublicIPs(auth)
 
 	if httpR != nil {
 		defer func() {
@@ -355,7 +368,7 @@ func (r *publicIPResource) Update(ctx context.Context, req resource.UpdateReques
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *publicIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &publicIPResourceModel{}
 
@@ -383,6 +396,12 @@ func (r *publicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 	ctxTO, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
 	auth, errCtx := helpers.GetAuthContextWithTO(r.client.Auth, ctxTO)
 	if errCtx != nil {
 		resp.Diagnostics.AddError(
@@ -396,7 +415,7 @@ func (r *publicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 	defer cloudavenue.Unlock(ctx)
 
 	// Delete the public IP
-	job, httpR, err := r.client.APIClient.PublicIPApi.DeletePublicIP(auth, state.PublicIP.ValueString())
+	job, httpR, err := apiClient.PublicIPApi.DeletePublicIP(auth, state.PublicIP.ValueString())
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
@@ -427,8 +446,14 @@ func (r *publicIPResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *publicIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_publicip", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.DottedName("public_ip", "public IP")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The ID of the resource is the public IP.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.Get("public_ip").Name())...)
 }
@@ -70,7 +70,7 @@ func (d *publicIPDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *publicIPDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_publicips", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_publicips", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	data := &publicIPDataSourceModel{}
 
@@ -85,7 +85,13 @@ func (d *publicIPDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	publicIPs, httpR, err := d.client.APIClient.PublicIPApi.GetPublicIPs(d.client.Auth)
+	apiClient, err := d.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
+	publicIPs, httpR, err := apiClient.PublicIPApi.GetPublicIPs(d.client.Auth)
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
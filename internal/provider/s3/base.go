@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 )
 
 const (
@@ -62,6 +64,9 @@ const (
 	ErrCodeXNotImplemented = "XNotImplemented"
 )
 
+// bucketImportFormat is the format of the import ID of the bucket resources.
+var bucketImportFormat = importid.Format{importid.DottedName("bucket", "S3 bucket")}
+
 // DefaultWaitRetryInterval is used to set the retry interval to 0 during acceptance tests.
 var DefaultWaitRetryInterval *time.Duration
 
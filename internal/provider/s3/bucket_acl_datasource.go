@@ -30,8 +30,11 @@ type BucketACLDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketACLDataSource) Init(ctx context.Context, dm *BucketACLModelDatasource) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
-
+	var err error
+	d.s3Client, err = d.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -61,7 +64,7 @@ func (d *BucketACLDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *BucketACLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_s3_bucket_acl", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_s3_bucket_acl", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &BucketACLModelDatasource{}
 
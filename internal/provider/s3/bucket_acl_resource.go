@@ -15,6 +15,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -37,7 +38,11 @@ type BucketACLResource struct {
 
 // Init Initializes the resource.
 func (r *BucketACLResource) Init(ctx context.Context, rm *BucketACLModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -70,7 +75,7 @@ func (r *BucketACLResource) Configure(ctx context.Context, req resource.Configur
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &BucketACLModel{}
 
@@ -118,7 +123,7 @@ func (r *BucketACLResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &BucketACLModel{}
 
@@ -159,7 +164,7 @@ func (r *BucketACLResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BucketACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &BucketACLModel{}
@@ -211,7 +216,7 @@ func (r *BucketACLResource) Update(ctx context.Context, req resource.UpdateReque
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &BucketACLModel{}
 
@@ -237,10 +242,15 @@ func (r *BucketACLResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *BucketACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_s3_bucket_acl", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, bucketImportFormat)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
-	// * Import basic
-	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), id.Get("bucket").Name())...)
 }
 
 // * CustomFuncs
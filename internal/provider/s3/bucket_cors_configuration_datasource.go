@@ -34,7 +34,11 @@ type BucketCorsConfigurationDatasource struct {
 
 // Init Initializes the data source.
 func (d *BucketCorsConfigurationDatasource) Init(ctx context.Context, dm *BucketCorsConfigurationModelDatasource) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	var err error
+	d.s3Client, err = d.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -64,7 +68,7 @@ func (d *BucketCorsConfigurationDatasource) Configure(ctx context.Context, req d
 }
 
 func (d *BucketCorsConfigurationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_s3_bucket_cors_configuration", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_s3_bucket_cors_configuration", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	data := &BucketCorsConfigurationModelDatasource{}
 
@@ -15,6 +15,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -38,7 +39,11 @@ type BucketCorsConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketCorsConfigurationResource) Init(ctx context.Context, rm *BucketCorsConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -71,7 +76,7 @@ func (r *BucketCorsConfigurationResource) Configure(ctx context.Context, req res
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketCorsConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &BucketCorsConfigurationModel{}
 
@@ -124,7 +129,7 @@ func (r *BucketCorsConfigurationResource) Create(ctx context.Context, req resour
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketCorsConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &BucketCorsConfigurationModel{}
 
@@ -166,7 +171,7 @@ func (r *BucketCorsConfigurationResource) Read(ctx context.Context, req resource
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BucketCorsConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &BucketCorsConfigurationModel{}
@@ -218,7 +223,7 @@ func (r *BucketCorsConfigurationResource) Update(ctx context.Context, req resour
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketCorsConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &BucketCorsConfigurationModel{}
 
@@ -256,8 +261,15 @@ func (r *BucketCorsConfigurationResource) Delete(ctx context.Context, req resour
 }
 
 func (r *BucketCorsConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Import)()
-	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+	defer metrics.New("cloudavenue_s3_bucket_cors_configuration", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, bucketImportFormat)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), id.Get("bucket").Name())...)
 }
 
 // * CustomFuncs
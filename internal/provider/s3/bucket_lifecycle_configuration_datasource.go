@@ -31,8 +31,11 @@ type BucketLifecycleConfigurationDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketLifecycleConfigurationDataSource) Init(ctx context.Context, dm *BucketLifecycleConfigurationDatasourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
-
+	var err error
+	d.s3Client, err = d.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -62,7 +65,7 @@ func (d *BucketLifecycleConfigurationDataSource) Configure(ctx context.Context,
 }
 
 func (d *BucketLifecycleConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_s3_bucket_lifecycle_configuration", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_s3_bucket_lifecycle_configuration", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &BucketLifecycleConfigurationDatasourceModel{}
 
@@ -19,6 +19,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -42,8 +43,11 @@ type BucketLifecycleConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketLifecycleConfigurationResource) Init(ctx context.Context, rm *BucketLifecycleConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
-
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -76,7 +80,7 @@ func (r *BucketLifecycleConfigurationResource) Configure(ctx context.Context, re
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketLifecycleConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &BucketLifecycleConfigurationModel{}
 
@@ -124,7 +128,7 @@ func (r *BucketLifecycleConfigurationResource) Create(ctx context.Context, req r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketLifecycleConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &BucketLifecycleConfigurationModel{}
 
@@ -157,7 +161,7 @@ func (r *BucketLifecycleConfigurationResource) Read(ctx context.Context, req res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BucketLifecycleConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &BucketLifecycleConfigurationModel{}
@@ -209,7 +213,7 @@ func (r *BucketLifecycleConfigurationResource) Update(ctx context.Context, req r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketLifecycleConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &BucketLifecycleConfigurationModel{}
 
@@ -246,10 +250,15 @@ func (r *BucketLifecycleConfigurationResource) Delete(ctx context.Context, req r
 }
 
 func (r *BucketLifecycleConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_s3_bucket_lifecycle_configuration", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, bucketImportFormat)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
-	// * Import basic
-	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), id.Get("bucket").Name())...)
 }
 
 // * CustomFuncs
@@ -31,7 +31,11 @@ type BucketPolicyDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketPolicyDataSource) Init(ctx context.Context, dm *BucketPolicyModelDatasource) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	var err error
+	d.s3Client, err = d.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -61,7 +65,7 @@ func (d *BucketPolicyDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *BucketPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_s3_bucket_policy", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_s3_bucket_policy", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &BucketPolicyModelDatasource{}
 
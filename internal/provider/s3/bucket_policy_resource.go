@@ -18,6 +18,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -40,8 +41,11 @@ type BucketPolicyResource struct {
 
 // Init Initializes the resource.
 func (r *BucketPolicyResource) Init(ctx context.Context, rm *BucketPolicyModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
-
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -74,7 +78,7 @@ func (r *BucketPolicyResource) Configure(ctx context.Context, req resource.Confi
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &BucketPolicyModel{}
 
@@ -115,7 +119,7 @@ func (r *BucketPolicyResource) Create(ctx context.Context, req resource.CreateRe
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &BucketPolicyModel{}
 
@@ -168,7 +172,7 @@ func (r *BucketPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &BucketPolicyModel{}
 
@@ -215,9 +219,15 @@ func (r *BucketPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *BucketPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_s3_bucket_policy", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, bucketImportFormat)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), id.Get("bucket").Name())...)
 }
 
 // genericCreateOrUpdate creates or updates a resource.
@@ -15,6 +15,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -38,7 +39,11 @@ type BucketResource struct {
 
 // Init Initializes the resource.
 func (r *BucketResource) Init(ctx context.Context, rm *BucketModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -71,7 +76,7 @@ func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &BucketModel{}
 
@@ -117,7 +122,7 @@ func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &BucketModel{}
 
@@ -150,13 +155,13 @@ func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 	// All attributes are immutable
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &BucketModel{}
 
@@ -186,8 +191,15 @@ func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Import)()
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	defer metrics.New("cloudavenue_s3_bucket", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, bucketImportFormat)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("bucket").Name())...)
 }
 
 // * CustomFuncs
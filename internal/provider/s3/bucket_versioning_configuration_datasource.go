@@ -32,7 +32,11 @@ type BucketVersioningConfigurationDatasource struct {
 
 // Init Initializes the data source.
 func (d *BucketVersioningConfigurationDatasource) Init(ctx context.Context, dm *BucketVersioningConfigurationDatasourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	var err error
+	d.s3Client, err = d.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -62,7 +66,7 @@ func (d *BucketVersioningConfigurationDatasource) Configure(ctx context.Context,
 }
 
 func (d *BucketVersioningConfigurationDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_s3_bucket_versioning_configuration", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_s3_bucket_versioning_configuration", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	// If the data source don't have same schema/structure as the resource, you can use the following code:
 	data := &BucketVersioningConfigurationDatasourceModel{}
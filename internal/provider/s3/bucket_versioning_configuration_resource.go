@@ -14,6 +14,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -37,7 +38,11 @@ type BucketVersioningConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketVersioningConfigurationResource) Init(ctx context.Context, rm *BucketVersioningConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -70,7 +75,7 @@ func (r *BucketVersioningConfigurationResource) Configure(ctx context.Context, r
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketVersioningConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &BucketVersioningConfigurationModel{}
 
@@ -116,7 +121,7 @@ func (r *BucketVersioningConfigurationResource) Create(ctx context.Context, req
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketVersioningConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &BucketVersioningConfigurationModel{}
 
@@ -149,7 +154,7 @@ func (r *BucketVersioningConfigurationResource) Read(ctx context.Context, req re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BucketVersioningConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &BucketVersioningConfigurationModel{}
@@ -195,7 +200,7 @@ func (r *BucketVersioningConfigurationResource) Update(ctx context.Context, req
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketVersioningConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &BucketVersioningConfigurationModel{}
 
@@ -228,10 +233,15 @@ func (r *BucketVersioningConfigurationResource) Delete(ctx context.Context, req
 }
 
 func (r *BucketVersioningConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_s3_bucket_versioning_configuration", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, bucketImportFormat)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
-	// * Import basic
-	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), id.Get("bucket").Name())...)
 }
 
 // * CustomFuncs
@@ -30,7 +30,11 @@ type BucketWebsiteConfigurationDataSource struct {
 
 // Init Initializes the data source.
 func (d *BucketWebsiteConfigurationDataSource) Init(ctx context.Context, dm *BucketWebsiteConfigurationDataSourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	var err error
+	d.s3Client, err = d.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -60,7 +64,7 @@ func (d *BucketWebsiteConfigurationDataSource) Configure(ctx context.Context, re
 }
 
 func (d *BucketWebsiteConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_s3_bucket_website_configuration", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_s3_bucket_website_configuration", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &BucketWebsiteConfigurationDataSourceModel{}
 
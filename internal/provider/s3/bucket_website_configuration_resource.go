@@ -19,6 +19,7 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -41,8 +42,11 @@ type BucketWebsiteConfigurationResource struct {
 
 // Init Initializes the resource.
 func (r *BucketWebsiteConfigurationResource) Init(ctx context.Context, rm *BucketWebsiteConfigurationModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
-
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -75,7 +79,7 @@ func (r *BucketWebsiteConfigurationResource) Configure(ctx context.Context, req
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *BucketWebsiteConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &BucketWebsiteConfigurationModel{}
 
@@ -143,7 +147,7 @@ func (r *BucketWebsiteConfigurationResource) Create(ctx context.Context, req res
 
 // Read refreshes the Terraform state with the latest data.
 func (r *BucketWebsiteConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &BucketWebsiteConfigurationModel{}
 
@@ -176,7 +180,7 @@ func (r *BucketWebsiteConfigurationResource) Read(ctx context.Context, req resou
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *BucketWebsiteConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &BucketWebsiteConfigurationModel{}
@@ -238,7 +242,7 @@ func (r *BucketWebsiteConfigurationResource) Update(ctx context.Context, req res
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *BucketWebsiteConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &BucketWebsiteConfigurationModel{}
 
@@ -282,10 +286,15 @@ func (r *BucketWebsiteConfigurationResource) Delete(ctx context.Context, req res
 }
 
 func (r *BucketWebsiteConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_s3_bucket_website_configuration", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, bucketImportFormat)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
-	// * Import basic
-	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), id.Get("bucket").Name())...)
 }
 
 // * CustomFuncs
@@ -0,0 +1,168 @@
+package s3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &CredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &CredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &CredentialEphemeralResource{}
+)
+
+// credentialPrivateKey is the key of the private data storing the credential to revoke on close.
+const credentialPrivateKey = "credential"
+
+// NewCredentialEphemeralResource is a helper function to simplify the provider implementation.
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &CredentialEphemeralResource{}
+}
+
+// CredentialEphemeralResource is the ephemeral resource implementation.
+type CredentialEphemeralResource struct {
+	client *client.CloudAvenue
+}
+
+type credentialPrivateData struct {
+	Username  string `json:"username"`
+	AccessKey string `json:"access_key"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *CredentialEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + categoryName + "_credential"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *CredentialEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = credentialEphemeralSchema(ctx)
+}
+
+func (r *CredentialEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudAvenue)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.CloudAvenue, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// Open creates an access key and a secret key for the run. The keys are only returned in the result and never stored in the state.
+func (r *CredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Open, &resp.Diagnostics)()
+
+	config := &CredentialEphemeralModel{}
+
+	// Retrieve values from config
+	resp.Diagnostics.Append(req.Config.Get(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Client, err := r.client.S3()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Configure S3 Client", err.Error())
+		return
+	}
+
+	user, oseErr := s3Client.GetUser(r.client.GetUserName())
+	if oseErr != nil {
+		if oseErr.IsNotFountError() {
+			resp.Diagnostics.AddError("User not found", fmt.Sprintf("The user %s is not found", r.client.GetUserName()))
+			return
+		}
+		resp.Diagnostics.AddError("Error getting user", oseErr.Error())
+		return
+	}
+
+	cred, err := user.NewCredential()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating credential", err.Error())
+		return
+	}
+
+	// Keep the access key to revoke the credential on close.
+	private, err := json.Marshal(credentialPrivateData{
+		Username:  user.GetName(),
+		AccessKey: cred.GetAccessKey(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshalling private data", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, credentialPrivateKey, private)...)
+
+	config.Username = types.StringValue(user.GetName())
+	config.AccessKey = types.StringValue(cred.GetAccessKey())
+	config.SecretKey = types.StringValue(cred.GetSecretKey())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+// Close revokes the credential created by Open.
+func (r *CredentialEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Close, &resp.Diagnostics)()
+
+	data, diags := req.Private.GetKey(ctx, credentialPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	private := credentialPrivateData{}
+	if err := json.Unmarshal(data, &private); err != nil {
+		resp.Diagnostics.AddError("Error unmarshalling private data", err.Error())
+		return
+	}
+
+	s3Client, err := r.client.S3()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Configure S3 Client", err.Error())
+		return
+	}
+
+	user, oseErr := s3Client.GetUser(private.Username)
+	if oseErr != nil {
+		if oseErr.IsNotFountError() {
+			// The user and its credentials are already gone
+			return
+		}
+		resp.Diagnostics.AddError("Error getting user", oseErr.Error())
+		return
+	}
+
+	// The credentials are listed, the lookup of a single credential does not tell a missing credential from an error.
+	creds, err := user.GetCredentials()
+	if err != nil {
+		resp.Diagnostics.AddError("Error getting credentials", err.Error())
+		return
+	}
+
+	for _, cred := range *creds {
+		if cred.GetAccessKey() != private.AccessKey {
+			continue
+		}
+		if err := cred.Delete(); err != nil {
+			resp.Diagnostics.AddError("Error revoking credential", err.Error())
+		}
+		return
+	}
+	// Already revoked
+}
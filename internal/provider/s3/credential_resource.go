@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,13 +15,15 @@ import (
 	v1 "github.com/orange-cloudavenue/cloudavenue-sdk-go/v1"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &CredentialResource{}
-	_ resource.ResourceWithConfigure  = &CredentialResource{}
-	_ resource.ResourceWithModifyPlan = &CredentialResource{}
+	_ resource.Resource                = &CredentialResource{}
+	_ resource.ResourceWithConfigure   = &CredentialResource{}
+	_ resource.ResourceWithModifyPlan  = &CredentialResource{}
+	_ resource.ResourceWithImportState = &CredentialResource{}
 )
 
 // NewCredentialResource is a helper function to simplify the provider implementation.
@@ -35,7 +39,11 @@ type CredentialResource struct {
 
 // Init Initializes the resource.
 func (r *CredentialResource) Init(ctx context.Context, rm *CredentialModel) (diags diag.Diagnostics) {
-	r.s3Client = r.client.CAVSDK.V1.S3()
+	var err error
+	r.s3Client, err = r.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -102,7 +110,7 @@ func (r *CredentialResource) ModifyPlan(ctx context.Context, req resource.Modify
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &CredentialModel{}
 
@@ -193,7 +201,7 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &CredentialModel{}
 
@@ -226,13 +234,13 @@ func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 	// No update for this resource
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &CredentialModel{}
 
@@ -270,6 +278,31 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+func (r *CredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_s3_credential", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.Name("access_key", "credential")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// The secret key is only available when the credential is created,
+	// the attributes used at the creation are set to their default value.
+	state := &CredentialModel{
+		ID:            supertypes.NewStringNull(),
+		Username:      supertypes.NewStringValue(r.client.GetUserName()),
+		FileName:      supertypes.NewStringValue("token.json"),
+		SaveInFile:    supertypes.NewBoolValue(false),
+		PrintToken:    supertypes.NewBoolValue(false),
+		SaveInTFState: supertypes.NewBoolValue(false),
+		AccessKey:     supertypes.NewStringValue(id.Get("access_key").Name()),
+		SecretKey:     supertypes.NewStringValue(""),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
 // * CustomFuncs
 
 // read is a generic read function that can be used by the resource Create, Read and Update functions.
@@ -3,6 +3,7 @@ package s3
 import (
 	"context"
 
+	schemaE "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	schemaR "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -103,3 +104,27 @@ func credentialSchema(_ context.Context) superschema.Schema {
 		},
 	}
 }
+
+// credentialEphemeralSchema is the schema of the ephemeral resource.
+// superschema does not support the ephemeral resources, the schema is declared with the framework.
+func credentialEphemeralSchema(_ context.Context) schemaE.Schema {
+	return schemaE.Schema{
+		MarkdownDescription: "The `cloudavenue_s3_credential` ephemeral resource creates an access key and a secret key for the S3 user of the provider for the duration of a Terraform run. The keys are never stored in the plan or the state and the credential is revoked when the run ends.",
+		Attributes: map[string]schemaE.Attribute{
+			"username": schemaE.StringAttribute{
+				MarkdownDescription: "The username is configured at the provider level.",
+				Computed:            true,
+			},
+			"access_key": schemaE.StringAttribute{
+				MarkdownDescription: "The Access Key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"secret_key": schemaE.StringAttribute{
+				MarkdownDescription: "The Secret Key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
@@ -3,6 +3,8 @@ package s3
 import (
 	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
@@ -22,3 +24,10 @@ func (rm *CredentialModel) Copy() *CredentialModel {
 	utils.ModelCopy(rm, x)
 	return x
 }
+
+// CredentialEphemeralModel is the model of the ephemeral resource cloudavenue_s3_credential.
+type CredentialEphemeralModel struct {
+	Username  types.String `tfsdk:"username"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+}
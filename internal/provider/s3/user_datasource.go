@@ -31,7 +31,11 @@ type UserDataSource struct {
 
 // Init Initializes the data source.
 func (d *UserDataSource) Init(ctx context.Context, dm *UserDataSourceModel) (diags diag.Diagnostics) {
-	d.s3Client = d.client.CAVSDK.V1.S3()
+	var err error
+	d.s3Client, err = d.client.S3()
+	if err != nil {
+		diags.AddError("Unable to Configure S3 Client", err.Error())
+	}
 	return
 }
 
@@ -61,7 +65,7 @@ func (d *UserDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_s3_user", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_s3_user", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := new(UserDataSourceModel)
 
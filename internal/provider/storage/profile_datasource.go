@@ -68,7 +68,7 @@ func (d *profileDataSource) Configure(ctx context.Context, req datasource.Config
 }
 
 func (d *profileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_storage_profile", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_storage_profile", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &profileDataSourceModel{}
 
@@ -84,6 +84,12 @@ func (d *profileDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	vmware, err := d.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	/*
 		Implement the data source read logic here.
 	*/
@@ -106,7 +112,7 @@ func (d *profileDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	storageProfile, err := d.client.Vmware.GetStorageProfileByHref(storageProfileRef.HREF)
+	storageProfile, err := vmware.GetStorageProfileByHref(storageProfileRef.HREF)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Storage Profile (Reference) not found",
@@ -79,7 +79,7 @@ func (d *profilesDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *profilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_storage_profiles", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_storage_profiles", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &profilesDataSourceModel{}
 
@@ -95,6 +95,12 @@ func (d *profilesDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	vmware, err := d.client.Vmware()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create VMWare VCD Client", err.Error())
+		return
+	}
+
 	/*
 		Implement the data source read logic here.
 	*/
@@ -106,7 +112,7 @@ func (d *profilesDataSource) Read(ctx context.Context, req datasource.ReadReques
 			VDC: types.StringValue(d.vdc.GetName()),
 		}
 
-		storageProfile, err := d.client.Vmware.GetStorageProfileByHref(sP.HREF)
+		storageProfile, err := vmware.GetStorageProfileByHref(sP.HREF)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Storage Profile (Reference) not found",
@@ -4,12 +4,10 @@ package vapp
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +15,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/acl"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &aclResource{}
 	_ resource.ResourceWithConfigure   = &aclResource{}
 	_ resource.ResourceWithImportState = &aclResource{}
+	_ resource.ResourceWithMoveState   = &aclResource{}
 )
 
 // NewaclResource is a helper function to simplify the provider implementation.
@@ -83,7 +84,7 @@ func (r *aclResource) Configure(ctx context.Context, req resource.ConfigureReque
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -116,7 +117,7 @@ func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read refreshes the Terraform state with the latest data.
 func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var state *aclResourceModel
 
@@ -187,7 +188,7 @@ func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var plan *aclResourceModel
 
@@ -218,7 +219,7 @@ func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *aclResourceModel
 
@@ -249,23 +250,25 @@ func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vapp_acl", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 1 && len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: vdc.vapp_name or vapp_name. Got: %q", req.ID),
-		)
+	// Without vdc, the VDC of the provider is used.
+	id, d := importid.Parse(req.ID,
+		importid.Format{importid.VDC, importid.VApp},
+		importid.Format{importid.VApp},
+	)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
-	if len(idParts) == 1 {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), req.ID)...)
-	} else {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), idParts[0])...)
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), idParts[1])...)
+	resp.Diagnostics.Append(importVApp(ctx, r.client, id, &resp.State)...)
+}
+
+// MoveState moves the state of the vcd_vapp_access_control resource of the vcd provider to the resource.
+func (r *aclResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vapp_access_control", nil),
 	}
 }
 
@@ -297,14 +300,20 @@ func (r *aclResource) createOrUpdateACL(ctx context.Context, plan *aclResourceMo
 	} else {
 		var sharedListOutput []*acl.SharedWithModel
 
+		vmware, err := r.client.Vmware()
+		if err != nil {
+			diags.AddError("Unable to Create VMWare VCD Client", err.Error())
+			return nil, diags
+		}
+
 		// Get admin Org
-		adminOrg, err := r.client.Vmware.GetAdminOrgByNameOrId(r.client.GetOrgName())
+		adminOrg, err := vmware.GetAdminOrgByNameOrId(r.client.GetOrgName())
 		if err != nil {
 			diags.AddError("Error retrieving Org", err.Error())
 			return nil, diags
 		}
 
-		accessSettings, sharedListOutput, err = acl.SharedSetToAccessControl(r.client.Vmware, adminOrg, sharedList)
+		accessSettings, sharedListOutput, err = acl.SharedSetToAccessControl(vmware, adminOrg, sharedList)
 		if err != nil {
 			diags.AddError("Error when reading shared_with from schema.", err.Error())
 			return nil, diags
@@ -1,5 +1,37 @@
 package vapp
 
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+)
+
 const (
 	categoryName = "vapp"
 )
+
+// importVApp sets the vdc and the vApp of the import ID in the state.
+// The vapp_id attribute is set if the vApp is imported with its URN, the vapp_name attribute otherwise.
+func importVApp(ctx context.Context, c *client.CloudAvenue, id importid.ID, state *tfsdk.State) (diags diag.Diagnostics) {
+	if id.Has("vdc") {
+		name, d := vdc.NameOf(c, id.Get("vdc").String())
+		if d.HasError() {
+			return d
+		}
+		diags.Append(state.SetAttribute(ctx, path.Root("vdc"), name)...)
+	}
+
+	if vApp := id.Get("vapp"); vApp.IsID() {
+		diags.Append(state.SetAttribute(ctx, path.Root("vapp_id"), vApp.ID())...)
+	} else {
+		diags.Append(state.SetAttribute(ctx, path.Root("vapp_name"), vApp.Name())...)
+	}
+
+	return diags
+}
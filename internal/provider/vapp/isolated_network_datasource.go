@@ -86,7 +86,7 @@ func (d *isolatedNetworkDataSource) Configure(ctx context.Context, req datasourc
 }
 
 func (d *isolatedNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vapp_isolated_network", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vapp_isolated_network", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var (
 		config      = &isolatedNetworkDataSourceModel{}
@@ -4,7 +4,6 @@ package vapp
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -18,6 +17,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &isolatedNetworkResource{}
 	_ resource.ResourceWithConfigure   = &isolatedNetworkResource{}
 	_ resource.ResourceWithImportState = &isolatedNetworkResource{}
+	_ resource.ResourceWithMoveState   = &isolatedNetworkResource{}
 )
 
 // NewIsolatedNetworkResource is a helper function to simplify the provider implementation.
@@ -92,7 +94,7 @@ func (r *isolatedNetworkResource) Configure(ctx context.Context, req resource.Co
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *isolatedNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -188,7 +190,7 @@ func (r *isolatedNetworkResource) Create(ctx context.Context, req resource.Creat
 
 // Read refreshes the Terraform state with the latest data.
 func (r *isolatedNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var (
 		state *isolatedNetworkResourceModel
@@ -283,7 +285,7 @@ func (r *isolatedNetworkResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *isolatedNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var plan *isolatedNetworkResourceModel
 
@@ -352,7 +354,7 @@ func (r *isolatedNetworkResource) Update(ctx context.Context, req resource.Updat
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *isolatedNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *isolatedNetworkResourceModel
 
@@ -383,24 +385,25 @@ func (r *isolatedNetworkResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *isolatedNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vapp_isolated_network", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 3 && len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: vdc.vapp_name.network_name or vapp_name.network_name. Got: %q", req.ID),
-		)
+	// Without vdc, the VDC of the provider is used.
+	id, d := importid.Parse(req.ID,
+		importid.Format{importid.VDC, importid.VApp, importid.Name("network", "vApp isolated network")},
+		importid.Format{importid.VApp, importid.Name("network", "vApp isolated network")},
+	)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	resp.Diagnostics.Append(importVApp(ctx, r.client, id, &resp.State)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("network").Name())...)
+}
 
-	if len(idParts) == 3 {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), idParts[0])...)
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), idParts[1])...)
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+// MoveState moves the state of the vcd_vapp_network resource of the vcd provider to the resource.
+func (r *isolatedNetworkResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vapp_network", nil),
 	}
 }
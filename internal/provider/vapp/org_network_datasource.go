@@ -85,7 +85,7 @@ func (d *orgNetworkDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *orgNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vapp_org_network", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vapp_org_network", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data *orgNetworkModel
 
@@ -4,7 +4,6 @@ package vapp
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"golang.org/x/exp/slices"
 
@@ -12,12 +11,15 @@ import (
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/network"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
@@ -30,6 +32,7 @@ var (
 	_ resource.Resource                = &orgNetworkResource{}
 	_ resource.ResourceWithConfigure   = &orgNetworkResource{}
 	_ resource.ResourceWithImportState = &orgNetworkResource{}
+	_ resource.ResourceWithMoveState   = &orgNetworkResource{}
 )
 
 // NewOrgNetworkResource is a helper function to simplify the provider implementation.
@@ -87,7 +90,7 @@ func (r *orgNetworkResource) Configure(ctx context.Context, req resource.Configu
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *orgNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -160,7 +163,7 @@ func (r *orgNetworkResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (r *orgNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var state *orgNetworkModel
 
@@ -215,13 +218,13 @@ func (r *orgNetworkResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *orgNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 	// No update for this resource
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *orgNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *orgNetworkModel
 
@@ -312,29 +315,27 @@ func (r *orgNetworkResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *orgNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vapp_org_network", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	var state *orgNetworkModel
-	resourceURI := strings.Split(req.ID, ".")
-
-	if len(resourceURI) != 3 && len(resourceURI) != 2 {
-		resp.Diagnostics.AddError("Error importing org_network", "Wrong resource URI format. Expected vdc.vapp.org_network_name or vapp.org_network_name")
+	// Without vdc, the VDC of the provider is used.
+	id, d := importid.Parse(req.ID,
+		importid.Format{importid.VDC, importid.VApp, importid.Name("network", "org network")},
+		importid.Format{importid.VApp, importid.Name("network", "org network")},
+	)
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
 		return
 	}
 
-	state = &orgNetworkModel{
-		VAppName:    types.StringValue(resourceURI[0]),
-		NetworkName: types.StringValue(resourceURI[1]),
-	}
+	resp.Diagnostics.Append(importVApp(ctx, r.client, id, &resp.State)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_name"), id.Get("network").Name())...)
+}
 
-	if len(resourceURI) == 3 {
-		state = &orgNetworkModel{
-			VDC:         types.StringValue(resourceURI[0]),
-			VAppName:    types.StringValue(resourceURI[1]),
-			NetworkName: types.StringValue(resourceURI[2]),
-		}
+// MoveState moves the state of the vcd_vapp_org_network resource of the vcd provider to the resource.
+func (r *orgNetworkResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vapp_org_network", map[string]string{
+			"org_network_name": "network_name",
+		}),
 	}
-
-	// Set state to fully populated data
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -68,7 +68,7 @@ func (d *vappDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *vappDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vapp", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vapp", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &vappResourceModel{}
 
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
@@ -15,7 +14,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
@@ -25,8 +23,11 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,6 +35,7 @@ var (
 	_ resource.Resource                = &vappResource{}
 	_ resource.ResourceWithConfigure   = &vappResource{}
 	_ resource.ResourceWithImportState = &vappResource{}
+	_ resource.ResourceWithMoveState   = &vappResource{}
 )
 
 // NewVappResource is a helper function to simplify the provider implementation.
@@ -91,7 +93,7 @@ func (r *vappResource) Configure(ctx context.Context, req resource.ConfigureRequ
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *vappResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -164,7 +166,7 @@ func (r *vappResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *vappResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := new(vappResourceModel)
 
@@ -196,7 +198,7 @@ func (r *vappResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vappResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = new(vappResourceModel)
@@ -246,7 +248,7 @@ func (r *vappResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *vappResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	// Get current state
 	state := new(vappResourceModel)
@@ -301,33 +303,25 @@ func (r *vappResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *vappResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vapp", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	var (
-		diags        diag.Diagnostics
-		vAppIDOrName string
+	// Without vdc, the VDC of the provider is used.
+	id, d := importid.Parse(req.ID,
+		importid.Format{importid.VDC, importid.VApp},
+		importid.Format{importid.VApp},
 	)
-
-	// Split req.ID with dot. ID format is EdgeGatewayIDOrName.StaticRouteNameOrID
-	idParts := strings.Split(req.ID, ".")
-
-	switch len(idParts) {
-	case 1:
-		vAppIDOrName = idParts[0]
-		r.vdc, diags = vdc.Init(r.client, basetypes.NewStringNull())
-		resp.Diagnostics.Append(diags...)
-	case 2:
-		vAppIDOrName = idParts[1]
-		r.vdc, diags = vdc.Init(r.client, basetypes.NewStringValue(idParts[0]))
-		resp.Diagnostics.Append(diags...)
-	default:
-		resp.Diagnostics.AddError("Invalid ID format", fmt.Sprintf("ID format is VDCName.VAppIDOrName or VAppIDOrName, got: %s", req.ID))
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
 	}
+
+	r.vdc, d = vdc.Init(r.client, utils.StringValueOrNull(id.Get("vdc").String()))
+	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	vapp, err := r.vdc.GetVAppByNameOrId(vAppIDOrName, true)
+	vapp, err := r.vdc.GetVAppByNameOrId(id.Get("vapp").String(), true)
 	if err != nil {
 		if errors.Is(err, govcd.ErrorEntityNotFound) {
 			resp.Diagnostics.AddError("vApp not found", err.Error())
@@ -342,6 +336,13 @@ func (r *vappResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), r.vdc.GetName())...)
 }
 
+// MoveState moves the state of the vcd_vapp resource of the vcd provider to the resource.
+func (r *vappResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vapp", nil),
+	}
+}
+
 // tryUndeploy try to undeploy a vApp, but do not throw an error if the vApp is powered off.
 // Very often the vApp is powered off at this point and Undeploy() would fail with error:
 // "The requested operation could not be executed since vApp vApp_name is not running"
@@ -15,6 +15,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
@@ -66,12 +67,18 @@ func (r *vcdaIPResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 
 	r.client = client
-	r.vcda = r.client.CAVSDK.V1.VCDA
+
+	cavSDK, err := r.client.CAVSDK()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue SDK Client", err.Error())
+		return
+	}
+	r.vcda = cavSDK.V1.VCDA
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *vcdaIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vcda_ip", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vcda_ip", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := new(vcdaIPResourceModel)
 
@@ -115,7 +122,7 @@ func (r *vcdaIPResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *vcdaIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vcda_ip", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vcda_ip", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := new(vcdaIPResourceModel)
 
@@ -144,7 +151,7 @@ func (r *vcdaIPResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *vcdaIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vcda_ip", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vcda_ip", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := new(vcdaIPResourceModel)
 
@@ -171,11 +178,18 @@ func (r *vcdaIPResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *vcdaIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_address"), req.ID)...)
+	id, d := importid.Parse(req.ID, importid.Format{importid.DottedName("ip_address", "IP address")})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	ipAddress := id.Get("ip_address").Name()
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_address"), ipAddress)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(uuid.Normalize(
 		uuid.VCDA,
 		utils.GenerateUUID(
-			req.ID,
+			ipAddress,
 		).ValueString(),
 	).String()))...)
 }
@@ -16,6 +16,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/acl"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 )
 
@@ -24,6 +26,7 @@ var (
 	_ resource.Resource                = &aclResource{}
 	_ resource.ResourceWithConfigure   = &aclResource{}
 	_ resource.ResourceWithImportState = &aclResource{}
+	_ resource.ResourceWithMoveState   = &aclResource{}
 )
 
 // NewACLResource is a helper function to simplify the provider implementation.
@@ -78,7 +81,7 @@ func (r *aclResource) Configure(ctx context.Context, req resource.ConfigureReque
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -111,7 +114,7 @@ func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read refreshes the Terraform state with the latest data.
 func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var state *aclResourceModel
 
@@ -180,7 +183,7 @@ func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var plan *aclResourceModel
 
@@ -211,7 +214,7 @@ func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *aclResourceModel
 
@@ -236,9 +239,29 @@ func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 //go:generate tf-doc-extractor -filename $GOFILE -example-dir ../../../examples -resource
 func (r *aclResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vdc_acl", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	resource.ImportStatePassthroughID(ctx, path.Root("vdc"), req, resp)
+	id, d := importid.Parse(req.ID, importid.Format{importid.VDC})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// The vdc attribute is the name of the VDC.
+	name, d := vdc.NameOf(r.client, id.Get("vdc").String())
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), name)...)
+}
+
+// MoveState moves the state of the vcd_org_vdc_access_control resource of the vcd provider to the resource.
+func (r *aclResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_org_vdc_access_control", nil),
+	}
 }
 
 func (r *aclResource) createOrUpdateACL(ctx context.Context, plan *aclResourceModel) (*aclResourceModel, diag.Diagnostics) {
@@ -260,14 +283,20 @@ func (r *aclResource) createOrUpdateACL(ctx context.Context, plan *aclResourceMo
 	if !isSharedWithEveryone {
 		everyoneAccessLevel = ""
 
+		vmware, err := r.client.Vmware()
+		if err != nil {
+			diags.AddError("Unable to Create VMWare VCD Client", err.Error())
+			return nil, diags
+		}
+
 		// Get admin Org
-		adminOrg, err := r.client.Vmware.GetAdminOrgByNameOrId(r.client.GetOrgName())
+		adminOrg, err := vmware.GetAdminOrgByNameOrId(r.client.GetOrgName())
 		if err != nil {
 			diags.AddError("Error retrieving Org", err.Error())
 			return nil, diags
 		}
 
-		accessSettings, sharedListOutput, err = acl.SharedSetToAccessControl(r.client.Vmware, adminOrg, sharedList)
+		accessSettings, sharedListOutput, err = acl.SharedSetToAccessControl(vmware, adminOrg, sharedList)
 		if err != nil {
 			diags.AddError("Error when reading shared_with from schema.", err.Error())
 			return nil, diags
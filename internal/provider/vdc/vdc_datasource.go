@@ -57,7 +57,7 @@ func (d *vdcDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 }
 
 func (d *vdcDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vdc", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vdc", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data vdcDataSourceModel
 
@@ -69,7 +69,13 @@ func (d *vdcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	}
 
 	// Get vDC info
-	vdc, httpR, err := d.client.APIClient.VDCApi.GetOrgVdcByName(d.client.Auth, data.Name.ValueString())
+	apiClient, err := d.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
+	vdc, httpR, err := apiClient.VDCApi.GetOrgVdcByName(d.client.Auth, data.Name.ValueString())
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
@@ -83,7 +89,7 @@ func (d *vdcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	// Get vDC UUID by parsing vDCs list and set URN ID
 	var ID string
-	vdcs, httpR, err := d.client.APIClient.VDCApi.GetOrgVdcs(d.client.Auth)
+	vdcs, httpR, err := apiClient.VDCApi.GetOrgVdcs(d.client.Auth)
 
 	if httpR != nil {
 		defer func() {
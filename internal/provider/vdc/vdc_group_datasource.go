@@ -63,7 +63,7 @@ func (d *vdcGroupDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *vdcGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vdc_group", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vdc_group", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &GroupModel{}
 
@@ -15,7 +15,9 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminorg"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -71,7 +73,7 @@ func (r *groupResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &GroupModel{}
 
@@ -116,7 +118,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &GroupModel{}
 
@@ -152,7 +154,7 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var (
 		plan  = &GroupModel{}
@@ -206,7 +208,7 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &GroupModel{}
 
@@ -239,14 +241,13 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vdc_group", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	// id format is vdcGroupIDOrName
-
-	var (
-		d   diag.Diagnostics
-		err error
-	)
+	id, d := importid.Parse(req.ID, importid.Format{importid.NameOrURN("vdc_group", "VDC group", uuid.VDCGroup)})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
 
 	r.adminOrg, d = adminorg.Init(r.client)
 	if d.HasError() {
@@ -254,7 +255,7 @@ func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	vdcGroup, err := r.adminOrg.GetVDCGroupByNameOrID(req.ID)
+	vdcGroup, err := r.adminOrg.GetVDCGroupByNameOrID(id.Get("vdc_group").String())
 	if err != nil {
 		resp.Diagnostics.AddError("Error retrieving VDC Group", err.Error())
 		return
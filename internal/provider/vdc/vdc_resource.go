@@ -21,16 +21,30 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/cloudavenue"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vdcResource{}
-	_ resource.ResourceWithConfigure   = &vdcResource{}
-	_ resource.ResourceWithImportState = &vdcResource{}
+	_ resource.Resource                 = &vdcResource{}
+	_ resource.ResourceWithConfigure    = &vdcResource{}
+	_ resource.ResourceWithImportState  = &vdcResource{}
+	_ resource.ResourceWithMoveState    = &vdcResource{}
+	_ resource.ResourceWithUpgradeState = &vdcResource{}
 )
 
+// vdcUpgradeSteps are the steps upgrading the state of the resource, the schema version is their number.
+var vdcUpgradeSteps = []stateupgrade.Step{
+	// 0 to 1: the vdc_group attribute was removed in the release 0.12.0, use the cloudavenue_vdc_group resource instead.
+	func(s stateupgrade.State) error {
+		s.Remove("vdc_group")
+		return nil
+	},
+}
+
 // NewVDCResource is a helper function to simplify the provider implementation.
 func NewVDCResource() resource.Resource {
 	return &vdcResource{}
@@ -49,6 +63,7 @@ func (r *vdcResource) Metadata(_ context.Context, req resource.MetadataRequest,
 // Schema defines the schema for the resource.
 func (r *vdcResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = vdcSchema().GetResource(ctx)
+	resp.Schema.Version = stateupgrade.Version(vdcUpgradeSteps)
 }
 
 // Configure configures the resource.
@@ -74,7 +89,7 @@ func (r *vdcResource) Configure(ctx context.Context, req resource.ConfigureReque
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var plan *vdcResourceModel
@@ -99,6 +114,12 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	ctxTO, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
 	auth, errCtx := helpers.GetAuthContextWithTO(r.client.Auth, ctxTO)
 	if errCtx != nil {
 		resp.Diagnostics.AddError(
@@ -138,7 +159,6 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 		})
 	}
 
-	var err error
 	var job apiclient.Jobcreated
 	var httpR *http.Response
 
@@ -146,7 +166,7 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 	defer cloudavenue.Unlock(ctx)
 
 	// Call API to create the resource and test for errors.
-	job, httpR, err = r.client.APIClient.VDCApi.CreateOrgVdc(auth, body)
+	job, httpR, err = apiClient.VDCApi.CreateOrgVdc(auth, body)
 
 	if httpR != nil {
 		defer func() {
@@ -179,7 +199,7 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	// Get vDC UUID by parsing vDCs list and set URN ID
 	var ID string
-	vdcs, httpR, err := r.client.APIClient.VDCApi.GetOrgVdcs(auth)
+	vdcs, httpR, err := apiClient.VDCApi.GetOrgVdcs(auth)
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
@@ -205,7 +225,7 @@ func (r *vdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read refreshes the Terraform state with the latest data.
 func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	// Get current state
 	var state *vdcResourceModel
@@ -230,6 +250,12 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	ctxTO, cancel := context.WithTimeout(ctx, readTimeout)
 	defer cancel()
 
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
 	auth, errCtx := helpers.GetAuthContextWithTO(r.client.Auth, ctxTO)
 	if errCtx != nil {
 		resp.Diagnostics.AddError(
@@ -240,7 +266,7 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get vDC info
-	vdc, httpR, err := r.client.APIClient.VDCApi.GetOrgVdcByName(auth, state.Name.ValueString())
+	vdc, httpR, err := apiClient.VDCApi.GetOrgVdcByName(auth, state.Name.ValueString())
 
 	if httpR != nil {
 		defer func() {
@@ -261,7 +287,7 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	// Get vDC UUID by parsing vDCs list and set URN ID
 	var ID string
-	vdcs, httpR, err := r.client.APIClient.VDCApi.GetOrgVdcs(auth)
+	vdcs, httpR, err := apiClient.VDCApi.GetOrgVdcs(auth)
 
 	if httpR != nil {
 		defer func() {
@@ -314,7 +340,7 @@ func (r *vdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 	var (
 		plan  *vdcResourceModel
 		state *vdcResourceModel
@@ -341,6 +367,12 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	ctxTO, cancel := context.WithTimeout(ctx, updateTimeout)
 	defer cancel()
 
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
 	auth, errCtx := helpers.GetAuthContextWithTO(r.client.Auth, ctxTO)
 	if errCtx != nil {
 		resp.Diagnostics.AddError(
@@ -352,9 +384,8 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	// Get vDC info
 	var httpR *http.Response
-	var err error
 	// Due a bug in CloudAvenue the field VdcGroup is mandatory in the body
-	vdc, httpR, err := r.client.APIClient.VDCApi.GetOrgVdcByName(auth, state.Name.Get())
+	vdc, httpR, err := apiClient.VDCApi.GetOrgVdcByName(auth, state.Name.Get())
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
@@ -399,7 +430,7 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	defer cloudavenue.Unlock(ctx)
 
 	// Call API to update the resource and test for errors.
-	job, httpR, err = r.client.APIClient.VDCApi.UpdateOrgVdc(auth, body, body.Vdc.Name)
+	job, httpR, err = apiClient.VDCApi.UpdateOrgVdc(auth, body, body.Vdc.Name)
 	if httpR != nil {
 		defer func() {
 			err = errors.Join(err, httpR.Body.Close())
@@ -435,7 +466,7 @@ func (r *vdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *vdcResourceModel
 
@@ -458,6 +489,12 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	ctxTO, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
+	apiClient, err := r.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
 	auth, errCtx := helpers.GetAuthContextWithTO(r.client.Auth, ctxTO)
 	if errCtx != nil {
 		resp.Diagnostics.AddError(
@@ -471,7 +508,7 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	defer cloudavenue.Unlock(ctx)
 
 	// Delete the VDC
-	job, httpR, err := r.client.APIClient.VDCApi.DeleteOrgVdc(auth, state.Name.ValueString())
+	job, httpR, err := apiClient.VDCApi.DeleteOrgVdc(auth, state.Name.ValueString())
 
 	if httpR != nil {
 		defer func() {
@@ -504,8 +541,36 @@ func (r *vdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *vdcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vdc", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	id, d := importid.Parse(req.ID, importid.Format{importid.VDC})
+	if d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	// The VDC is read by name.
+	name := id.Get("vdc").Name()
+	if name == "" {
+		v, err := r.client.GetVDC(client.WithVDCName(id.Get("vdc").ID()))
+		if err != nil {
+			resp.Diagnostics.AddError("Error retrieving VDC", err.Error())
+			return
+		}
+		name = v.GetName()
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// UpgradeState upgrades the state written with the prior schema versions of the resource.
+func (r *vdcResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateupgrade.Upgraders(vdcUpgradeSteps)
+}
 
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+// MoveState moves the state of the vcd_org_vdc resource of the vcd provider to the resource.
+func (r *vdcResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_org_vdc", nil),
+	}
 }
@@ -0,0 +1,59 @@
+package vdc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	supertypes "github.com/FrangipaneTeam/terraform-plugin-framework-supertypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vdc"
+)
+
+// Unit test for the upgrade of the states written with the prior schema versions of the resource cloudavenue_vdc.
+func TestVDCResourceUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := vdc.NewVDCResource()
+
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	// State written by the release 0.11.0 with the vdc_group attribute.
+	rawState, err := os.ReadFile("testdata/vdc_state_v0_11.json")
+	if err != nil {
+		t.Fatalf("error reading the fixture: %s", err)
+	}
+
+	upgrader, ok := r.(fwresource.ResourceWithUpgradeState).UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("expected an upgrader for version 0")
+	}
+
+	resp := &fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+	upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: rawState}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no error, got %v", resp.Diagnostics)
+	}
+
+	var name supertypes.StringValue
+	if diags := resp.State.GetAttribute(ctx, path.Root("name"), &name); diags.HasError() || name.ValueString() != "my-vdc" {
+		t.Errorf("name: expected my-vdc, got %s", name)
+	}
+
+	storageProfiles, _, err := tftypes.WalkAttributePath(resp.State.Raw, tftypes.NewAttributePath().WithAttributeName("storage_profiles"))
+	if err != nil {
+		t.Fatalf("storage_profiles: expected a set, got %s", err)
+	}
+	var elements []tftypes.Value
+	if err := storageProfiles.(tftypes.Value).As(&elements); err != nil || len(elements) != 1 {
+		t.Errorf("storage_profiles: expected 1 element, got %s", storageProfiles)
+	}
+}
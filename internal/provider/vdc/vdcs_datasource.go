@@ -58,7 +58,7 @@ func (d *vdcsDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *vdcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vdcs", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vdcs", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var (
 		data  vdcsDataSourceModel
@@ -72,7 +72,13 @@ func (d *vdcsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	vdcs, httpR, err := d.client.APIClient.VDCApi.GetOrgVdcs(d.client.Auth)
+	apiClient, err := d.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
+	vdcs, httpR, err := apiClient.VDCApi.GetOrgVdcs(d.client.Auth)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vdcs detail, got error: %s", err))
 		return
@@ -6,12 +6,15 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
@@ -20,8 +23,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.VAppName.
 var (
-	_ resource.Resource              = &insertedMediaResource{}
-	_ resource.ResourceWithConfigure = &insertedMediaResource{}
+	_ resource.Resource                = &insertedMediaResource{}
+	_ resource.ResourceWithConfigure   = &insertedMediaResource{}
+	_ resource.ResourceWithMoveState   = &insertedMediaResource{}
+	_ resource.ResourceWithImportState = &insertedMediaResource{}
 )
 
 // NewInsertedMediaResource is a helper function to simplify the provider implementation.
@@ -85,7 +90,7 @@ func (r *insertedMediaResource) Configure(ctx context.Context, req resource.Conf
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *insertedMediaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vm_inserted_media", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vm_inserted_media", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -132,7 +137,7 @@ func (r *insertedMediaResource) Create(ctx context.Context, req resource.CreateR
 
 // Read refreshes the Terraform state with the latest data.
 func (r *insertedMediaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vm_inserted_media", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vm_inserted_media", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var state *insertedMediaResourceModel
 
@@ -235,7 +240,7 @@ func (r *insertedMediaResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *insertedMediaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vm_inserted_media", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vm_inserted_media", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *insertedMediaResourceModel
 
@@ -263,3 +268,32 @@ func (r *insertedMediaResource) Delete(ctx context.Context, req resource.DeleteR
 		resp.Diagnostics.AddError("Error ejecting media", err.Error())
 	}
 }
+
+func (r *insertedMediaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer metrics.New("cloudavenue_vm_inserted_media", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
+
+	// The media name is the last part as it usually contains a dot (my-media.iso).
+	id, d := importid.Parse(req.ID, importid.Format{
+		importid.VDC,
+		importid.VApp,
+		importid.Name("vm", "VM"),
+		importid.Name("catalog", "catalog"),
+		importid.DottedName("media", "media"),
+	})
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(importVApp(ctx, r.client, id, &resp.State)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_name"), id.Get("vm").Name())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("catalog"), id.Get("catalog").Name())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), id.Get("media").Name())...)
+}
+
+// MoveState moves the state of the vcd_inserted_media resource of the vcd provider to the resource.
+func (r *insertedMediaResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_inserted_media", nil),
+	}
+}
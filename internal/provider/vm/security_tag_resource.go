@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 )
 
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &securityTagResource{}
 	_ resource.ResourceWithConfigure   = &securityTagResource{}
 	_ resource.ResourceWithImportState = &securityTagResource{}
+	_ resource.ResourceWithMoveState   = &securityTagResource{}
 )
 
 // NewSecurityTagResource is a helper function to simplify the provider implementation.
@@ -256,6 +259,12 @@ func (r *securityTagResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *securityTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, d := importid.Parse(req.ID, importid.Format{importid.Name("security_tag", "security tag")})
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Init resource
 	resp.Diagnostics.Append(r.Init(ctx, nil)...)
 	if resp.Diagnostics.HasError() {
@@ -263,7 +272,7 @@ func (r *securityTagResource) ImportState(ctx context.Context, req resource.Impo
 	}
 
 	// Get all VM tagged in struct taggedEntities
-	taggedEntities, err := r.org.GetAllSecurityTaggedEntitiesByName(req.ID)
+	taggedEntities, err := r.org.GetAllSecurityTaggedEntitiesByName(id.Get("security_tag").Name())
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing security_tag", "name not found:"+err.Error())
 		return
@@ -282,7 +291,7 @@ func (r *securityTagResource) ImportState(ctx context.Context, req resource.Impo
 	}
 
 	plan := &securityTagResourceModel{
-		Name:  types.StringValue(req.ID),
+		Name:  types.StringValue(id.Get("security_tag").Name()),
 		VMIDs: VMIDs,
 	}
 
@@ -292,3 +301,12 @@ func (r *securityTagResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 }
+
+// MoveState moves the state of the vcd_security_tag resource of the vcd provider to the resource.
+func (r *securityTagResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_security_tag", map[string]string{
+			"name": "id",
+		}),
+	}
+}
@@ -64,7 +64,7 @@ func (d *vmAffinityRuleDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *vmAffinityRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vm_affinity_rule", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vm_affinity_rule", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data vmAffinityRuleDataSourceModel
 
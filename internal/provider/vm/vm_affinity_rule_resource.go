@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
 	govcdtypes "github.com/vmware/go-vcloud-director/v2/types/v56"
@@ -21,6 +20,8 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &vmAffinityRuleResource{}
 	_ resource.ResourceWithConfigure   = &vmAffinityRuleResource{}
 	_ resource.ResourceWithImportState = &vmAffinityRuleResource{}
+	_ resource.ResourceWithMoveState   = &vmAffinityRuleResource{}
 )
 
 // NewVMAffinityRuleResource is a helper function to simplify the provider implementation.
@@ -81,7 +83,7 @@ func (r *vmAffinityRuleResource) Configure(ctx context.Context, req resource.Con
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *vmAffinityRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	// Retrieve values from plan
 	var (
@@ -123,7 +125,7 @@ func (r *vmAffinityRuleResource) Create(ctx context.Context, req resource.Create
 
 // Read refreshes the Terraform state with the latest data.
 func (r *vmAffinityRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var state *vmAffinityRuleResourceModel
 
@@ -173,7 +175,7 @@ func (r *vmAffinityRuleResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vmAffinityRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	var plan *vmAffinityRuleResourceModel
 
@@ -233,7 +235,7 @@ func (r *vmAffinityRuleResource) Update(ctx context.Context, req resource.Update
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *vmAffinityRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	var state *vmAffinityRuleResourceModel
 
@@ -262,28 +264,21 @@ func (r *vmAffinityRuleResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *vmAffinityRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vm_affiny_rule", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
 	var state *vmAffinityRuleResourceModel
 
-	resourceURI := strings.Split(req.ID, ".")
-	if len(resourceURI) != 2 && len(resourceURI) != 1 {
-		resp.Diagnostics.AddError("Failed to import resource", "resource URI must be specified as vdc-name.affinity-rule-name or just affinity-rule-name if VDC is set at provider level.")
-	}
-
-	// Init resource
-	affinityRuleIdentifier := ""
-	vdcName := ""
+	rulePart := importid.NameOrID("affinity_rule", "affinity rule")
 
-	if len(resourceURI) == 1 {
-		affinityRuleIdentifier = resourceURI[0]
+	id, d := importid.Parse(req.ID, importid.Format{importid.VDC, rulePart}, importid.Format{rulePart})
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if len(resourceURI) == 2 {
-		vdcName, affinityRuleIdentifier = resourceURI[0], resourceURI[1]
-	}
+	affinityRuleIdentifier := id.Get("affinity_rule").String()
 	state = &vmAffinityRuleResourceModel{
-		VDC: types.StringValue(vdcName),
+		VDC: types.StringValue(id.Get("vdc").String()),
 	}
 
 	// Init resource
@@ -292,18 +287,13 @@ func (r *vmAffinityRuleResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
-	vdcName = r.vdc.GetName()
+	vdcName := r.vdc.GetName()
 
 	if vdcName == "" {
 		resp.Diagnostics.AddError("Failed to import resource", "VDC must be set at provider level or in resource URI.")
 		return
 	}
 
-	if affinityRuleIdentifier == "" {
-		resp.Diagnostics.AddError("Failed to import resource", "affinity rule name must be specified in resource URI.")
-		return
-	}
-
 	lookingForID := govcd.IsUuid(affinityRuleIdentifier)
 
 	ruleList, err := r.vdc.GetAllVmAffinityRuleList()
@@ -347,6 +337,13 @@ func (r *vmAffinityRuleResource) ImportState(ctx context.Context, req resource.I
 	}
 }
 
+// MoveState moves the state of the vcd_vm_affinity_rule resource of the vcd provider to the resource.
+func (r *vmAffinityRuleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_vm_affinity_rule", nil),
+	}
+}
+
 // resourceToAffinityRule prepares a VM affinity rule definition from the data in the resource.
 func resourceToAffinityRule(r *vmAffinityRuleResource, m *vmAffinityRuleResourceModel) (*govcdtypes.VmAffinityRule, error) {
 	name := m.Name.ValueString()
@@ -100,7 +100,7 @@ func (d *vmDataSource) Configure(ctx context.Context, req datasource.ConfigureRe
 }
 
 func (d *vmDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vm", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vm", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &VMDataSourceModel{}
 
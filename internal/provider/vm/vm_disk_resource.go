@@ -4,7 +4,6 @@ package vm
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/vmware/go-vcloud-director/v2/govcd"
@@ -18,6 +17,8 @@ import (
 
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/org"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
@@ -33,6 +34,7 @@ var (
 	_ resource.ResourceWithConfigure   = &diskResource{}
 	_ resource.ResourceWithImportState = &diskResource{}
 	_ resource.ResourceWithModifyPlan  = &diskResource{}
+	_ resource.ResourceWithMoveState   = &diskResource{}
 )
 
 // NewDiskResource is a helper function to simplify the provider implementation.
@@ -164,7 +166,7 @@ func (r *diskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *diskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { //nolint:gocyclo
-	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &vm.Disk{}
 
@@ -368,7 +370,7 @@ func (r *diskResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *diskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &vm.Disk{}
 
@@ -450,7 +452,7 @@ func (r *diskResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *diskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:gocyclo
-	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	plan := &vm.Disk{}
 	state := &vm.Disk{}
@@ -661,7 +663,7 @@ func (r *diskResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *diskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &vm.Disk{}
 	// Get current state
@@ -752,96 +754,55 @@ func (r *diskResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *diskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vm_disk", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	idParts := strings.Split(req.ID, ".")
-
-	var (
-		vdcName          string
-		vAppID, vAppName string
-		vmID, vmName     string
-		diskID           string
-		isDetachable     bool
-
-		diags diag.Diagnostics
-	)
+	diskPart := importid.Part{
+		Name:        "disk",
+		Description: "disk",
+		URNs:        []uuid.VcloudUUID{uuid.Disk},
+		Help:        "the URN (urn:vcloud:disk:<uuid>) of the detachable disk or the ID of the internal disk",
+	}
+	vmPart := importid.NameOrURN("vm", "VM", uuid.VM)
 
-	switch len(idParts) {
-	// Case 2 : vAppIDOrName.DiskID
-	case 2:
-		if uuid.IsVAPP(idParts[0]) {
-			vAppID = idParts[0]
-		} else {
-			vAppName = idParts[0]
-		}
+	formats := []importid.Format{
+		{importid.VApp, diskPart},
+		{importid.VDC, importid.VApp, diskPart},
+		{importid.VApp, vmPart, diskPart},
+		{importid.VDC, importid.VApp, vmPart, diskPart},
+	}
 
-		diskID = idParts[1]
-		if uuid.IsDisk(idParts[1]) {
-			isDetachable = true
-		}
+	id, diags := importid.Parse(req.ID, formats...)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Case 3 : vAppIDOrName.VmIDOrName.DiskID or vdcName.vAppIDOrName.DiskID
-	case 3:
-		diskID = idParts[2]
-		if uuid.IsDisk(diskID) {
-			isDetachable = true
+	// vdc.vapp.disk is only used for a detachable disk in an existing VDC, vapp.vm.disk otherwise.
+	if id.Format.String() == formats[1].String() {
+		isVAppVMDisk := !id.Get("disk").IsID()
+		if !isVAppVMDisk {
+			_, diags = vdc.Init(r.client, types.StringValue(id.Get("vdc").String()))
+			isVAppVMDisk = diags.HasError()
 		}
 
-		if isDetachable {
-			_, diags = vdc.Init(r.client, types.StringValue(idParts[0]))
-			if !diags.HasError() {
-				// FORMAT : vdcName.vAppIDOrName.DiskID
-				vdcName = idParts[0]
-				if uuid.IsVAPP(idParts[1]) {
-					vAppID = idParts[1]
-				} else {
-					vAppName = idParts[1]
-				}
-				goto next
+		if isVAppVMDisk {
+			id, diags = importid.Parse(req.ID, formats[2])
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
 			}
 		}
-
-		// FORMAT : vAppIDOrName.VmIDOrName.DiskID
-		if uuid.IsVAPP(idParts[0]) {
-			vAppID = idParts[0]
-		} else {
-			vAppName = idParts[0]
-		}
-
-		if uuid.IsVM(idParts[1]) {
-			vmID = idParts[1]
-		} else {
-			vmName = idParts[1]
-		}
-
-	// Case 4 : vdcName.vAppIDOrName.VmIDOrName.DiskID
-	case 4:
-		vdcName = idParts[0]
-		if uuid.IsVAPP(idParts[1]) {
-			vAppID = idParts[1]
-		} else {
-			vAppName = idParts[1]
-		}
-
-		if uuid.IsVM(idParts[2]) {
-			vmID = idParts[2]
-		} else {
-			vmName = idParts[2]
-		}
-
-		diskID = idParts[3]
-		if uuid.IsDisk(diskID) {
-			isDetachable = true
-		}
-	default:
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with formats: vAppIDOrName.DiskID or vAppIDOrName.VmIDOrName.DiskID or vdcName.vAppIDOrName.DiskID or vdcName.vAppIDOrName.VmIDOrName.DiskID Got: %q", req.ID),
-		)
-		return
 	}
 
-next:
+	var (
+		vdcName      = id.Get("vdc").String()
+		vAppID       = id.Get("vapp").ID()
+		vAppName     = id.Get("vapp").Name()
+		vmID         = id.Get("vm").ID()
+		vmName       = id.Get("vm").Name()
+		diskID       = id.Get("disk").String()
+		isDetachable = id.Get("disk").IsID()
+	)
 
 	r.org, diags = org.Init(r.client)
 	resp.Diagnostics.Append(diags...)
@@ -890,3 +851,11 @@ next:
 		}
 	}
 }
+
+// MoveState moves the state of the vcd_independent_disk and vcd_vm_internal_disk resources of the vcd provider to the resource.
+func (r *diskResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCD("vcd_independent_disk", nil),
+		movestate.FromVCD("vcd_vm_internal_disk", nil),
+	}
+}
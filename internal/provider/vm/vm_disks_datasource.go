@@ -88,7 +88,7 @@ func (d *disksDataSource) Configure(ctx context.Context, req datasource.Configur
 }
 
 func (d *disksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_vm_disks", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_vm_disks", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	config := &DisksModel{}
 
@@ -0,0 +1,161 @@
+package vm_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vm"
+)
+
+// Unit test for the move of the states of the vcd_vapp_vm and vcd_vm resources of the vcd provider to the resource cloudavenue_vm.
+func TestVMResourceMoveState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := vm.NewVMResource()
+
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	settings := path.Root("settings")
+	customization := settings.AtName("customization")
+
+	type expected struct {
+		path path.Path
+		want attr.Value
+	}
+
+	tests := map[string]struct {
+		sourceType string
+		fixture    string
+		expected   []expected
+	}{
+		"vcd_vapp_vm": {
+			sourceType: "vcd_vapp_vm",
+			fixture:    "testdata/vcd_vapp_vm_state.json",
+			expected: []expected{
+				{path: path.Root("id"), want: types.StringValue("urn:vcloud:vm:8a0b3b1e-55c4-4b55-8d2e-6c1f0f2c9e3a")},
+				{path: path.Root("name"), want: types.StringValue("my-vm")},
+				{path: path.Root("vapp_name"), want: types.StringValue("my-vapp")},
+				{path: path.Root("vdc"), want: types.StringValue("my-vdc")},
+				{path: path.Root("description"), want: types.StringValue("My VM")},
+				{path: path.Root("deploy_os").AtName("vapp_template_id"), want: types.StringValue("urn:vcloud:vapptemplate:b8c2b0a4-3b4f-4e0c-9a43-7d1f2f3e6a5b")},
+				{path: path.Root("deploy_os").AtName("vm_name_in_template"), want: types.StringValue("debian-10")},
+				{path: path.Root("deploy_os").AtName("boot_image_id"), want: types.StringNull()},
+				{path: path.Root("deploy_os").AtName("accept_all_eulas"), want: types.BoolValue(true)},
+				{path: path.Root("state").AtName("power_on"), want: types.BoolValue(true)},
+				{path: path.Root("state").AtName("status"), want: types.StringValue("POWERED_ON")},
+				{path: path.Root("resource").AtName("cpus"), want: types.Int64Value(2)},
+				{path: path.Root("resource").AtName("cpus_cores"), want: types.Int64Value(1)},
+				{path: path.Root("resource").AtName("cpu_hot_add_enabled"), want: types.BoolValue(true)},
+				{path: path.Root("resource").AtName("memory"), want: types.Int64Value(2048)},
+				{path: path.Root("resource").AtName("memory_hot_add_enabled"), want: types.BoolValue(true)},
+				{path: path.Root("resource").AtName("networks").AtListIndex(0).AtName("name"), want: types.StringValue("my-network")},
+				{path: path.Root("resource").AtName("networks").AtListIndex(0).AtName("ip"), want: types.StringValue("192.168.1.10")},
+				{path: settings.AtName("expose_hardware_virtualization"), want: types.BoolValue(false)},
+				{path: settings.AtName("os_type"), want: types.StringValue("debian10_64Guest")},
+				{path: settings.AtName("storage_profile"), want: types.StringValue("gold")},
+				{path: settings.AtName("guest_properties").AtMapKey("guestinfo.hostname"), want: types.StringValue("my-vm")},
+				{path: customization.AtName("hostname"), want: types.StringValue("my-vm")},
+				{path: customization.AtName("init_script"), want: types.StringValue("#!/bin/sh\necho hello")},
+				{path: customization.AtName("auto_generate_password"), want: types.BoolValue(true)},
+			},
+		},
+		"vcd_vm": {
+			sourceType: "vcd_vm",
+			fixture:    "testdata/vcd_vm_state.json",
+			expected: []expected{
+				{path: path.Root("id"), want: types.StringValue("urn:vcloud:vm:1f2e3d4c-5b6a-4978-8a9b-0c1d2e3f4a5b")},
+				{path: path.Root("name"), want: types.StringValue("my-standalone-vm")},
+				// The vApp is read by the refresh following the move.
+				{path: path.Root("vapp_name"), want: types.StringNull()},
+				{path: path.Root("vapp_id"), want: types.StringNull()},
+				{path: path.Root("vdc"), want: types.StringValue("my-vdc")},
+				{path: path.Root("deploy_os").AtName("vapp_template_id"), want: types.StringNull()},
+				{path: path.Root("deploy_os").AtName("vm_name_in_template"), want: types.StringNull()},
+				{path: path.Root("deploy_os").AtName("boot_image_id"), want: types.StringValue("urn:vcloud:catalogitem:5d6e7f80-91a2-4b3c-8d4e-5f6a7b8c9d0e")},
+				{path: path.Root("state").AtName("power_on"), want: types.BoolValue(false)},
+				{path: path.Root("state").AtName("status"), want: types.StringValue("POWERED_OFF")},
+				{path: path.Root("resource").AtName("cpus"), want: types.Int64Value(4)},
+				{path: path.Root("resource").AtName("cpus_cores"), want: types.Int64Value(2)},
+				{path: path.Root("resource").AtName("memory"), want: types.Int64Value(4096)},
+				{path: settings.AtName("expose_hardware_virtualization"), want: types.BoolValue(true)},
+				{path: settings.AtName("os_type"), want: types.StringValue("ubuntu64Guest")},
+				{path: settings.AtName("storage_profile"), want: types.StringValue("silver")},
+				{path: customization.AtName("hostname"), want: types.StringValue("my-standalone-vm")},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rawState, err := os.ReadFile(tt.fixture)
+			if err != nil {
+				t.Fatalf("error reading %s: %s", tt.fixture, err)
+			}
+
+			// Like Terraform, the movers are called until one of them sets the target state.
+			resp := &fwresource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: schemaResponse.Schema,
+					Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			for _, mover := range r.(fwresource.ResourceWithMoveState).MoveState(ctx) {
+				mover.StateMover(ctx, fwresource.MoveStateRequest{
+					SourceProviderAddress: "registry.terraform.io/vmware/vcd",
+					SourceTypeName:        tt.sourceType,
+					SourceRawState:        &tfprotov6.RawState{JSON: rawState},
+				}, resp)
+				if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+					break
+				}
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got %v", resp.Diagnostics)
+			}
+			if resp.TargetState.Raw.IsNull() {
+				t.Fatalf("expected a mover of %s", tt.sourceType)
+			}
+
+			for _, e := range tt.expected {
+				var (
+					got   attr.Value
+					diags diag.Diagnostics
+				)
+				switch e.want.(type) {
+				case types.String:
+					var v types.String
+					diags = resp.TargetState.GetAttribute(ctx, e.path, &v)
+					got = v
+				case types.Int64:
+					var v types.Int64
+					diags = resp.TargetState.GetAttribute(ctx, e.path, &v)
+					got = v
+				case types.Bool:
+					var v types.Bool
+					diags = resp.TargetState.GetAttribute(ctx, e.path, &v)
+					got = v
+				}
+				if diags.HasError() {
+					t.Fatalf("%s: expected a value, got %v", e.path, diags)
+				}
+				if !got.Equal(e.want) {
+					t.Errorf("%s: expected %s, got %s", e.path, e.want, got)
+				}
+			}
+		})
+	}
+}
@@ -18,20 +18,100 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/adminvdc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/importid"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/movestate"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/stateupgrade"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vapp"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/writeonly"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/utils"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &vmResource{}
-	_ resource.ResourceWithConfigure   = &vmResource{}
-	_ resource.ResourceWithImportState = &vmResource{}
+	_ resource.Resource                 = &vmResource{}
+	_ resource.ResourceWithConfigure    = &vmResource{}
+	_ resource.ResourceWithImportState  = &vmResource{}
+	_ resource.ResourceWithMoveState    = &vmResource{}
+	_ resource.ResourceWithUpgradeState = &vmResource{}
 )
 
+// vmUpgradeSteps are the steps upgrading the state of the resource, the schema version is their number.
+var vmUpgradeSteps = []stateupgrade.Step{
+	// 0 to 1: the states written before the release 0.3.0 have the attributes of the VM at the root,
+	// they are moved under deploy_os, state, resource and settings.
+	func(s stateupgrade.State) error {
+		if err := s.Unwrap("customization"); err != nil {
+			return err
+		}
+
+		for from, to := range vmFlatLayout {
+			if err := s.Move(from, to); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+// vmFlatLayout maps the root attributes of the states written before the release 0.3.0 to their path.
+var vmFlatLayout = map[string]string{
+	"vapp_template_id":               "deploy_os.vapp_template_id",
+	"vm_name_in_template":            "deploy_os.vm_name_in_template",
+	"boot_image_id":                  "deploy_os.boot_image_id",
+	"accept_all_eulas":               "deploy_os.accept_all_eulas",
+	"power_on":                       "state.power_on",
+	"status":                         "state.status",
+	"cpus":                           "resource.cpus",
+	"cpu_cores":                      "resource.cpus_cores",
+	"cpu_hot_add_enabled":            "resource.cpu_hot_add_enabled",
+	"memory":                         "resource.memory",
+	"memory_hot_add_enabled":         "resource.memory_hot_add_enabled",
+	"networks":                       "resource.networks",
+	"expose_hardware_virtualization": "settings.expose_hardware_virtualization",
+	"os_type":                        "settings.os_type",
+	"storage_profile":                "settings.storage_profile",
+	"guest_properties":               "settings.guest_properties",
+	"customization":                  "settings.customization",
+}
+
+// vmFromVCDStep moves the attributes of the states of the vcd_vapp_vm and vcd_vm resources to their path.
+// The vcd resources have the flat layout of the states written before the release 0.3.0, with a few renamed attributes.
+func vmFromVCDStep(s stateupgrade.State) error {
+	// The status of vcd is a number, its text is the status of the resource.
+	s.Remove("status")
+
+	// The attributes of deploy_os force the replacement of the VM, the empty strings of vcd are null.
+	for _, name := range []string{"vapp_template_id", "vm_name_in_template", "boot_image_id"} {
+		if value, ok := s[name].(string); ok && value == "" {
+			s.Remove(name)
+		}
+	}
+
+	if err := s.Unwrap("customization"); err != nil {
+		return err
+	}
+
+	for from, to := range vmVCDAttributes {
+		if err := s.Move(from, to); err != nil {
+			return err
+		}
+	}
+
+	return vmUpgradeSteps[0](s)
+}
+
+// vmVCDAttributes maps the attributes of the vcd resources to their name in the flat layout.
+var vmVCDAttributes = map[string]string{
+	"status_text":              "status",
+	"network":                  "networks",
+	"computer_name":            "customization.hostname",
+	"customization.initscript": "customization.init_script",
+}
+
 // NewVmResource is a helper function to simplify the provider implementation.
 func NewVMResource() resource.Resource {
 	return &vmResource{}
@@ -65,7 +145,24 @@ func (r *vmResource) Init(ctx context.Context, rm *vm.VMResourceModel) (diags di
 		return
 	}
 
-	r.vapp, d = vapp.Init(r.client, r.vdc, rm.VappID, rm.VappName)
+	vappID := rm.VappID
+	// The states moved from the vcd_vm resource have no vApp, it is the vApp of the VM.
+	if rm.VappID.IsNull() && rm.VappName.IsNull() && !rm.ID.IsNull() {
+		vmOut, err := r.vdc.QueryVmById(rm.ID.ValueString())
+		if err != nil {
+			diags.AddError("Error retrieving VM", err.Error())
+			return
+		}
+
+		parent, err := vmOut.GetParentVApp()
+		if err != nil {
+			diags.AddError("Error retrieving the vApp of the VM", err.Error())
+			return
+		}
+		vappID = types.StringValue(parent.VApp.ID)
+	}
+
+	r.vapp, d = vapp.Init(r.client, r.vdc, vappID, rm.VappName)
 	diags.Append(d...)
 	if diags.HasError() {
 		return
@@ -88,7 +185,8 @@ func (r *vmResource) Metadata(_ context.Context, req resource.MetadataRequest, r
 
 // Schema defines the schema for the resource.
 func (r *vmResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = vmSuperSchema(ctx).GetResource(ctx)
+	resp.Schema = writeonly.Set(vmSuperSchema(ctx).GetResource(ctx))
+	resp.Schema.Version = stateupgrade.Version(vmUpgradeSteps)
 }
 
 func (r *vmResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -111,7 +209,7 @@ func (r *vmResource) Configure(ctx context.Context, req resource.ConfigureReques
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Create)()
+	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Create, &resp.Diagnostics)()
 
 	plan := &vm.VMResourceModel{}
 
@@ -122,6 +220,8 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 
 	// Retrieve values from plan
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	// Write-only attributes are only available in the config.
+	resp.Diagnostics.Append(plan.WriteOnlyFromConfig(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -242,6 +342,10 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 	tfState.VDC = types.StringValue(r.vdc.GetName())
 	tfState.Settings = settings.ToPlan(ctx)
 	tfState.Resource = r.vm.ResourceRead(ctx).ToPlan(ctx, networks)
+	resp.Diagnostics.Append(tfState.RemoveWriteOnlySecrets(ctx)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
@@ -249,7 +353,7 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 
 // Read refreshes the Terraform state with the latest data.
 func (r *vmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	state := &vm.VMResourceModel{}
 
@@ -293,7 +397,7 @@ func (r *vmResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { //nolint:gocyclo
-	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Update)()
+	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Update, &resp.Diagnostics)()
 
 	plan := &vm.VMResourceModel{}
 	state := &vm.VMResourceModel{}
@@ -301,6 +405,8 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 	// Get current state
 	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	// Write-only attributes are only available in the config.
+	resp.Diagnostics.Append(plan.WriteOnlyFromConfig(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -506,7 +612,7 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 	}
 
 	// * Customization
-	if !allStructsPlan.Settings.Customization.Equal(allStructsState.Settings.Customization) {
+	if !allStructsPlan.Settings.Customization.Equal(allStructsState.Settings.Customization) || plan.WriteOnlyVersionChanged(state) {
 		// Detected change on customization
 		customizationFromPlan, d := allStructsPlan.Settings.CustomizationFromPlan(ctx)
 		resp.Diagnostics.Append(d...)
@@ -514,7 +620,7 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 			return
 		}
 
-		customization := customizationFromPlan.GetCustomizationSection(plan.Name.ValueString())
+		customization := plan.GetCustomizationSection(customizationFromPlan)
 		if err := r.vm.SetCustomization(customization); err != nil {
 			resp.Diagnostics.AddError("Error updating customization", err.Error())
 			return
@@ -720,7 +826,7 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *vmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Delete)()
+	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Delete, &resp.Diagnostics)()
 
 	state := &vm.VMResourceModel{}
 
@@ -798,30 +904,32 @@ func (r *vmResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 }
 
 func (r *vmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Import)()
+	defer metrics.New("cloudavenue_vm", r.client.GetOrgName(), metrics.Import, &resp.Diagnostics)()
 
-	idParts := strings.Split(req.ID, ".")
+	vmPart := importid.URN("vm", "VM", uuid.VM)
 
-	if len(idParts) != 3 && len(idParts) != 2 {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: vdc.vapp_name.vm_id or vapp_name.vm_id. Got: %q", req.ID),
-		)
+	id, d := importid.Parse(req.ID, importid.Format{importid.VDC, importid.VApp, vmPart}, importid.Format{importid.VApp, vmPart})
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var id string
+	resp.Diagnostics.Append(importVApp(ctx, r.client, id, &resp.State)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.Get("vm").ID())...)
+}
 
-	if len(idParts) == 3 {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vdc"), idParts[0])...)
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), idParts[1])...)
-		id = idParts[2]
-	} else {
-		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vapp_name"), idParts[0])...)
-		id = idParts[1]
-	}
+// UpgradeState upgrades the state written with the prior schema versions of the resource.
+func (r *vmResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return stateupgrade.Upgraders(vmUpgradeSteps)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), uuid.Normalize(uuid.VM, id).String())...)
+// MoveState moves the state of the vcd_vapp_vm and vcd_vm resources of the vcd provider to the resource.
+// The states of vcd_vm have no vApp, the vApp of the VM is set by the refresh following the move.
+func (r *vmResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		movestate.FromVCDWithStep("vcd_vapp_vm", vmFromVCDStep),
+		movestate.FromVCDWithStep("vcd_vm", vmFromVCDStep),
+	}
 }
 
 func (r *vmResource) createVMWithTemplate(ctx context.Context, rm vm.VMResourceModel) (vmCreated vm.VM, diags diag.Diagnostics) {
@@ -1036,7 +1144,7 @@ func (r *vmResource) createVMWithBootImage(ctx context.Context, rm vm.VMResource
 			StorageProfile:            storageProfile,
 			ComputePolicy:             vmComputePolicy,
 			Description:               rm.Description.ValueString(),
-			GuestCustomizationSection: customization.GetCustomizationSection(rm.Name.ValueString()),
+			GuestCustomizationSection: rm.GetCustomizationSection(customization),
 			VmSpecSection: &govcdtypes.VmSpecSection{
 				Modified:          utils.TakeBoolPointer(true),
 				Info:              "Virtual Machine specification",
@@ -1167,7 +1275,7 @@ func (r *vmResource) processAfterCreate(ctx context.Context, vmCreated vm.VM, rm
 		return
 	}
 
-	if err = vmCreated.SetCustomization(customization.GetCustomizationSection(rm.Name.ValueString())); err != nil {
+	if err = vmCreated.SetCustomization(rm.GetCustomizationSection(customization)); err != nil {
 		diags.AddError("Error updating customization", err.Error())
 		return
 	}
@@ -1272,16 +1380,25 @@ func (r *vmResource) read(ctx context.Context, rm, rmPlan *vm.VMResourceModel) (
 		return
 	}
 
-	return &vm.VMResourceModel{
-		ID:          types.StringValue(r.vm.GetID()),
-		VDC:         types.StringValue(r.vdc.GetName()),
-		Name:        types.StringValue(r.vm.GetName()),
-		VappID:      types.StringValue(r.vapp.GetID()),
-		VappName:    types.StringValue(r.vapp.GetName()),
-		Description: rm.Description,
-		State:       stateStruct.ToPlan(ctx),
-		Resource:    r.vm.ResourceRead(ctx).ToPlan(ctx, networks),
-		Settings:    settings.ToPlan(ctx),
-		DeployOS:    rm.DeployOS,
-	}, nil
+	plan = &vm.VMResourceModel{
+		ID:                          types.StringValue(r.vm.GetID()),
+		VDC:                         types.StringValue(r.vdc.GetName()),
+		Name:                        types.StringValue(r.vm.GetName()),
+		VappID:                      types.StringValue(r.vapp.GetID()),
+		VappName:                    types.StringValue(r.vapp.GetName()),
+		Description:                 rm.Description,
+		State:                       stateStruct.ToPlan(ctx),
+		Resource:                    r.vm.ResourceRead(ctx).ToPlan(ctx, networks),
+		Settings:                    settings.ToPlan(ctx),
+		DeployOS:                    rm.DeployOS,
+		AdminPasswordWO:             types.StringNull(),
+		AdminPasswordWOVersion:      rmPlan.AdminPasswordWOVersion,
+		JoinDomainPasswordWO:        types.StringNull(),
+		JoinDomainPasswordWOVersion: rmPlan.JoinDomainPasswordWOVersion,
+	}
+
+	// ? Write-only secrets are never stored in the state
+	diags.Append(plan.RemoveWriteOnlySecrets(ctx)...)
+
+	return plan, diags
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/storageprofile"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vdc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/vm"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/common/writeonly"
 )
 
 func vmSuperSchema(_ context.Context) superschema.Schema {
@@ -814,6 +815,49 @@ func vmSuperSchema(_ context.Context) superschema.Schema {
 					},
 				},
 			},
+			"admin_password_wo": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: writeonly.Description("settings.customization.admin_password", "admin_password_wo"),
+					Optional:            true,
+					Sensitive:           true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(
+							path.MatchRoot("settings").AtName("customization").AtName("admin_password"),
+							path.MatchRoot("settings").AtName("customization").AtName("auto_generate_password"),
+						),
+						stringvalidator.AlsoRequires(path.MatchRoot("admin_password_wo_version")),
+					},
+				},
+			},
+			"admin_password_wo_version": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: writeonly.VersionDescription("admin_password_wo"),
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AlsoRequires(path.MatchRoot("admin_password_wo")),
+					},
+				},
+			},
+			"join_domain_password_wo": superschema.StringAttribute{
+				Resource: &schemaR.StringAttribute{
+					MarkdownDescription: writeonly.Description("settings.customization.join_domain_password", "join_domain_password_wo"),
+					Optional:            true,
+					Sensitive:           true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRoot("settings").AtName("customization").AtName("join_domain_password")),
+						stringvalidator.AlsoRequires(path.MatchRoot("join_domain_password_wo_version")),
+					},
+				},
+			},
+			"join_domain_password_wo_version": superschema.Int64Attribute{
+				Resource: &schemaR.Int64Attribute{
+					MarkdownDescription: writeonly.VersionDescription("join_domain_password_wo"),
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AlsoRequires(path.MatchRoot("join_domain_password_wo")),
+					},
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,88 @@
+package vm_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider/vm"
+)
+
+// Unit test for the upgrade of the states written with the prior schema versions of the resource cloudavenue_vm.
+func TestVMResourceUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := vm.NewVMResource()
+
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+
+	tests := map[string]struct {
+		version int64
+		fixture string
+	}{
+		"flat layout before 0.3.0": {version: 0, fixture: "testdata/vm_state_v0_flat.json"},
+		"version 0":                {version: 0, fixture: "testdata/vm_state_v0.json"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rawState, err := os.ReadFile(tt.fixture)
+			if err != nil {
+				t.Fatalf("error reading %s: %s", tt.fixture, err)
+			}
+
+			upgrader, ok := r.(fwresource.ResourceWithUpgradeState).UpgradeState(ctx)[tt.version]
+			if !ok {
+				t.Fatalf("expected an upgrader for version %d", tt.version)
+			}
+
+			resp := &fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResponse.Schema}}
+			upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: rawState}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no error, got %v", resp.Diagnostics)
+			}
+
+			expected := []struct {
+				path path.Path
+				want string
+			}{
+				{path: path.Root("name"), want: "my-vm"},
+				{path: path.Root("deploy_os").AtName("vapp_template_id"), want: "urn:vcloud:vapptemplate:b8c2b0a4-3b4f-4e0c-9a43-7d1f2f3e6a5b"},
+				{path: path.Root("state").AtName("status"), want: "POWERED_ON"},
+				{path: path.Root("resource").AtName("networks").AtListIndex(0).AtName("name"), want: "my-network"},
+				{path: path.Root("settings").AtName("os_type"), want: "debian10_64Guest"},
+				{path: path.Root("settings").AtName("storage_profile"), want: "gold"},
+				{path: path.Root("settings").AtName("guest_properties").AtMapKey("guestinfo.hostname"), want: "my-vm"},
+				{path: path.Root("settings").AtName("customization").AtName("hostname"), want: "my-vm"},
+			}
+			for _, e := range expected {
+				var got types.String
+				if diags := resp.State.GetAttribute(ctx, e.path, &got); diags.HasError() {
+					t.Fatalf("%s: expected a string, got %v", e.path, diags)
+				}
+				if got.ValueString() != e.want {
+					t.Errorf("%s: expected %s, got %s", e.path, e.want, got)
+				}
+			}
+
+			var memory types.Int64
+			if diags := resp.State.GetAttribute(ctx, path.Root("resource").AtName("memory"), &memory); diags.HasError() || memory.ValueInt64() != 2048 {
+				t.Errorf("resource.memory: expected 2048, got %s", memory)
+			}
+			var cores types.Int64
+			if diags := resp.State.GetAttribute(ctx, path.Root("resource").AtName("cpus_cores"), &cores); diags.HasError() || cores.ValueInt64() != 1 {
+				t.Errorf("resource.cpus_cores: expected 1, got %s", cores)
+			}
+		})
+	}
+}
@@ -56,7 +56,7 @@ func (d *tier0VrfDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *tier0VrfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_tier0_vrf", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_tier0_vrf", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data tier0VrfDataSourceModel
 
@@ -67,7 +67,13 @@ func (d *tier0VrfDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	tier0Detail, _, err := d.client.APIClient.Tier0Api.GetTier0VrfByName(d.client.Auth, data.Name.ValueString())
+	apiClient, err := d.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
+	tier0Detail, _, err := apiClient.Tier0Api.GetTier0VrfByName(d.client.Auth, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Tier-0 detail, got error: %s", err))
 		return
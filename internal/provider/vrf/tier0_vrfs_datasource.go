@@ -57,7 +57,7 @@ func (d *tier0VrfsDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *tier0VrfsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	defer metrics.New("data.cloudavenue_tier0_vrfs", d.client.GetOrgName(), metrics.Read)()
+	defer metrics.New("data.cloudavenue_tier0_vrfs", d.client.GetOrgName(), metrics.Read, &resp.Diagnostics)()
 
 	var data tier0VrfsDataSourceModel
 
@@ -68,7 +68,13 @@ func (d *tier0VrfsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	tier0vrfs, _, err := d.client.APIClient.Tier0Api.GetTier0Vrfs(d.client.Auth)
+	apiClient, err := d.client.APIClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Cloud Avenue API Client", err.Error())
+		return
+	}
+
+	tier0vrfs, _, err := apiClient.Tier0Api.GetTier0Vrfs(d.client.Auth)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
 		return
@@ -5,6 +5,8 @@ import (
 	"context"
 	"log"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/client"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/testsacc"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/metrics"
 	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
@@ -33,6 +36,10 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 // about the appropriate environment variables being set are common to see in a pre-check
 // function.
 func TestAccPreCheck(t *testing.T) {
+	if os.Getenv("CLOUDAVENUE_VCR_MODE") != "" {
+		testAccPreCheckVCR(t)
+	}
+
 	if v := os.Getenv("CLOUDAVENUE_URL"); v == "" {
 		t.Fatal("CLOUDAVENUE_URL must be set for acceptance tests")
 	}
@@ -60,6 +67,51 @@ func TestAccPreCheck(t *testing.T) {
 	log.Default().Printf("TestACC: execution ID is %s", metrics.GlobalExecutionID)
 }
 
+// TestUnitPreCheck skips the unit tests run against the fake API (internal/helpers/fakeapi)
+// when the Terraform CLI is not available.
+func TestUnitPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("terraform CLI not found, set TF_ACC_TERRAFORM_PATH to run the unit tests")
+	}
+}
+
+// testAccPreCheckVCR configures the record/replay transport for the test.
+// Each test has its own cassette in the directory set with CLOUDAVENUE_VCR_CASSETTE_DIR (testdata/cassettes by default).
+// In replay mode, the test is skipped if it has no cassette and the credentials are not required.
+func testAccPreCheckVCR(t *testing.T) {
+	dir := os.Getenv("CLOUDAVENUE_VCR_CASSETTE_DIR")
+	if dir == "" {
+		dir = filepath.Join("testdata", "cassettes")
+	}
+	cassette := filepath.Join(dir, t.Name()+".yaml")
+	t.Setenv("CLOUDAVENUE_VCR_CASSETTE", cassette)
+
+	if client.VCRMode(os.Getenv("CLOUDAVENUE_VCR_MODE")) != client.VCRModeReplay {
+		return
+	}
+
+	if _, err := os.Stat(cassette); err != nil {
+		t.Skipf("no cassette recorded for %s: %s", t.Name(), cassette)
+	}
+
+	// The values are replaced by the placeholders of the cassette, any value works.
+	for env, value := range map[string]string{
+		"CLOUDAVENUE_URL":       "https://console.cloudavenue.invalid",
+		"CLOUDAVENUE_USER":      "replay",
+		"CLOUDAVENUE_PASSWORD":  "replay-password",
+		"CLOUDAVENUE_ORG":       "cav00ev00ocb0000000",
+		"CLOUDAVENUE_VDC":       "replay-vdc",
+		"CLOUDAVENUE_TELEMETRY": "off",
+	} {
+		if os.Getenv(env) == "" {
+			t.Setenv(env, value)
+		}
+	}
+}
+
 // Deprecated: Use ContactConfigs instead.
 func ConcatTests(tests ...string) string {
 	return ContactConfigs(tests...)
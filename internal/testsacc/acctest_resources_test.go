@@ -20,8 +20,9 @@ func GetResourceConfig() map[testsacc.ResourceName]func() resourceConfig {
 		NetworkRoutedResourceName: NewResourceConfig(NewNetworkRoutedResourceTest()),
 
 		// * Edge Gateway
-		EdgeGatewayResourceName:         NewResourceConfig(NewEdgeGatewayResourceTest()),
-		EdgeGatewayFirewallResourceName: NewResourceConfig(NewEdgeGatewayFirewallResourceTest()),
+		EdgeGatewayResourceName:             NewResourceConfig(NewEdgeGatewayResourceTest()),
+		EdgeGatewayFirewallResourceName:     NewResourceConfig(NewEdgeGatewayFirewallResourceTest()),
+		EdgeGatewayFirewallRuleResourceName: NewResourceConfig(NewEdgeGatewayFirewallRuleResourceTest()),
 
 		// * Backup
 		BackupResourceName: NewResourceConfig(NewBackupResourceTest()),
@@ -0,0 +1,38 @@
+package testsacc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccAlbEdgeGatewaySettingsDataSourceConfig = `
+data "cloudavenue_alb_edgegateway_settings" "example" {
+	edge_gateway_name = "tn01e02ocb0006205spt102"
+}
+`
+
+func TestAccAlbEdgeGatewaySettingsDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_alb_edgegateway_settings.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: testAccAlbEdgeGatewaySettingsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(dataSourceName, "id", uuid.TestIsType(uuid.Gateway)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", dataSourceName, "edge_gateway_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "edge_gateway_name", "tn01e02ocb0006205spt102"),
+					resource.TestCheckResourceAttr(dataSourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "supported_feature_set"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_network_definition"),
+				),
+			},
+		},
+	})
+}
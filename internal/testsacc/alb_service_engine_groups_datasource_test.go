@@ -0,0 +1,37 @@
+package testsacc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccAlbServiceEngineGroupsDataSourceConfig = `
+data "cloudavenue_alb_service_engine_groups" "example" {
+	edge_gateway_name = "tn01e02ocb0006205spt102"
+}
+`
+
+func TestAccAlbServiceEngineGroupsDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_alb_service_engine_groups.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: testAccAlbServiceEngineGroupsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "edge_gateway_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "edge_gateway_name", "tn01e02ocb0006205spt102"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_engine_groups.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_engine_groups.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_engine_groups.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_engine_groups.0.deployed_virtual_services"),
+				),
+			},
+		},
+	})
+}
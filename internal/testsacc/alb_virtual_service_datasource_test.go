@@ -0,0 +1,39 @@
+package testsacc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccAlbVirtualServiceDataSourceConfig = `
+data "cloudavenue_alb_virtual_service" "example" {
+	edge_gateway_name = cloudavenue_alb_virtual_service.example.edge_gateway_name
+	name              = cloudavenue_alb_virtual_service.example.name
+}
+`
+
+func TestAccAlbVirtualServiceDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_alb_virtual_service.example"
+	resourceName := "cloudavenue_alb_virtual_service.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: ConcatTests(testAccAlbVirtualServiceDependenciesConfig, testAccAlbVirtualServiceResourceConfig, testAccAlbVirtualServiceDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "edge_gateway_id", resourceName, "edge_gateway_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "pool_id", resourceName, "pool_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "virtual_ip", resourceName, "virtual_ip"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_profile_type", resourceName, "application_profile_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_engine_group_name", resourceName, "service_engine_group_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_ports.#", resourceName, "service_ports.#"),
+				),
+			},
+		},
+	})
+}
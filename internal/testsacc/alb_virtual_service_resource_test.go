@@ -0,0 +1,114 @@
+package testsacc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccAlbVirtualServiceDependenciesConfig = `
+resource "cloudavenue_publicip" "example" {
+	edge_gateway_name = "tn01e02ocb0006205spt102"
+}
+
+resource "cloudavenue_alb_pool" "example" {
+	edge_gateway_name = "tn01e02ocb0006205spt102"
+	name              = "ExampleVirtualService"
+
+	members = [
+	  {
+		ip_address = "192.168.1.1"
+		port       = "80"
+	  }
+	]
+}
+`
+
+const testAccAlbVirtualServiceResourceConfig = `
+resource "cloudavenue_alb_virtual_service" "example" {
+	edge_gateway_name        = "tn01e02ocb0006205spt102"
+	name                     = "Example"
+	pool_id                  = cloudavenue_alb_pool.example.id
+	virtual_ip               = cloudavenue_publicip.example.public_ip
+	application_profile_type = "HTTP"
+
+	service_ports = [
+	  {
+		start = 80
+	  }
+	]
+}
+`
+
+const testAccAlbVirtualServiceResourceConfigUpdate = `
+resource "cloudavenue_alb_virtual_service" "example" {
+	edge_gateway_name        = "tn01e02ocb0006205spt102"
+	name                     = "Example"
+	description              = "Example virtual service"
+	enabled                  = false
+	pool_id                  = cloudavenue_alb_pool.example.id
+	virtual_ip               = cloudavenue_publicip.example.public_ip
+	application_profile_type = "L4"
+
+	service_ports = [
+	  {
+		start = 8080
+		end   = 8090
+	  }
+	]
+}
+`
+
+func TestAccAlbVirtualServiceResource(t *testing.T) {
+	const resourceName = "cloudavenue_alb_virtual_service.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: ConcatTests(testAccAlbVirtualServiceDependenciesConfig, testAccAlbVirtualServiceResourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrWith(resourceName, "edge_gateway_id", uuid.TestIsType(uuid.Gateway)),
+					resource.TestCheckResourceAttrWith(resourceName, "pool_id", uuid.TestIsType(uuid.LoadBalancerPool)),
+					resource.TestCheckResourceAttrSet(resourceName, "service_engine_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Example"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "application_profile_type", "HTTP"),
+					resource.TestCheckResourceAttrPair(resourceName, "virtual_ip", "cloudavenue_publicip.example", "public_ip"),
+					resource.TestCheckResourceAttr(resourceName, "service_ports.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "service_ports.*", map[string]string{
+						"start":       "80",
+						"ssl_enabled": "false",
+						"type":        "TCP_PROXY",
+					}),
+				),
+			},
+			{
+				// Update test
+				Config: ConcatTests(testAccAlbVirtualServiceDependenciesConfig, testAccAlbVirtualServiceResourceConfigUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Example virtual service"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "application_profile_type", "L4"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "service_ports.*", map[string]string{
+						"start": "8080",
+						"end":   "8090",
+					}),
+				),
+			},
+			// Import State testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "tn01e02ocb0006205spt102.Example",
+			},
+		},
+	})
+}
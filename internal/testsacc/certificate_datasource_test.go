@@ -0,0 +1,42 @@
+package testsacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccCertificateDataSourceConfig = `
+data "cloudavenue_certificate" "example" {
+	alias = cloudavenue_certificate.example.alias
+}
+`
+
+func TestAccCertificateDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_certificate.example"
+	resourceName := "cloudavenue_certificate.example"
+	certificate, privateKey := testAccCertificateGenerate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: ConcatTests(fmt.Sprintf(testAccCertificateResourceConfig, certificate, privateKey), testAccCertificateDataSourceConfig),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "alias", resourceName, "alias"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subject", resourceName, "subject"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "issuer", resourceName, "issuer"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "not_before", resourceName, "not_before"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "not_after", resourceName, "not_after"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subject_alternative_names.#", resourceName, "subject_alternative_names.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "certificate"),
+				),
+			},
+		},
+	})
+}
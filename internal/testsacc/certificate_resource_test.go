@@ -0,0 +1,121 @@
+package testsacc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+// testAccCertificateGenerate returns a self-signed PEM encoded certificate of www.example.com and its private key.
+func testAccCertificateGenerate(t *testing.T) (certificate, privateKey string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating the private key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		DNSNames:     []string{"www.example.com", "example.com"},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating the certificate: %v", err)
+	}
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("encoding the private key: %v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}))
+}
+
+const testAccCertificateResourceConfig = `
+resource "cloudavenue_certificate" "example" {
+	alias       = "example-certificate"
+	certificate = <<EOT
+%s
+EOT
+	private_key = <<EOT
+%s
+EOT
+}
+`
+
+const testAccCertificateResourceConfigUpdate = `
+resource "cloudavenue_certificate" "example" {
+	alias       = "example-certificate-updated"
+	description = "Example certificate"
+	certificate = <<EOT
+%s
+EOT
+	private_key = <<EOT
+%s
+EOT
+}
+`
+
+func TestAccCertificateResource(t *testing.T) {
+	const resourceName = "cloudavenue_certificate.example"
+	certificate, privateKey := testAccCertificateGenerate(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: fmt.Sprintf(testAccCertificateResourceConfig, certificate, privateKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "id", uuid.TestIsType(uuid.Certificate)),
+					resource.TestCheckResourceAttr(resourceName, "alias", "example-certificate"),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "CN=www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "CN=www.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subject_alternative_names.*", "www.example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "subject_alternative_names.*", "example.com"),
+				),
+			},
+			{
+				// Update test
+				Config: fmt.Sprintf(testAccCertificateResourceConfigUpdate, certificate, privateKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "id", uuid.TestIsType(uuid.Certificate)),
+					resource.TestCheckResourceAttr(resourceName, "alias", "example-certificate-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Example certificate"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "CN=www.example.com"),
+				),
+			},
+			// Import State testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           "example-certificate-updated",
+				ImportStateVerifyIgnore: []string{"certificate", "private_key"},
+			},
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package testsacc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testAccDNSForwarderDataSourceConfig = `
+data "cloudavenue_edgegateway_dns_forwarder" "example" {
+	edge_gateway_id = cloudavenue_edgegateway_dns_forwarder.example.edge_gateway_id
+}
+`
+
+func TestAccDNSForwarderDataSource(t *testing.T) {
+	dataSourceName := "data.cloudavenue_edgegateway_dns_forwarder.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				// Apply test
+				Config: ConcatTests(testAccEdgeGatewayResourceConfig, testAccDNSForwarderResourceConfig, testAccDNSForwarderDataSourceConfig),
+				Check:  dnsForwarderTestCheck(dataSourceName),
+			},
+		},
+	})
+}
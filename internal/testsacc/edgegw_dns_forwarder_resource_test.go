@@ -0,0 +1,99 @@
+package testsacc
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+const testAccDNSForwarderResourceConfig = `
+resource "cloudavenue_edgegateway_dns_forwarder" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	default_forwarder_zone = {
+		name = "default"
+		upstream_servers = [
+			"1.1.1.1"
+		]
+	}
+}
+`
+
+const testAccDNSForwarderResourceConfigUpdate = `
+resource "cloudavenue_edgegateway_dns_forwarder" "example" {
+	edge_gateway_id = cloudavenue_edgegateway.example_with_vdc.id
+	default_forwarder_zone = {
+		name = "default"
+		upstream_servers = [
+			"1.1.1.1",
+			"8.8.8.8"
+		]
+	}
+	conditional_forwarder_zones = [
+		{
+			name = "internal"
+			domain_names = [
+				"example.internal"
+			]
+			upstream_servers = [
+				"192.168.10.10"
+			]
+		}
+	]
+}
+`
+
+func dnsForwarderTestCheck(resourceName string) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrWith(resourceName, "id", uuid.TestIsType(uuid.Gateway)),
+		resource.TestCheckResourceAttrWith(resourceName, "edge_gateway_id", uuid.TestIsType(uuid.Gateway)),
+		resource.TestCheckResourceAttrSet(resourceName, "edge_gateway_name"),
+		resource.TestCheckResourceAttrSet(resourceName, "listener_ip"),
+		resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+		resource.TestCheckResourceAttr(resourceName, "default_forwarder_zone.name", "default"),
+		resource.TestCheckResourceAttr(resourceName, "default_forwarder_zone.upstream_servers.#", "1"),
+		resource.TestCheckTypeSetElemAttr(resourceName, "default_forwarder_zone.upstream_servers.*", "1.1.1.1"),
+		resource.TestCheckNoResourceAttr(resourceName, "conditional_forwarder_zones.#"),
+	)
+}
+
+func TestAccDNSForwarderResource(t *testing.T) {
+	resourceName := "cloudavenue_edgegateway_dns_forwarder.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply
+				Config: ConcatTests(testAccEdgeGatewayResourceConfig, testAccDNSForwarderResourceConfig),
+				Check:  dnsForwarderTestCheck(resourceName),
+			},
+			{
+				// Update
+				Config: ConcatTests(testAccEdgeGatewayResourceConfig, testAccDNSForwarderResourceConfigUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "id", uuid.TestIsType(uuid.Gateway)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_forwarder_zone.upstream_servers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "default_forwarder_zone.upstream_servers.*", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "conditional_forwarder_zones.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "conditional_forwarder_zones.*", map[string]string{
+						"name":               "internal",
+						"domain_names.#":     "1",
+						"domain_names.0":     "example.internal",
+						"upstream_servers.#": "1",
+						"upstream_servers.0": "192.168.10.10",
+					}),
+				),
+			},
+			{
+				// Import
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package testsacc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/testsacc"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+var _ testsacc.TestACC = &EdgeGatewayFirewallRuleResource{}
+
+const (
+	EdgeGatewayFirewallRuleResourceName = testsacc.ResourceName("cloudavenue_edgegateway_firewall_rule")
+)
+
+type EdgeGatewayFirewallRuleResource struct{}
+
+func NewEdgeGatewayFirewallRuleResourceTest() testsacc.TestACC {
+	return &EdgeGatewayFirewallRuleResource{}
+}
+
+// GetResourceName returns the name of the resource.
+func (r *EdgeGatewayFirewallRuleResource) GetResourceName() string {
+	return EdgeGatewayFirewallRuleResourceName.String()
+}
+
+func (r *EdgeGatewayFirewallRuleResource) DependenciesConfig() (resp testsacc.DependenciesConfigResponse) {
+	resp.Append(GetResourceConfig()[EdgeGatewayResourceName]().GetDefaultConfig)
+	return
+}
+
+func (r *EdgeGatewayFirewallRuleResource) Tests(ctx context.Context) map[testsacc.TestName]func(ctx context.Context, resourceName string) testsacc.Test {
+	return map[testsacc.TestName]func(ctx context.Context, resourceName string) testsacc.Test{
+		// * First Test For a firewall rule named "example"
+		"example": func(_ context.Context, resourceName string) testsacc.Test {
+			return testsacc.Test{
+				CommonChecks: []resource.TestCheckFunc{
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrWith(resourceName, "edge_gateway_id", uuid.TestIsType(uuid.Gateway)),
+					resource.TestCheckResourceAttrSet(resourceName, "edge_gateway_name"),
+				},
+				// ! Create testing
+				Create: testsacc.TFConfig{
+					TFConfig: `
+					resource "cloudavenue_edgegateway_firewall_rule" "example" {
+					  edge_gateway_id = cloudavenue_edgegateway.example.id
+					  name            = "allow all IPv4 traffic"
+					  action          = "ALLOW"
+					  direction       = "IN_OUT"
+					}`,
+					Checks: []resource.TestCheckFunc{
+						resource.TestCheckResourceAttr(resourceName, "name", "allow all IPv4 traffic"),
+						resource.TestCheckResourceAttr(resourceName, "action", "ALLOW"),
+						resource.TestCheckResourceAttr(resourceName, "direction", "IN_OUT"),
+						resource.TestCheckResourceAttr(resourceName, "ip_protocol", "IPV4"),
+						resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+						resource.TestCheckResourceAttr(resourceName, "logging", "false"),
+						resource.TestCheckNoResourceAttr(resourceName, "position"),
+					},
+				},
+				// ! Updates testing
+				Updates: []testsacc.TFConfig{
+					{
+						TFConfig: `
+						resource "cloudavenue_edgegateway_firewall_rule" "example" {
+						  edge_gateway_id = cloudavenue_edgegateway.example.id
+						  name            = "drop OUT IPv4 traffic"
+						  action          = "DROP"
+						  direction       = "OUT"
+						  logging         = true
+						  position        = "FIRST"
+						}`,
+						Checks: []resource.TestCheckFunc{
+							resource.TestCheckResourceAttr(resourceName, "name", "drop OUT IPv4 traffic"),
+							resource.TestCheckResourceAttr(resourceName, "action", "DROP"),
+							resource.TestCheckResourceAttr(resourceName, "direction", "OUT"),
+							resource.TestCheckResourceAttr(resourceName, "logging", "true"),
+							resource.TestCheckResourceAttr(resourceName, "position", "FIRST"),
+						},
+					},
+				},
+				// ! Imports testing
+				Imports: []testsacc.TFImport{
+					{
+						ImportStateIDBuilder:    []string{"edge_gateway_id", "id"},
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"position", "position_rule_id"},
+					},
+					{
+						ImportStateIDBuilder:    []string{"edge_gateway_name", "name"},
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{"position", "position_rule_id"},
+					},
+				},
+			}
+		},
+	}
+}
+
+func TestAccEdgeGatewayFirewallRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps:                    testsacc.GenerateTests(&EdgeGatewayFirewallRuleResource{}),
+	})
+}
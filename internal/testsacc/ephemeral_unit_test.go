@@ -0,0 +1,191 @@
+package testsacc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/fakeapi"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/provider"
+)
+
+// ephemeralServer runs the ephemeral resources of the provider configured for the fake API.
+// The terraform CLI is not required: the protocol server of the provider is called directly.
+type ephemeralServer struct {
+	t       *testing.T
+	server  tfprotov6.ProviderServer
+	schemas map[string]*tfprotov6.Schema
+}
+
+func newEphemeralServer(t *testing.T, api *fakeapi.Server) *ephemeralServer {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(provider.New("test")())()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	config := schemaValue(t, schemas.Provider, map[string]tftypes.Value{
+		"url":         tftypes.NewValue(tftypes.String, api.URL),
+		"org":         tftypes.NewValue(tftypes.String, api.Org()),
+		"token":       tftypes.NewValue(tftypes.String, fakeapi.DefaultAPIToken),
+		"max_retries": tftypes.NewValue(tftypes.Number, 0),
+	})
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{TerraformVersion: "1.10.0", Config: config})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	checkDiagnostics(t, resp.Diagnostics)
+
+	return &ephemeralServer{t: t, server: server, schemas: schemas.EphemeralResourceSchemas}
+}
+
+// open opens the ephemeral resource and returns its result and its private data.
+func (s *ephemeralServer) open(typeName string, values map[string]tftypes.Value) (map[string]tftypes.Value, []byte) {
+	s.t.Helper()
+
+	resp, err := s.server.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   schemaValue(s.t, s.schemas[typeName], values),
+	})
+	if err != nil {
+		s.t.Fatalf("expected no error, got %v", err)
+	}
+	checkDiagnostics(s.t, resp.Diagnostics)
+
+	value, err := resp.Result.Unmarshal(s.schemas[typeName].ValueType())
+	if err != nil {
+		s.t.Fatalf("expected no error, got %v", err)
+	}
+	result := map[string]tftypes.Value{}
+	if err := value.As(&result); err != nil {
+		s.t.Fatalf("expected no error, got %v", err)
+	}
+	return result, resp.Private
+}
+
+// close closes the ephemeral resource opened with the private data.
+func (s *ephemeralServer) close(typeName string, private []byte) {
+	s.t.Helper()
+
+	resp, err := s.server.CloseEphemeralResource(context.Background(), &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: typeName,
+		Private:  private,
+	})
+	if err != nil {
+		s.t.Fatalf("expected no error, got %v", err)
+	}
+	checkDiagnostics(s.t, resp.Diagnostics)
+}
+
+// schemaValue returns the configuration of the schema with the values, the other attributes and blocks are null.
+func schemaValue(t *testing.T, schema *tfprotov6.Schema, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	typ := schema.ValueType().(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range typ.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		}
+	}
+
+	config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, attributes))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return &config
+}
+
+func checkDiagnostics(t *testing.T, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("expected no error, got %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+// TestUnitIAMTokenEphemeralResource is not parallel: the telemetry is disabled in the environment.
+func TestUnitIAMTokenEphemeralResource(t *testing.T) {
+	t.Setenv("CLOUDAVENUE_TELEMETRY", "off")
+
+	api := fakeapi.New(t)
+	server := newEphemeralServer(t, api)
+
+	result, private := server.open("cloudavenue_iam_token", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "token01"),
+	})
+
+	var id, token string
+	if err := result["id"].As(&id); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := result["token"].As(&token); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tokens := api.Tokens(); len(tokens) != 1 || tokens[0].ID != id || tokens[0].Name != "token01" || tokens[0].RefreshToken != token {
+		t.Fatalf("expected token %s named token01, got %v", id, tokens)
+	}
+
+	// * The token is revoked on close
+	server.close("cloudavenue_iam_token", private)
+	if tokens := api.Tokens(); len(tokens) != 0 {
+		t.Fatalf("expected the token to be revoked, got %v", tokens)
+	}
+
+	// * A token already revoked is not an error
+	server.close("cloudavenue_iam_token", private)
+}
+
+// TestUnitS3CredentialEphemeralResource is not parallel: the endpoint of the S3 credentials API is set in the environment.
+func TestUnitS3CredentialEphemeralResource(t *testing.T) {
+	t.Setenv("CLOUDAVENUE_TELEMETRY", "off")
+
+	api := fakeapi.New(t)
+	t.Setenv("S3_ENDPOINT", api.URL)
+	server := newEphemeralServer(t, api)
+
+	result, private := server.open("cloudavenue_s3_credential", nil)
+
+	var accessKey string
+	if err := result["access_key"].As(&accessKey); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	hasCredential := func() bool {
+		for _, cred := range api.S3Credentials() {
+			if cred.AccessKey == accessKey {
+				return true
+			}
+		}
+		return false
+	}
+	if !hasCredential() {
+		t.Fatalf("expected credential %s, got %v", accessKey, api.S3Credentials())
+	}
+
+	// * The credential is revoked on close
+	server.close("cloudavenue_s3_credential", private)
+	if hasCredential() {
+		t.Fatalf("expected credential %s to be revoked, got %v", accessKey, api.S3Credentials())
+	}
+
+	// * A credential already revoked is not an error
+	server.close("cloudavenue_s3_credential", private)
+
+	// * A user already deleted is not an error
+	api.InjectError(http.MethodGet, "/api/v1/core/tenants/{orgId}/users/{userName}", http.StatusNotFound, 1, "user not found")
+	server.close("cloudavenue_s3_credential", private)
+}
@@ -0,0 +1,204 @@
+// package testsacc provides the acceptance tests for the provider.
+package testsacc
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/internal/helpers/fakeapi"
+	"github.com/orange-cloudavenue/terraform-provider-cloudavenue/pkg/uuid"
+)
+
+func TestUnitEdgeGatewayDataSource(t *testing.T) {
+	TestUnitPreCheck(t)
+
+	server := fakeapi.New(t)
+	edge := server.AddEdgeGateway(fakeapi.EdgeGateway{
+		Name:         "tn01e02ocb0001234spt101",
+		OwnerName:    "vdc01",
+		Tier0VrfName: "prvrf01eocb0001234allsp01",
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfigWithCredentials() + `
+data "cloudavenue_edgegateway" "example" {
+  name = "tn01e02ocb0001234spt101"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cloudavenue_edgegateway.example", "id", edge.ID),
+					resource.TestCheckResourceAttr("data.cloudavenue_edgegateway.example", "owner_name", "vdc01"),
+					resource.TestCheckResourceAttr("data.cloudavenue_edgegateway.example", "tier0_vrf_name", "prvrf01eocb0001234allsp01"),
+				),
+			},
+			{
+				// The session of the Cloud Avenue SDK is opened with the access token exchanged for the API token.
+				Config: server.ProviderConfig() + `
+data "cloudavenue_edgegateway" "example" {
+  name = "tn01e02ocb0001234spt101"
+}`,
+				Check: resource.TestCheckResourceAttr("data.cloudavenue_edgegateway.example", "id", edge.ID),
+			},
+			{
+				Config: server.ProviderConfigWithCredentials() + `
+data "cloudavenue_edgegateway" "example" {
+  name = "tn01e02ocb0001234spt999"
+}`,
+				ExpectError: regexp.MustCompile(`Error retrieving edge gateway`),
+			},
+		},
+	})
+}
+
+func TestUnitPublicIPResource(t *testing.T) {
+	TestUnitPreCheck(t)
+
+	server := fakeapi.New(t)
+	server.AddVDC(fakeapi.VDC{Name: "vdc01"})
+	server.AddEdgeGateway(fakeapi.EdgeGateway{Name: "tn01e02ocb0001234spt101", OwnerName: "vdc01"})
+	server.AddPublicIP(fakeapi.PublicIP{UplinkIP: "192.0.2.1", EdgeGatewayName: "tn01e02ocb0001234spt101"})
+
+	config := server.ProviderConfig() + `
+resource "cloudavenue_publicip" "example" {
+  edge_gateway_name = "tn01e02ocb0001234spt101"
+}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodPost, "/api/customers/v1.0/ip", http.StatusInternalServerError, 1, "internal error")
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`internal error`),
+			},
+			{
+				PreConfig: func() {
+					server.SetJobStates("CREATED", "PENDING", "IN_PROGRESS", "DONE")
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudavenue_publicip.example", "public_ip", "192.0.2.2"),
+					resource.TestCheckResourceAttr("cloudavenue_publicip.example", "edge_gateway_name", "tn01e02ocb0001234spt101"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if len(server.PublicIPs()) != 1 {
+				return fmt.Errorf("expected the public IP to be deleted, got %v", server.PublicIPs())
+			}
+			return nil
+		},
+	})
+}
+
+func TestUnitVDCResource(t *testing.T) {
+	TestUnitPreCheck(t)
+
+	server := fakeapi.New(t)
+
+	const config = `
+resource "cloudavenue_vdc" "example" {
+  name                  = "vdcunit01"
+  description           = %q
+  cpu_allocated         = 22000
+  memory_allocated      = 30
+  cpu_speed_in_mhz      = 2200
+  billing_model         = "PAYG"
+  disponibility_class   = "ONE-ROOM"
+  service_class         = "STD"
+  storage_billing_model = "PAYG"
+
+  storage_profiles = [{
+    class   = "gold"
+    default = true
+    limit   = 500
+  }]
+}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.SetJobStates("CREATED", "IN_PROGRESS", "DONE")
+				},
+				Config: server.ProviderConfig() + fmt.Sprintf(config, "created by the unit test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("cloudavenue_vdc.example", "id", uuid.TestIsType(uuid.VDC)),
+					resource.TestCheckResourceAttr("cloudavenue_vdc.example", "name", "vdcunit01"),
+					resource.TestCheckResourceAttr("cloudavenue_vdc.example", "description", "created by the unit test"),
+					resource.TestCheckResourceAttr("cloudavenue_vdc.example", "storage_profiles.0.class", "gold"),
+					func(_ *terraform.State) error {
+						if vdcs := server.VDCs(); len(vdcs) != 1 || vdcs[0].CPUAllocated != 22000 {
+							return fmt.Errorf("expected the VDC to be created with 22000 MHz, got %v", vdcs)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(config, "updated by the unit test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudavenue_vdc.example", "description", "updated by the unit test"),
+					func(_ *terraform.State) error {
+						if vdcs := server.VDCs(); len(vdcs) != 1 || vdcs[0].Description != "updated by the unit test" {
+							return fmt.Errorf("expected the VDC to be updated, got %v", vdcs)
+						}
+						return nil
+					},
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if len(server.VDCs()) != 0 {
+				return fmt.Errorf("expected the VDC to be deleted, got %v", server.VDCs())
+			}
+			return nil
+		},
+	})
+}
+
+func TestUnitALBPoolResourceUnknownEdgeGateway(t *testing.T) {
+	TestUnitPreCheck(t)
+
+	server := fakeapi.New(t)
+
+	// The name of the Edge Gateway is only known after the apply of terraform_data, the plan must not
+	// look up the Edge Gateway, which does not exist on the server.
+	config := server.ProviderConfig() + `
+resource "terraform_data" "edge_gateway" {
+  input = "tn01e02ocb0001234spt101"
+}
+
+resource "cloudavenue_alb_pool" "example" {
+  edge_gateway_name = terraform_data.edge_gateway.output
+  name              = "Example"
+
+  members = [
+    {
+      ip_address = "192.168.1.1"
+      port       = 80
+    }
+  ]
+}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
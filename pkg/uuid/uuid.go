@@ -31,6 +31,7 @@ const (
 	SecurityGroup     = VcloudUUID(VcloudUUIDPrefix + "firewallGroup:")
 	Catalog           = VcloudUUID(VcloudUUIDPrefix + "catalog:")
 	Token             = VcloudUUID(VcloudUUIDPrefix + "token:")
+	Certificate       = VcloudUUID(VcloudUUIDPrefix + "certificateLibraryItem:")
 
 	// * CLOUDAVENUE.
 	VCDA = VcloudUUID(CloudAvenueUUIDPrefix + "vcda:")
@@ -53,8 +54,12 @@ var vcloudUUIDs = []VcloudUUID{
 	SecurityGroup,
 	Catalog,
 	Token,
+	Certificate,
 }
 
+// prefixes is the list of all the known prefixes, vCloud and Cloud Avenue.
+var prefixes = append(append([]VcloudUUID{}, vcloudUUIDs...), VCDA)
+
 type (
 	VcloudUUID string
 )
@@ -119,6 +124,50 @@ func IsValid(uuid string) bool {
 	return false
 }
 
+// Prefixes returns all the known prefixes, vCloud and Cloud Avenue.
+func Prefixes() []VcloudUUID {
+	return append([]VcloudUUID{}, prefixes...)
+}
+
+// PrefixOf returns the known prefix of the URN. It returns an empty prefix if the URN has no known prefix.
+func PrefixOf(urn string) VcloudUUID {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(urn, prefix.String()) {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// PrefixFromType returns the prefix of the type (vm, vdc, vdcGroup, vcda...).
+// It returns an empty prefix if the type is unknown.
+func PrefixFromType(t string) VcloudUUID {
+	for _, prefix := range prefixes {
+		if prefix.Type() == t {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// Namespace returns the namespace of the prefix (vcloud or cloudavenue).
+func (uuid VcloudUUID) Namespace() string {
+	parts := strings.Split(uuid.String(), ":")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+// Type returns the type of the prefix (vm, vdc, vdcGroup, vcda...).
+func (uuid VcloudUUID) Type() string {
+	parts := strings.Split(uuid.String(), ":")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[2]
+}
+
 // Normalize returns the UUID with the prefix if prefix is missing.
 func Normalize(prefix VcloudUUID, uuid string) VcloudUUID {
 	if len(uuid) == 0 || prefix.isEmpty() {
@@ -208,6 +257,11 @@ func (uuid VcloudUUID) IsToken() bool {
 	return uuid.IsType(Token)
 }
 
+// IsCertificate returns true if the UUID is a Certificate UUID.
+func (uuid VcloudUUID) IsCertificate() bool {
+	return uuid.IsType(Certificate)
+}
+
 // IsVDCComputePolicy returns true if the UUID is a VDCComputePolicy UUID.
 func (uuid VcloudUUID) IsVDCComputePolicy() bool {
 	return uuid.IsType(VDCComputePolicy)
@@ -295,6 +349,11 @@ func IsToken(uuid string) bool {
 	return VcloudUUID(uuid).IsType(Token)
 }
 
+// IsCertificate returns true if the UUID is a Certificate UUID.
+func IsCertificate(uuid string) bool {
+	return VcloudUUID(uuid).IsType(Certificate)
+}
+
 // IsVDCComputePolicy returns true if the UUID is a VDCComputePolicy UUID.
 func IsVDCComputePolicy(uuid string) bool {
 	return VcloudUUID(uuid).IsType(VDCComputePolicy)
@@ -234,6 +234,121 @@ func TestNormalize(t *testing.T) {
 	}
 }
 
+func TestPrefixOf(t *testing.T) {
+	tests := []struct {
+		name string
+		urn  string
+		want VcloudUUID
+	}{
+		{
+			name: "VM",
+			urn:  VM.String() + validUUIDv4,
+			want: VM,
+		},
+		{
+			name: "VDCGroup",
+			urn:  VDCGroup.String() + validUUIDv4,
+			want: VDCGroup,
+		},
+		{
+			name: "VCDA",
+			urn:  VCDA.String() + validUUIDv4,
+			want: VCDA,
+		},
+		{
+			name: "Unknown",
+			urn:  "urn:vcloud:unknown:" + validUUIDv4,
+			want: "",
+		},
+		{
+			name: "EmptyString",
+			urn:  "",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrefixOf(tt.urn); got != tt.want {
+				t.Errorf("PrefixOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixFromType(t *testing.T) {
+	tests := []struct {
+		name     string
+		uuidType string
+		want     VcloudUUID
+	}{
+		{
+			name:     "VDC",
+			uuidType: "vdc",
+			want:     VDC,
+		},
+		{
+			name:     "VDCGroup",
+			uuidType: "vdcGroup",
+			want:     VDCGroup,
+		},
+		{
+			name:     "VCDA",
+			uuidType: "vcda",
+			want:     VCDA,
+		},
+		{
+			name:     "Unknown",
+			uuidType: "unknown",
+			want:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PrefixFromType(tt.uuidType); got != tt.want {
+				t.Errorf("PrefixFromType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVcloudUUID_NamespaceAndType(t *testing.T) {
+	tests := []struct {
+		name      string
+		uuid      VcloudUUID
+		namespace string
+		uuidType  string
+	}{
+		{
+			name:      "VM",
+			uuid:      VM,
+			namespace: "vcloud",
+			uuidType:  "vm",
+		},
+		{
+			name:      "VCDA",
+			uuid:      VCDA,
+			namespace: "cloudavenue",
+			uuidType:  "vcda",
+		},
+		{
+			name:      "EmptyString",
+			uuid:      VcloudUUID(""),
+			namespace: "",
+			uuidType:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.Namespace(); got != tt.namespace {
+				t.Errorf("VcloudUUID.Namespace() = %v, want %v", got, tt.namespace)
+			}
+			if got := tt.uuid.Type(); got != tt.uuidType {
+				t.Errorf("VcloudUUID.Type() = %v, want %v", got, tt.uuidType)
+			}
+		})
+	}
+}
+
 func TestVcloudUUID_IsVM(t *testing.T) {
 	tests := []struct {
 		name string
@@ -712,6 +827,38 @@ func TestVcloudUUID_IsToken(t *testing.T) {
 	}
 }
 
+// IsCertificate.
+func TestVcloudUUID_IsCertificate(t *testing.T) {
+	tests := []struct {
+		name string
+		uuid VcloudUUID
+		want bool
+	}{
+		{ // IsCertificate
+			name: "IsCertificate",
+			uuid: VcloudUUID(Certificate.String() + validUUIDv4),
+			want: true,
+		},
+		{ // IsNotCertificate
+			name: "IsNotCertificate",
+			uuid: VcloudUUID("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791"),
+			want: false,
+		},
+		{ // Empty string
+			name: "EmptyString",
+			uuid: VcloudUUID(""),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.uuid.IsCertificate(); got != tt.want {
+				t.Errorf("VcloudUUID.IsCertificate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestIsType tests the TestIsType function.
 func TestTestIsType(t *testing.T) {
 	testCases := []struct {
@@ -1220,3 +1367,37 @@ func TestIsToken(t *testing.T) {
 		})
 	}
 }
+
+// TestIsCertificate.
+func TestIsCertificate(t *testing.T) {
+	tests := []struct {
+		name     string
+		uuidType VcloudUUID
+		uuid     string
+		want     bool
+	}{
+		{ // IsCertificate
+			name: "IsCertificate",
+			uuid: VcloudUUID(Certificate.String() + validUUIDv4).String(),
+			want: true,
+		},
+		{ // IsNotCertificate
+			name: "IsNotCertificate",
+			uuid: VcloudUUID("urn:vcloud:vm:f47ac10b-58cc-4372-a567-0e02b2c3d4791").String(),
+			want: false,
+		},
+		{ // EmptyString
+			name: "EmptyString",
+			uuid: VcloudUUID("").String(),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCertificate(tt.uuid); got != tt.want {
+				t.Errorf("IsCertificate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}